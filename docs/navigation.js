@@ -52,6 +52,11 @@ export const navigation = [
                 href: '/docs/advanced-guide/remote-log-level-change',
                 desc: "Discover how to dynamically change log levels remotely, enabling you to adjust logging verbosity without redeploying your application."
             },
+            {
+                title: 'Feature Flags',
+                href: '/docs/advanced-guide/feature-flags',
+                desc: "Learn how to enable features for all or some of the users without redeploying, using flags read from a file or a custom provider."
+            },
             {
                 title: 'Publishing Custom Metrics',
                 href: '/docs/advanced-guide/publishing-custom-metrics',
@@ -107,6 +112,11 @@ export const navigation = [
                 href: '/docs/advanced-guide/using-publisher-subscriber',
                 desc: "Discover how to gofr seamlessly allows to integrate different Pub/Sub systems in your application for effective messaging and event-driven architectures."
             },
+            {
+                title: 'Using the Event Bus',
+                href: '/docs/advanced-guide/using-event-bus',
+                desc: "Learn how to publish domain events to the handlers of other components in the same application, without an external broker."
+            },
             {
                 title: 'Injecting Databases',
                 href: '/docs/advanced-guide/injecting-databases-drivers',
@@ -37,16 +37,19 @@ type HelloClientWrapper struct {
 	HelloGoFrClient
 }
 
-func createGRPCConn(host string) (*grpc.ClientConn, error) {
-	conn, err := grpc.Dial(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func createGRPCConn(host string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	// the credentials passed in opts, e.g. for TLS, replace the insecure ones
+	opts = append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
+
+	conn, err := grpc.Dial(host, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return conn, nil
 }
 
-func NewHelloGoFrClient(host string, metrics metrics.Manager) (*HelloClientWrapper, error) {
-	conn, err := createGRPCConn(host)
+func NewHelloGoFrClient(host string, metrics metrics.Manager, opts ...grpc.DialOption) (*HelloClientWrapper, error) {
+	conn, err := createGRPCConn(host, opts...)
 	if err != nil {
 		return &HelloClientWrapper{client: nil}, err
 	}
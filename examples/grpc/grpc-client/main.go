@@ -1,15 +1,36 @@
 package main
 
 import (
+	"time"
+
+	"google.golang.org/grpc"
+
 	"gofr.dev/examples/grpc/grpc-client/client"
 	"gofr.dev/pkg/gofr"
+	gofrGRPC "gofr.dev/pkg/gofr/grpc"
 )
 
 func main() {
 	app := gofr.New()
 
+	// Time out the calls made with a context having no deadline after 5 seconds, and send the ID of the HTTP request in
+	// the metadata of the calls, to correlate the logs of the server with the request
+	opts := []grpc.DialOption{grpc.WithChainUnaryInterceptor(gofrGRPC.DeadlineInterceptor(5*time.Second),
+		gofrGRPC.RequestIDInterceptor())}
+
+	// Dial with TLS when the CA of the server is configured, presenting the client certificate for mutual TLS if set
+	if caFile := app.Config.Get("GRPC_SERVER_CA_FILE"); caFile != "" {
+		creds, err := gofrGRPC.ClientCredentials(caFile, app.Config.Get("GRPC_CLIENT_CERT_FILE"), app.Config.Get("GRPC_CLIENT_KEY_FILE"))
+		if err != nil {
+			app.Logger().Errorf("Failed to load gRPC client TLS credentials: %v", err)
+			return
+		}
+
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	}
+
 	// Create a gRPC client for the Hello service
-	helloGRPCClient, err := client.NewHelloGoFrClient(app.Config.Get("GRPC_SERVER_HOST"), app.Metrics())
+	helloGRPCClient, err := client.NewHelloGoFrClient(app.Config.Get("GRPC_SERVER_HOST"), app.Metrics(), opts...)
 	if err != nil {
 		app.Logger().Errorf("Failed to create Hello gRPC client: %v", err)
 		return
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"gofr.dev/pkg/gofr"
@@ -20,15 +21,23 @@ func main() {
 		gofr.AddHelp("hello world option"),
 	)
 
-	// Add a sub-command "params" with its handler, help and description
+	// Add a sub-command "params" with a flag which can be passed as -name, --name or -n
 	app.SubCommand("params", func(c *gofr.Context) (any, error) {
-		return fmt.Sprintf("Hello %s!", c.Param("name")), nil
-	})
+		return fmt.Sprintf("Hello %s!", c.Flag("name")), nil
+	},
+		gofr.WithFlag("name", "n", "World", "the name to greet"),
+	)
 
 	app.SubCommand("spinner", spinner)
 
 	app.SubCommand("progress", progress)
 
+	app.SubCommand("confirm", confirm)
+
+	app.SubCommand("table", table)
+
+	app.SubCommand("bars", multiProgress)
+
 	// Run the command-line application
 	app.Run()
 }
@@ -70,3 +79,63 @@ func progress(ctx *gofr.Context) (any, error) {
 
 	return "Process Complete", nil
 }
+
+func confirm(ctx *gofr.Context) (any, error) {
+	// the prompt returns terminal.ErrNotInteractive if stdin is not a terminal, e.g. when the input is piped.
+	confirmed, err := ctx.In.Confirm(ctx, "Do you want to continue?")
+	if err != nil {
+		return nil, err
+	}
+
+	if !confirmed {
+		return "Aborted", nil
+	}
+
+	name, err := ctx.In.Prompt(ctx, "Enter your name:")
+	if err != nil {
+		return nil, err
+	}
+
+	return fmt.Sprintf("Hello %s!", name), nil
+}
+
+func table(ctx *gofr.Context) (any, error) {
+	// the table is printed as tab-separated values when the output is not a terminal.
+	terminal.NewTable(ctx.Out, "COMMAND", "DESCRIPTION").WithBorder().
+		AddRow("hello", "Print 'Hello World!'").
+		AddRow("spinner", "Display a spinner").
+		AddRow("progress", "Display a progress bar").
+		Render()
+
+	return "", nil
+}
+
+func multiProgress(ctx *gofr.Context) (any, error) {
+	mp := terminal.NewMultiProgress(ctx.Out)
+
+	var wg sync.WaitGroup
+
+	// each worker updates its own bar concurrently.
+	for _, total := range []int64{20, 40, 60} {
+		bar := mp.AddBar(total)
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range total {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(10 * time.Millisecond):
+					bar.Incr(1)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return "Process Complete", nil
+}
@@ -41,6 +41,7 @@ func TestCMDRunWithParams(t *testing.T) {
 		"command params   -name=Vikash",
 		"command -name=Vikash params",
 		"command params -name=Vikash -",
+		"command params -n=Vikash",
 	}
 
 	for i, command := range commands {
@@ -106,3 +107,30 @@ func TestCMDRun_ProgressContextCancelled(t *testing.T) {
 	assert.Empty(t, res)
 	assert.ErrorIs(t, err, context.Canceled)
 }
+
+func TestCMDRun_ConfirmNotInteractive(t *testing.T) {
+	os.Args = []string{"command", "confirm"}
+
+	output := testutil.StderrOutputForFunc(main)
+
+	assert.Contains(t, output, terminal.ErrNotInteractive.Error())
+}
+
+func TestCMDRun_Table(t *testing.T) {
+	os.Args = []string{"command", "table"}
+
+	output := testutil.StdoutOutputForFunc(main)
+
+	assert.Contains(t, output, "COMMAND\tDESCRIPTION\n")
+	assert.Contains(t, output, "spinner\tDisplay a spinner\n")
+}
+
+func TestCMDRun_MultiProgress(t *testing.T) {
+	os.Args = []string{"command", "bars"}
+
+	output := testutil.StdoutOutputForFunc(main)
+
+	assert.Contains(t, output, "bar 1: 100.000%\n")
+	assert.Contains(t, output, "bar 3: 50.000%\n")
+	assert.Contains(t, output, "Process Complete\n")
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"fmt"
+
 	"gofr.dev/examples/using-subscriber/migrations"
 	"gofr.dev/pkg/gofr"
 )
@@ -18,9 +20,8 @@ func main() {
 
 		err := c.Bind(&productInfo)
 		if err != nil {
-			c.Logger.Error(err)
-
-			return nil
+			// the message cannot be handled, so it is acknowledged instead of being redelivered
+			return fmt.Errorf("%w: %v", gofr.ErrDropMessage, err)
 		}
 
 		c.Logger.Info("Received product", productInfo)
@@ -36,9 +37,7 @@ func main() {
 
 		err := c.Bind(&orderStatus)
 		if err != nil {
-			c.Logger.Error(err)
-
-			return nil
+			return fmt.Errorf("%w: %v", gofr.ErrDropMessage, err)
 		}
 
 		c.Logger.Info("Received order", orderStatus)
@@ -0,0 +1,24 @@
+package gofr
+
+import "gofr.dev/pkg/gofr/container"
+
+// The build info reported by the health endpoint can be set when building the application, e.g.
+//
+//	go build -ldflags "-X gofr.dev/pkg/gofr.buildVersion=v1.2.0 -X gofr.dev/pkg/gofr.buildCommit=$(git rev-parse HEAD)"
+//
+//nolint:gochecknoglobals // set using -ldflags
+var (
+	buildVersion string
+	buildCommit  string
+	buildTime    string
+)
+
+// BuildInfo describes the build of the application, reported in the build section of the health endpoint.
+type BuildInfo = container.BuildInfo
+
+// SetBuildInfo sets the version, commit and build time reported by the health endpoint. The empty fields of info keep
+// the values set using -ldflags or recorded by the Go toolchain. The build info and the uptime are omitted from the
+// health endpoint if the HEALTH_BUILD_INFO config is false.
+func (a *App) SetBuildInfo(info BuildInfo) {
+	a.container.SetBuildInfo(info)
+}
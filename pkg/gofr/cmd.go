@@ -14,6 +14,7 @@ import (
 type cmd struct {
 	routes []route
 	out    terminal.Output
+	in     terminal.Input
 }
 
 type route struct {
@@ -21,6 +22,16 @@ type route struct {
 	handler     Handler
 	description string
 	help        string
+	flags       []flag
+}
+
+// flag is a flag declared for a subcommand, it can be passed as -name=value, --name=value or using its short name.
+type flag struct {
+	name         string
+	short        string
+	defaultValue string
+	usage        string
+	required     bool
 }
 
 // Options is a function type used to configure a route in the command handler.
@@ -33,6 +44,15 @@ func (ErrCommandNotFound) Error() string {
 	return "No Command Found!"
 }
 
+// ErrMissingFlags is returned when required flags of a subcommand are not provided.
+type ErrMissingFlags struct {
+	Flags []string
+}
+
+func (e ErrMissingFlags) Error() string {
+	return "missing required flag(s): " + strings.Join(e.Flags, ", ")
+}
+
 func (cmd *cmd) Run(c *container.Container) {
 	args := os.Args[1:] // First one is command itself
 	subCommand := ""
@@ -60,7 +80,8 @@ func (cmd *cmd) Run(c *container.Container) {
 	}
 
 	r := cmd.handler(subCommand)
-	ctx := newCMDContext(&cmd2.Responder{}, cmd2.NewRequest(args), c, cmd.out)
+	req := cmd2.NewRequest(args)
+	ctx := newCMDContext(&cmd2.Responder{}, req, c, cmd.out, cmd.in)
 
 	// handling if route is not found or the handler is nil
 	if cmd.noCommandResponse(r, ctx) {
@@ -69,12 +90,83 @@ func (cmd *cmd) Run(c *container.Container) {
 
 	if showHelp {
 		cmd.out.Println(r.help)
+		r.printFlags(cmd.out)
+
+		return
+	}
+
+	flags, err := r.resolveFlags(req)
+	if err != nil {
+		ctx.responder.Respond(nil, err)
 		return
 	}
 
+	ctx.flags = flags
+
 	ctx.responder.Respond(r.handler(ctx))
 }
 
+// resolveFlags returns the values of the declared flags of the route, using the defaults for the ones not provided.
+func (r *route) resolveFlags(req *cmd2.Request) (map[string]string, error) {
+	values := make(map[string]string, len(r.flags))
+
+	var missing []string
+
+	for _, f := range r.flags {
+		value := req.Param(f.name)
+
+		if value == "" && f.short != "" {
+			value = req.Param(f.short)
+		}
+
+		if value == "" {
+			value = f.defaultValue
+		}
+
+		if value == "" && f.required {
+			missing = append(missing, f.name)
+		}
+
+		values[f.name] = value
+	}
+
+	if len(missing) > 0 {
+		return nil, ErrMissingFlags{Flags: missing}
+	}
+
+	return values, nil
+}
+
+func (r *route) printFlags(out terminal.Output) {
+	if len(r.flags) == 0 {
+		return
+	}
+
+	out.Println("Flags:")
+
+	table := terminal.NewTable(out)
+
+	for _, f := range r.flags {
+		names := "--" + f.name
+		if f.short != "" {
+			names = "-" + f.short + ", " + names
+		}
+
+		usage := f.usage
+
+		switch {
+		case f.required:
+			usage += " (required)"
+		case f.defaultValue != "":
+			usage += fmt.Sprintf(" (default %q)", f.defaultValue)
+		}
+
+		table.AddRow("  "+names, usage)
+	}
+
+	table.Render()
+}
+
 // noCommandResponse responds with error when no route with the given subcommand is not found or handler is nil.
 func (cmd *cmd) noCommandResponse(r *route, ctx *Context) bool {
 	if r == nil {
@@ -125,6 +217,23 @@ func AddHelp(helperString string) Options {
 	}
 }
 
+// WithFlag declares a flag for the subcommand, which can be read in the handler using ctx.Flag(name).
+// The flag can be passed as -name=value, --name=value or using the short name, e.g. -n=value, and takes the
+// default value if it is not passed. Declared flags are listed in the help of the subcommand.
+func WithFlag(name, short, defaultValue, usage string) Options {
+	return func(r *route) {
+		r.flags = append(r.flags, flag{name: name, short: short, defaultValue: defaultValue, usage: usage})
+	}
+}
+
+// WithRequiredFlag declares a flag which must be passed to the subcommand, the handler is not called
+// and ErrMissingFlags is returned if it is not.
+func WithRequiredFlag(name, short, usage string) Options {
+	return func(r *route) {
+		r.flags = append(r.flags, flag{name: name, short: short, usage: usage, required: true})
+	}
+}
+
 // addRoute adds a new route to cmd's list of routes.
 func (cmd *cmd) addRoute(pattern string, handler Handler, options ...Options) {
 	tempRoute := route{
@@ -0,0 +1,119 @@
+package terminal
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// ErrNotInteractive is returned by the prompts when the input is not a terminal, e.g. when it is piped,
+// so that destructive commands do not proceed without an explicit answer from the user.
+var ErrNotInteractive = errors.New("cannot prompt for input, stdin is not a terminal")
+
+type Input interface {
+	Prompt(ctx context.Context, message string) (string, error)
+	Confirm(ctx context.Context, message string) (bool, error)
+}
+
+// In reads the answers of the user to the prompts of a CMD application.
+type In struct {
+	isTerminal bool
+	reader     *bufio.Reader
+	out        io.Writer
+
+	mu sync.Mutex
+	// pending holds the result of a read that was abandoned because the context of its prompt was done,
+	// so that the next prompt receives the line instead of starting a concurrent read.
+	pending chan readResult
+}
+
+type readResult struct {
+	line string
+	err  error
+}
+
+// NewInput returns the In reading from stdin. The same In is returned on every call, as separate buffered
+// readers on stdin would consume the input meant for each other.
+func NewInput() *In {
+	return stdinInput()
+}
+
+var stdinInput = sync.OnceValue(func() *In {
+	return &In{
+		isTerminal: term.IsTerminal(int(os.Stdin.Fd())),
+		reader:     bufio.NewReader(os.Stdin),
+		out:        os.Stdout,
+	}
+})
+
+// Prompt asks the user for input on stdin. See In.Prompt.
+func Prompt(ctx context.Context, message string) (string, error) {
+	return NewInput().Prompt(ctx, message)
+}
+
+// Confirm asks the user for a yes/no confirmation on stdin. See In.Confirm.
+func Confirm(ctx context.Context, message string) (bool, error) {
+	return NewInput().Confirm(ctx, message)
+}
+
+// Prompt prints the message and returns the line entered by the user without surrounding whitespaces.
+// It returns ErrNotInteractive if the input is not a terminal and the error of the context if it is done
+// before the user answers.
+func (i *In) Prompt(ctx context.Context, message string) (string, error) {
+	if !i.isTerminal {
+		return "", ErrNotInteractive
+	}
+
+	fmt.Fprint(i.out, message+" ")
+
+	return i.readLine(ctx)
+}
+
+// Confirm prints the message followed by [y/N] and returns true only if the user answers y or yes.
+func (i *In) Confirm(ctx context.Context, message string) (bool, error) {
+	answer, err := i.Prompt(ctx, message+" [y/N]")
+	if err != nil {
+		return false, err
+	}
+
+	switch strings.ToLower(answer) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+func (i *In) readLine(ctx context.Context) (string, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.pending == nil {
+		i.pending = make(chan readResult, 1)
+
+		go func(result chan<- readResult) {
+			line, err := i.reader.ReadString('\n')
+			if errors.Is(err, io.EOF) && line != "" {
+				err = nil
+			}
+
+			result <- readResult{line: strings.TrimSpace(line), err: err}
+		}(i.pending)
+	}
+
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case res := <-i.pending:
+		i.pending = nil
+
+		return res.line, res.err
+	}
+}
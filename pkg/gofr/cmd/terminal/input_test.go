@@ -0,0 +1,98 @@
+package terminal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tempInput(input io.Reader) (*In, *bytes.Buffer) {
+	var b bytes.Buffer
+
+	return &In{isTerminal: true, reader: bufio.NewReader(input), out: &b}, &b
+}
+
+func TestNewInput(t *testing.T) {
+	in := NewInput()
+
+	assert.Same(t, in, NewInput())
+	// for tests, the os.Stdin is not a terminal.
+	assert.False(t, in.isTerminal)
+}
+
+func TestIn_Prompt(t *testing.T) {
+	in, out := tempInput(strings.NewReader("  gofr \nsecond"))
+
+	name, err := in.Prompt(context.Background(), "Enter name:")
+
+	require.NoError(t, err)
+	assert.Equal(t, "gofr", name)
+	assert.Equal(t, "Enter name: ", out.String())
+
+	name, err = in.Prompt(context.Background(), "Enter name:")
+
+	require.NoError(t, err)
+	assert.Equal(t, "second", name, "the last line should be read even without a trailing newline")
+}
+
+func TestIn_Confirm(t *testing.T) {
+	testCases := []struct {
+		answer   string
+		expected bool
+	}{
+		{"y\n", true},
+		{"YES\n", true},
+		{"n\n", false},
+		{"\n", false},
+		{"maybe\n", false},
+	}
+
+	for i, tc := range testCases {
+		in, out := tempInput(strings.NewReader(tc.answer))
+
+		confirmed, err := in.Confirm(context.Background(), "Delete all files?")
+
+		require.NoError(t, err, "TEST[%d], Failed.\n", i)
+		assert.Equal(t, tc.expected, confirmed, "TEST[%d], Failed.\n", i)
+		assert.Equal(t, "Delete all files? [y/N] ", out.String(), "TEST[%d], Failed.\n", i)
+	}
+}
+
+func TestIn_NotInteractive(t *testing.T) {
+	in, out := tempInput(strings.NewReader("y\n"))
+	in.isTerminal = false
+
+	confirmed, err := in.Confirm(context.Background(), "Delete all files?")
+
+	require.ErrorIs(t, err, ErrNotInteractive)
+	assert.False(t, confirmed)
+	assert.Empty(t, out.String())
+}
+
+func TestIn_ContextDone(t *testing.T) {
+	r, w := io.Pipe()
+	in, _ := tempInput(r)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := in.Prompt(ctx, "Enter name:")
+
+	require.ErrorIs(t, err, context.Canceled)
+
+	// the line entered after the cancellation is returned to the next prompt.
+	go func() {
+		_, _ = w.Write([]byte("gofr\n"))
+	}()
+
+	name, err := in.Prompt(context.Background(), "Enter name:")
+
+	require.NoError(t, err)
+	assert.Equal(t, "gofr", name)
+}
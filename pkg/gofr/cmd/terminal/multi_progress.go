@@ -0,0 +1,113 @@
+package terminal
+
+import "sync"
+
+// logStep is the percentage step at which the progress of a bar is printed when the output is not a terminal.
+const logStep = 10
+
+// MultiProgress is a TUI component that displays several progress bars, one per line, which can be updated
+// concurrently. On a terminal all the bars are redrawn in place on every update, otherwise a line is printed
+// for a bar every time its progress crosses a multiple of 10%.
+type MultiProgress struct {
+	stream     Output
+	isTerminal bool
+	tWidth     int
+
+	mu    sync.Mutex
+	bars  []*Bar
+	drawn int
+}
+
+// Bar is a progress bar of a MultiProgress.
+type Bar struct {
+	multi *MultiProgress
+	id    int
+	bar   ProgressBar
+}
+
+func NewMultiProgress(out Output) *MultiProgress {
+	m := &MultiProgress{stream: out}
+
+	o, ok := out.(*Out)
+	if !ok || !o.isTerminal {
+		return m
+	}
+
+	if w, _, err := out.getSize(); err == nil {
+		m.isTerminal = true
+		m.tWidth = w
+	}
+
+	return m
+}
+
+// AddBar adds a progress bar for the given total, a negative total is taken as 0.
+func (m *MultiProgress) AddBar(total int64) *Bar {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	b := &Bar{
+		multi: m,
+		id:    len(m.bars) + 1,
+		bar:   ProgressBar{total: max(total, 0), tWidth: m.tWidth},
+	}
+
+	m.bars = append(m.bars, b)
+
+	if m.isTerminal {
+		m.redraw()
+	}
+
+	return b
+}
+
+// Incr increments the progress of the bar and returns false once it is complete. It is safe to call Incr
+// for the bars of a MultiProgress from multiple goroutines.
+func (b *Bar) Incr(i int64) bool {
+	m := b.multi
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p := &b.bar
+
+	if p.current >= p.total {
+		return false
+	}
+
+	previous := p.current
+	p.current = min(p.current+i, p.total)
+
+	if m.isTerminal {
+		m.redraw()
+	} else if step(p.current, p.total) != step(previous, p.total) {
+		m.stream.Printf("bar %d: %s\n", b.id, p.getString())
+	}
+
+	return p.current != p.total
+}
+
+// redraw moves the cursor to the first bar and prints all the bars again, so that the bars are not
+// clobbered by each other.
+func (m *MultiProgress) redraw() {
+	if m.drawn > 0 {
+		m.stream.CursorUp(m.drawn)
+	}
+
+	for _, b := range m.bars {
+		m.stream.Print("\r")
+		m.stream.ClearLineRight()
+		m.stream.Print(b.bar.getString())
+		m.stream.Print("\n")
+	}
+
+	m.drawn = len(m.bars)
+}
+
+func step(current, total int64) int64 {
+	if total <= 0 {
+		return 0
+	}
+
+	return current * 100 / total / logStep
+}
@@ -0,0 +1,86 @@
+package terminal
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMultiProgress_NotTerminal(t *testing.T) {
+	var b bytes.Buffer
+
+	m := NewMultiProgress(&Out{out: &b})
+
+	assert.False(t, m.isTerminal)
+}
+
+func TestMultiProgress_Terminal(t *testing.T) {
+	var b bytes.Buffer
+
+	m := &MultiProgress{stream: &Out{terminal{isTerminal: true, fd: 1}, &b}, isTerminal: true, tWidth: 80}
+
+	first := m.AddBar(10)
+	second := m.AddBar(4)
+
+	b.Reset()
+
+	assert.True(t, first.Incr(5))
+	assert.False(t, second.Incr(10))
+	assert.False(t, second.Incr(1), "complete bar should not be incremented")
+
+	// every update moves the cursor up to the first bar and redraws both the bars.
+	expected := "\x1b[2A\r\x1b[0K50.000%\n\r\x1b[0K0.000%\n" +
+		"\x1b[2A\r\x1b[0K50.000%\n\r\x1b[0K100.000%\n"
+
+	assert.Equal(t, expected, b.String())
+}
+
+func TestMultiProgress_NotTerminalLogs(t *testing.T) {
+	var b bytes.Buffer
+
+	m := NewMultiProgress(&Out{out: &b})
+	bar := m.AddBar(100)
+
+	for i := 0; i < 25; i++ {
+		bar.Incr(1)
+	}
+
+	bar.Incr(-1)
+	bar.Incr(100)
+
+	assert.Equal(t, "bar 1: 10.000%\nbar 1: 20.000%\nbar 1: 100.000%\n", b.String())
+}
+
+func TestMultiProgress_Concurrent(t *testing.T) {
+	var b bytes.Buffer
+
+	m := &MultiProgress{stream: &Out{terminal{isTerminal: true, fd: 1}, &b}, isTerminal: true, tWidth: 120}
+
+	bars := []*Bar{m.AddBar(50), m.AddBar(100), m.AddBar(150)}
+
+	var wg sync.WaitGroup
+
+	for _, bar := range bars {
+		wg.Add(1)
+
+		go func(bar *Bar) {
+			defer wg.Done()
+
+			for range 150 {
+				bar.Incr(1)
+			}
+		}(bar)
+	}
+
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	lastRedraw := lines[len(lines)-3:]
+
+	for i, line := range lastRedraw {
+		assert.Contains(t, line, "100.000%", "TEST[%d], Failed.\n", i)
+	}
+}
@@ -0,0 +1,158 @@
+package terminal
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// columnGap is the number of spaces between the columns of a table without borders.
+const columnGap = 2
+
+// Table is a TUI component that renders rows of values as aligned columns. When the output is not a terminal,
+// the rows are rendered as tab-separated values so that they can be processed by other programs.
+type Table struct {
+	stream  Output
+	headers []string
+	rows    [][]string
+	border  bool
+}
+
+func NewTable(out Output, headers ...string) *Table {
+	return &Table{
+		stream:  out,
+		headers: headers,
+	}
+}
+
+// WithBorder draws the table with box-drawing borders when the output is a terminal.
+func (t *Table) WithBorder() *Table {
+	t.border = true
+
+	return t
+}
+
+// AddRow adds a row with the given values, formatted using their default format.
+func (t *Table) AddRow(values ...any) *Table {
+	row := make([]string, len(values))
+
+	for i, v := range values {
+		row[i] = fmt.Sprint(v)
+	}
+
+	t.rows = append(t.rows, row)
+
+	return t
+}
+
+// Render prints the table to the output.
+func (t *Table) Render() {
+	out, ok := t.stream.(*Out)
+	if !ok || !out.isTerminal {
+		t.renderPlain()
+
+		return
+	}
+
+	widths := t.columnWidths()
+
+	if t.border {
+		t.renderBordered(widths)
+
+		return
+	}
+
+	if len(t.headers) > 0 {
+		t.stream.Println(alignRow(t.headers, widths))
+	}
+
+	for _, row := range t.rows {
+		t.stream.Println(alignRow(row, widths))
+	}
+}
+
+func (t *Table) renderPlain() {
+	if len(t.headers) > 0 {
+		t.stream.Println(strings.Join(t.headers, "\t"))
+	}
+
+	for _, row := range t.rows {
+		t.stream.Println(strings.Join(row, "\t"))
+	}
+}
+
+func (t *Table) renderBordered(widths []int) {
+	t.stream.Println(borderLine(widths, "┌", "┬", "┐"))
+
+	if len(t.headers) > 0 {
+		t.stream.Println(borderedRow(t.headers, widths))
+		t.stream.Println(borderLine(widths, "├", "┼", "┤"))
+	}
+
+	for _, row := range t.rows {
+		t.stream.Println(borderedRow(row, widths))
+	}
+
+	t.stream.Println(borderLine(widths, "└", "┴", "┘"))
+}
+
+// columnWidths returns the width of the widest cell of every column, rows may have more cells than the headers.
+func (t *Table) columnWidths() []int {
+	widths := make([]int, 0, len(t.headers))
+
+	for _, row := range append([][]string{t.headers}, t.rows...) {
+		for i, cell := range row {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	return widths
+}
+
+func alignRow(row []string, widths []int) string {
+	var b strings.Builder
+
+	for i, width := range widths {
+		b.WriteString(pad(cell(row, i), width+columnGap))
+	}
+
+	return strings.TrimRight(b.String(), " ")
+}
+
+func borderedRow(row []string, widths []int) string {
+	var b strings.Builder
+
+	b.WriteString("│")
+
+	for i, width := range widths {
+		b.WriteString(" " + pad(cell(row, i), width) + " │")
+	}
+
+	return b.String()
+}
+
+func borderLine(widths []int, left, middle, right string) string {
+	segments := make([]string, len(widths))
+
+	for i, width := range widths {
+		segments[i] = strings.Repeat("─", width+2)
+	}
+
+	return left + strings.Join(segments, middle) + right
+}
+
+func cell(row []string, i int) string {
+	if i < len(row) {
+		return row[i]
+	}
+
+	return ""
+}
+
+func pad(s string, width int) string {
+	return s + strings.Repeat(" ", max(width-utf8.RuneCountInString(s), 0))
+}
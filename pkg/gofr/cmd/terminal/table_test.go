@@ -0,0 +1,68 @@
+package terminal
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func tempTerminalOutput() (*Out, *bytes.Buffer) {
+	var b bytes.Buffer
+
+	return &Out{terminal{isTerminal: true, fd: 1}, &b}, &b
+}
+
+func TestTable_Render(t *testing.T) {
+	out, b := tempTerminalOutput()
+
+	NewTable(out, "NAME", "SIZE").
+		AddRow("main.go", 1024).
+		AddRow("go.mod", 64, "extra").
+		Render()
+
+	expected := "NAME     SIZE\n" +
+		"main.go  1024\n" +
+		"go.mod   64    extra\n"
+
+	assert.Equal(t, expected, b.String())
+}
+
+func TestTable_RenderWithBorder(t *testing.T) {
+	out, b := tempTerminalOutput()
+
+	NewTable(out, "NAME", "SIZE").WithBorder().
+		AddRow("main.go", 1024).
+		AddRow("naïve.go", 8).
+		Render()
+
+	expected := "┌──────────┬──────┐\n" +
+		"│ NAME     │ SIZE │\n" +
+		"├──────────┼──────┤\n" +
+		"│ main.go  │ 1024 │\n" +
+		"│ naïve.go │ 8    │\n" +
+		"└──────────┴──────┘\n"
+
+	assert.Equal(t, expected, b.String())
+}
+
+func TestTable_RenderNotTerminal(t *testing.T) {
+	var b bytes.Buffer
+
+	NewTable(&Out{out: &b}, "NAME", "SIZE").WithBorder().
+		AddRow("main.go", 1024).
+		Render()
+
+	assert.Equal(t, "NAME\tSIZE\nmain.go\t1024\n", b.String())
+}
+
+func TestTable_RenderWithoutHeaders(t *testing.T) {
+	out, b := tempTerminalOutput()
+
+	NewTable(out).
+		AddRow("a", "b").
+		AddRow("ccc", "d").
+		Render()
+
+	assert.Equal(t, "a    b\nccc  d\n", b.String())
+}
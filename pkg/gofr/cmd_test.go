@@ -347,3 +347,69 @@ func Test_Run_handler_help(t *testing.T) {
 	// check that only help for the hello subcommand is printed
 	assert.Equal(t, "this a helper string for hello sub command\n", out)
 }
+
+func Test_Run_Flags(t *testing.T) {
+	testCases := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"", "greet"}, "Hello World!"},
+		{[]string{"", "greet", "--name=Gofr"}, "Hello Gofr!"},
+		{[]string{"", "greet", "-name=Gofr"}, "Hello Gofr!"},
+		{[]string{"", "greet", "-n=Gofr"}, "Hello Gofr!"},
+	}
+
+	for i, tc := range testCases {
+		os.Args = tc.args
+
+		c := cmd{}
+
+		c.addRoute("greet", func(c *Context) (any, error) {
+			return "Hello " + c.Flag("name") + "!", nil
+		}, WithFlag("name", "n", "World", "the name to greet"))
+
+		out := testutil.StdoutOutputForFunc(func() {
+			c.Run(container.NewContainer(config.NewMockConfig(map[string]string{})))
+		})
+
+		assert.Equal(t, tc.expected+"\n", out, "TEST[%d], Failed.\n", i)
+	}
+}
+
+func Test_Run_MissingRequiredFlags(t *testing.T) {
+	os.Args = []string{"", "rm", "-force"}
+
+	c := cmd{}
+
+	c.addRoute("rm", func(_ *Context) (any, error) {
+		t.Error("handler should not be called when required flags are missing")
+
+		return nil, nil
+	}, WithRequiredFlag("filename", "f", "the file to remove"), WithRequiredFlag("dir", "", "the directory"))
+
+	out := testutil.StderrOutputForFunc(func() {
+		c.Run(container.NewContainer(config.NewMockConfig(map[string]string{})))
+	})
+
+	assert.Equal(t, "missing required flag(s): filename, dir\n", out)
+}
+
+func Test_Run_HelpListsFlags(t *testing.T) {
+	os.Args = []string{"", "greet", "-h"}
+
+	out := testutil.StdoutOutputForFunc(func() {
+		c := cmd{out: terminal.New()}
+
+		c.addRoute("greet", func(_ *Context) (any, error) {
+			return nil, nil
+		}, AddHelp("greets the user"),
+			WithFlag("name", "n", "World", "the name to greet"),
+			WithRequiredFlag("greeting", "", "the greeting"))
+
+		c.Run(container.NewContainer(config.NewMockConfig(map[string]string{})))
+	})
+
+	assert.Equal(t, "greets the user\nFlags:\n"+
+		"  -n, --name\tthe name to greet (default \"World\")\n"+
+		"  --greeting\tthe greeting (required)\n", out)
+}
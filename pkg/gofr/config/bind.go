@@ -0,0 +1,145 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	errNotStructPointer = errors.New("configs can only be bound to a pointer to a struct")
+	errUnsupportedType  = errors.New("unsupported type")
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Bind populates the fields of the struct pointed to by dst from the configs named in their `env` tags, converting
+// the values to the type of the field. Supported types are string, bool, ints, uints, floats, time.Duration and
+// slices of them, which are read as comma-separated values. Nested structs are bound as well.
+//
+// The `default` tag sets the value used when the config is not set, and a config can be made mandatory by adding
+// required to its tag, e.g. `env:"DB_HOST,required"`. All the missing and invalid configs are reported together
+// in the returned error.
+//
+//	type AppConfig struct {
+//		Port    int           `env:"PORT" default:"8000"`
+//		Timeout time.Duration `env:"TIMEOUT" default:"5s"`
+//		Hosts   []string      `env:"HOSTS,required"`
+//	}
+func Bind(c Config, dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return errNotStructPointer
+	}
+
+	return errors.Join(bindStruct(c, v.Elem())...)
+}
+
+func bindStruct(c Config, v reflect.Value) []error {
+	var errs []error
+
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field, value := t.Field(i), v.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, ok := field.Tag.Lookup("env")
+		if !ok {
+			if value.Kind() == reflect.Struct && field.Type != durationType {
+				errs = append(errs, bindStruct(c, value)...)
+			}
+
+			continue
+		}
+
+		key, options, _ := strings.Cut(tag, ",")
+
+		raw := c.Get(key)
+		if raw == "" {
+			raw = field.Tag.Get("default")
+		}
+
+		if raw == "" {
+			if options == "required" {
+				errs = append(errs, fmt.Errorf("config %s is required", key))
+			}
+
+			continue
+		}
+
+		if err := setValue(value, raw); err != nil {
+			errs = append(errs, fmt.Errorf("invalid value of config %s: %w", key, err))
+		}
+	}
+
+	return errs
+}
+
+func setValue(v reflect.Value, raw string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(int64(d))
+
+		return nil
+	}
+
+	//nolint:exhaustive // unsupported kinds are handled by the default case
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetFloat(f)
+	case reflect.Slice:
+		parts := strings.Split(raw, ",")
+		slice := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+
+		for i, part := range parts {
+			if err := setValue(slice.Index(i), strings.TrimSpace(part)); err != nil {
+				return err
+			}
+		}
+
+		v.Set(slice)
+	default:
+		return fmt.Errorf("%w %v", errUnsupportedType, v.Type())
+	}
+
+	return nil
+}
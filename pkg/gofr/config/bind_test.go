@@ -0,0 +1,99 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type dbConfig struct {
+	Host string `env:"DB_HOST,required"`
+	Port uint16 `env:"DB_PORT" default:"3306"`
+}
+
+type appConfig struct {
+	Name     string        `env:"APP_NAME"`
+	Port     int           `env:"HTTP_PORT" default:"8000"`
+	Debug    bool          `env:"DEBUG"`
+	Ratio    float64       `env:"RATIO"`
+	Timeout  time.Duration `env:"TIMEOUT" default:"5s"`
+	Hosts    []string      `env:"HOSTS"`
+	Weights  []int         `env:"WEIGHTS"`
+	Untagged string
+	DB       dbConfig
+
+	unexported string `env:"APP_NAME"`
+}
+
+func TestBind(t *testing.T) {
+	c := NewMockConfig(map[string]string{
+		"APP_NAME": "gofr",
+		"DEBUG":    "true",
+		"RATIO":    "0.5",
+		"HOSTS":    "a.com, b.com",
+		"WEIGHTS":  "1,2,3",
+		"TIMEOUT":  "1m",
+		"DB_HOST":  "localhost",
+	})
+
+	var cfg appConfig
+
+	err := Bind(c, &cfg)
+
+	require.NoError(t, err)
+	assert.Equal(t, appConfig{
+		Name:    "gofr",
+		Port:    8000,
+		Debug:   true,
+		Ratio:   0.5,
+		Timeout: time.Minute,
+		Hosts:   []string{"a.com", "b.com"},
+		Weights: []int{1, 2, 3},
+		DB:      dbConfig{Host: "localhost", Port: 3306},
+	}, cfg)
+}
+
+func TestBind_Errors(t *testing.T) {
+	c := NewMockConfig(map[string]string{
+		"HTTP_PORT": "eighty",
+		"DEBUG":     "yes please",
+		"DB_PORT":   "70000",
+	})
+
+	var cfg appConfig
+
+	err := Bind(c, &cfg)
+
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "invalid value of config HTTP_PORT")
+	assert.ErrorContains(t, err, "invalid value of config DEBUG")
+	assert.ErrorContains(t, err, "config DB_HOST is required")
+	assert.ErrorContains(t, err, "invalid value of config DB_PORT")
+}
+
+func TestBind_InvalidDestination(t *testing.T) {
+	var (
+		cfg appConfig
+		n   int
+	)
+
+	testCases := []any{nil, cfg, &n, (*appConfig)(nil)}
+
+	for i, tc := range testCases {
+		err := Bind(NewMockConfig(nil), tc)
+
+		assert.Equal(t, errNotStructPointer, err, "TEST[%d], Failed.\n", i)
+	}
+}
+
+func TestBind_UnsupportedType(t *testing.T) {
+	var cfg struct {
+		Values map[string]string `env:"VALUES"`
+	}
+
+	err := Bind(NewMockConfig(map[string]string{"VALUES": "a"}), &cfg)
+
+	assert.ErrorIs(t, err, errUnsupportedType)
+}
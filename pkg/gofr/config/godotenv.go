@@ -6,6 +6,7 @@ import (
 	"io/fs"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/joho/godotenv"
 )
@@ -17,6 +18,14 @@ const (
 
 type EnvLoader struct {
 	logger logger
+	folder string
+
+	// systemEnv holds the environment variables set before the config files were loaded.
+	systemEnv map[string]string
+
+	mu        sync.Mutex
+	values    map[string]string
+	listeners []func(key, oldValue, newValue string)
 }
 
 type logger interface {
@@ -27,7 +36,14 @@ type logger interface {
 }
 
 func NewEnvFile(configFolder string, logger logger) Config {
-	conf := &EnvLoader{logger: logger}
+	conf := &EnvLoader{logger: logger, folder: configFolder, systemEnv: make(map[string]string)}
+
+	for _, envVar := range os.Environ() {
+		if key, value, found := strings.Cut(envVar, "="); found {
+			conf.systemEnv[key] = value
+		}
+	}
+
 	conf.read(configFolder)
 
 	return conf
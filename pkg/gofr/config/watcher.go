@@ -0,0 +1,134 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// Notifier is implemented by the configs which can notify about the changes of their values at runtime.
+type Notifier interface {
+	OnChange(fn func(key, oldValue, newValue string))
+}
+
+// OnChange registers a function which is called for every config whose value changes when the config files
+// are reloaded by Watch. A removed config is notified with an empty new value.
+func (e *EnvLoader) OnChange(fn func(key, oldValue, newValue string)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.listeners = append(e.listeners, fn)
+}
+
+// Watch reloads the config files every interval and applies the changed values, following the same precedence
+// as when they were first loaded. The environment variables set for the process are not overridden by the
+// values of the .env file.
+func (e *EnvLoader) Watch(interval time.Duration) {
+	e.mu.Lock()
+	e.values, _ = e.readFiles()
+	e.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			e.reload()
+		}
+	}()
+}
+
+type change struct {
+	key, oldValue, newValue string
+}
+
+func (e *EnvLoader) reload() {
+	values, err := e.readFiles()
+	if err != nil {
+		e.logger.Warnf("Failed to reload config, Err: %v", err)
+
+		return
+	}
+
+	e.mu.Lock()
+
+	var changes []change
+
+	for key, value := range values {
+		if old := os.Getenv(key); old != value {
+			os.Setenv(key, value)
+
+			changes = append(changes, change{key, old, value})
+		}
+	}
+
+	for key := range e.values {
+		if _, ok := values[key]; ok {
+			continue
+		}
+
+		old := os.Getenv(key)
+
+		// restore the value of the environment variable which was overridden by the removed config
+		if value, ok := e.systemEnv[key]; ok {
+			os.Setenv(key, value)
+			changes = append(changes, change{key, old, value})
+
+			continue
+		}
+
+		os.Unsetenv(key)
+
+		changes = append(changes, change{key, old, ""})
+	}
+
+	e.values = values
+	listeners := e.listeners
+
+	e.mu.Unlock()
+
+	for _, c := range changes {
+		e.logger.Infof("Config %v changed", c.key)
+
+		for _, fn := range listeners {
+			fn(c.key, c.oldValue, c.newValue)
+		}
+	}
+}
+
+// readFiles returns the values of the config files which take effect, i.e. the values of the .env file
+// not set in the environment of the process, overridden by the values of the override file.
+func (e *EnvLoader) readFiles() (map[string]string, error) {
+	values := make(map[string]string)
+
+	defaults, err := godotenv.Read(e.folder + defaultFileName)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	for key, value := range defaults {
+		if _, ok := e.systemEnv[key]; !ok {
+			values[key] = value
+		}
+	}
+
+	overrideFile := e.folder + defaultOverrideFileName
+	if env := e.Get("APP_ENV"); env != "" {
+		overrideFile = fmt.Sprintf("%s/.%s.env", e.folder, env)
+	}
+
+	overrides, err := godotenv.Read(overrideFile)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	for key, value := range overrides {
+		values[key] = value
+	}
+
+	return values, nil
+}
@@ -0,0 +1,116 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr/logging"
+)
+
+func unsetEnv(t *testing.T, keys ...string) {
+	t.Helper()
+
+	t.Cleanup(func() {
+		for _, key := range keys {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+func TestEnvLoader_Reload(t *testing.T) {
+	t.Setenv("APP_ENV", "")
+	t.Setenv("RELOAD_SYSTEM_KEY", "system")
+	unsetEnv(t, "RELOAD_CHANGED_KEY", "RELOAD_REMOVED_KEY", "RELOAD_ADDED_KEY", "RELOAD_SAME_KEY")
+
+	dir := t.TempDir()
+
+	createEnvFile(t, dir, ".env", map[string]string{
+		"RELOAD_CHANGED_KEY": "old",
+		"RELOAD_REMOVED_KEY": "removed",
+		"RELOAD_SAME_KEY":    "same",
+		"RELOAD_SYSTEM_KEY":  "file",
+	})
+
+	env := NewEnvFile(dir, logging.NewMockLogger(logging.DEBUG)).(*EnvLoader)
+	env.Watch(time.Hour)
+
+	changes := make(map[string][2]string)
+
+	env.OnChange(func(key, oldValue, newValue string) {
+		changes[key] = [2]string{oldValue, newValue}
+	})
+
+	createEnvFile(t, dir, ".env", map[string]string{
+		"RELOAD_CHANGED_KEY": "new",
+		"RELOAD_ADDED_KEY":   "added",
+		"RELOAD_SAME_KEY":    "same",
+		"RELOAD_SYSTEM_KEY":  "changed file",
+	})
+
+	env.reload()
+
+	assert.Equal(t, map[string][2]string{
+		"RELOAD_CHANGED_KEY": {"old", "new"},
+		"RELOAD_ADDED_KEY":   {"", "added"},
+		"RELOAD_REMOVED_KEY": {"removed", ""},
+	}, changes)
+
+	assert.Equal(t, "new", env.Get("RELOAD_CHANGED_KEY"))
+	assert.Equal(t, "added", env.Get("RELOAD_ADDED_KEY"))
+	assert.Equal(t, "system", env.Get("RELOAD_SYSTEM_KEY"), "environment variables should not be overridden by .env")
+
+	_, ok := os.LookupEnv("RELOAD_REMOVED_KEY")
+	assert.False(t, ok)
+}
+
+func TestEnvLoader_ReloadOverrideRemoved(t *testing.T) {
+	t.Setenv("APP_ENV", "")
+	t.Setenv("RELOAD_OVERRIDDEN_KEY", "system")
+
+	dir := t.TempDir()
+
+	createEnvFile(t, dir, ".local.env", map[string]string{"RELOAD_OVERRIDDEN_KEY": "local"})
+
+	env := NewEnvFile(dir, logging.NewMockLogger(logging.DEBUG)).(*EnvLoader)
+	env.Watch(time.Hour)
+
+	require.Equal(t, "local", env.Get("RELOAD_OVERRIDDEN_KEY"))
+
+	createEnvFile(t, dir, ".local.env", map[string]string{})
+
+	env.reload()
+
+	assert.Equal(t, "system", env.Get("RELOAD_OVERRIDDEN_KEY"))
+}
+
+func TestEnvLoader_Watch(t *testing.T) {
+	t.Setenv("APP_ENV", "")
+	unsetEnv(t, "WATCHED_KEY")
+
+	dir := t.TempDir()
+
+	createEnvFile(t, dir, ".env", map[string]string{"WATCHED_KEY": "old"})
+
+	env := NewEnvFile(dir, logging.NewMockLogger(logging.DEBUG)).(*EnvLoader)
+
+	changed := make(chan string, 1)
+
+	env.OnChange(func(_, _, value string) {
+		select {
+		case changed <- value:
+		default:
+		}
+	})
+
+	env.Watch(10 * time.Millisecond)
+
+	createEnvFile(t, dir, ".env", map[string]string{"WATCHED_KEY": "new"})
+
+	newValue := <-changed
+
+	assert.Equal(t, "new", newValue)
+}
@@ -0,0 +1,61 @@
+package gofr
+
+import (
+	"time"
+
+	"gofr.dev/pkg/gofr/config"
+	"gofr.dev/pkg/gofr/logging"
+)
+
+type configWatcher interface {
+	config.Notifier
+	Watch(interval time.Duration)
+}
+
+// OnConfigChange registers a function which is called with the old and new value of every config changed at
+// runtime. Configs are reloaded from the config files every CONFIG_RELOAD_INTERVAL, e.g. 30s, if it is set.
+func (a *App) OnConfigChange(fn func(key, oldValue, newValue string)) {
+	n, ok := a.Config.(config.Notifier)
+	if !ok {
+		a.container.Warn("config changes cannot be watched for the configured config source")
+
+		return
+	}
+
+	n.OnChange(fn)
+}
+
+// watchConfig starts reloading the config files if CONFIG_RELOAD_INTERVAL is set, and applies the changes
+// of LOG_LEVEL to the logger.
+func (a *App) watchConfig() {
+	value := a.Config.Get("CONFIG_RELOAD_INTERVAL")
+	if value == "" {
+		return
+	}
+
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		a.container.Errorf("invalid value of config CONFIG_RELOAD_INTERVAL: %v", value)
+
+		return
+	}
+
+	w, ok := a.Config.(configWatcher)
+	if !ok {
+		return
+	}
+
+	w.OnChange(func(key, _, newValue string) {
+		if key == "LOG_LEVEL" {
+			level, moduleLevels := logging.ParseLevels(newValue)
+
+			a.container.Logger.ChangeLevel(level)
+
+			for module, moduleLevel := range moduleLevels {
+				logging.SetModuleLevel(a.container.Logger, module, moduleLevel)
+			}
+		}
+	})
+
+	w.Watch(interval)
+}
@@ -0,0 +1,38 @@
+package gofr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gofr.dev/pkg/gofr/config"
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/logging"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+func TestApp_WatchConfig_InvalidInterval(t *testing.T) {
+	logs := testutil.StderrOutputForFunc(func() {
+		app := &App{
+			Config:    config.NewMockConfig(map[string]string{"CONFIG_RELOAD_INTERVAL": "10"}),
+			container: &container.Container{Logger: logging.NewMockLogger(logging.DEBUG)},
+		}
+
+		app.watchConfig()
+	})
+
+	assert.Contains(t, logs, "invalid value of config CONFIG_RELOAD_INTERVAL: 10")
+}
+
+func TestApp_OnConfigChange_NotSupported(t *testing.T) {
+	logs := testutil.StdoutOutputForFunc(func() {
+		app := &App{
+			Config:    config.NewMockConfig(map[string]string{}),
+			container: &container.Container{Logger: logging.NewMockLogger(logging.DEBUG)},
+		}
+
+		app.OnConfigChange(func(_, _, _ string) {})
+	})
+
+	assert.Contains(t, logs, "config changes cannot be watched")
+}
@@ -0,0 +1,67 @@
+package container
+
+import (
+	"runtime/debug"
+	"time"
+)
+
+// BuildInfo describes the build of the application, reported by the health endpoint.
+type BuildInfo struct {
+	Version   string `json:"version,omitempty"`
+	Commit    string `json:"commit,omitempty"`
+	BuildTime string `json:"buildTime,omitempty"`
+}
+
+// SetBuildInfo sets the build info reported by the health endpoint. The empty fields of info keep their value, which
+// is the commit and time of the build recorded by the Go toolchain, if any, and the version of the app.
+func (c *Container) SetBuildInfo(info BuildInfo) {
+	if info.Version != "" {
+		c.buildInfo.Version = info.Version
+	}
+
+	if info.Commit != "" {
+		c.buildInfo.Commit = info.Commit
+	}
+
+	if info.BuildTime != "" {
+		c.buildInfo.BuildTime = info.BuildTime
+	}
+}
+
+// vcsBuildInfo returns the commit and time of the build recorded by the Go toolchain when building from a repository.
+func vcsBuildInfo() BuildInfo {
+	var info BuildInfo
+
+	build, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+
+	for _, setting := range build.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			info.Commit = setting.Value
+		case "vcs.time":
+			info.BuildTime = setting.Value
+		}
+	}
+
+	return info
+}
+
+func (c *Container) buildHealth(healthMap map[string]any) {
+	if !c.healthBuildInfo {
+		return
+	}
+
+	build := c.buildInfo
+	if build.Version == "" {
+		build.Version = c.GetAppVersion()
+	}
+
+	healthMap["build"] = build
+
+	if !c.startTime.IsZero() {
+		healthMap["uptime"] = time.Since(c.startTime).Round(time.Second).String()
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"time"
 
 	_ "github.com/go-sql-driver/mysql" // This is required to be blank import
+	"go.opentelemetry.io/otel/metric"
+	metricSdk "go.opentelemetry.io/otel/sdk/metric"
 
 	"gofr.dev/pkg/gofr/config"
 	"gofr.dev/pkg/gofr/datasource/file"
@@ -29,6 +31,7 @@ import (
 	"gofr.dev/pkg/gofr/datasource/pubsub/mqtt"
 	"gofr.dev/pkg/gofr/datasource/redis"
 	"gofr.dev/pkg/gofr/datasource/sql"
+	"gofr.dev/pkg/gofr/featureflag"
 	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/logging/remotelogger"
 	"gofr.dev/pkg/gofr/metrics"
@@ -46,6 +49,13 @@ type Container struct {
 	appName    string
 	appVersion string
 
+	buildInfo       BuildInfo
+	healthBuildInfo bool
+	startTime       time.Time
+
+	// shutdownMetrics flushes the metrics pushed over OTLP, if any, and stops the readers of the metrics.
+	shutdownMetrics func(ctx context.Context) error
+
 	Services       map[string]service.HTTP
 	metricsManager metrics.Manager
 	PubSub         pubsub.Client
@@ -65,6 +75,8 @@ type Container struct {
 	KVStore KVStore
 
 	File file.FileSystem
+
+	FeatureFlags *featureflag.FeatureFlags
 }
 
 func NewContainer(conf config.Config) *Container {
@@ -73,8 +85,11 @@ func NewContainer(conf config.Config) *Container {
 	}
 
 	c := &Container{
-		appName:    conf.GetOrDefault("APP_NAME", "gofr-app"),
-		appVersion: conf.GetOrDefault("APP_VERSION", "dev"),
+		appName:         conf.GetOrDefault("APP_NAME", "gofr-app"),
+		appVersion:      conf.GetOrDefault("APP_VERSION", "dev"),
+		buildInfo:       vcsBuildInfo(),
+		healthBuildInfo: !strings.EqualFold(conf.Get("HEALTH_BUILD_INFO"), "false"),
+		startTime:       time.Now(),
 	}
 
 	c.Create(conf)
@@ -97,8 +112,13 @@ func (c *Container) Create(conf config.Config) {
 			levelFetchConfig = 15
 		}
 
-		c.Logger = remotelogger.New(logging.GetLevelFromString(conf.Get("LOG_LEVEL")), conf.Get("REMOTE_LOG_URL"),
-			time.Duration(levelFetchConfig)*time.Second)
+		level, moduleLevels := logging.ParseLevels(conf.Get("LOG_LEVEL"))
+
+		c.Logger = remotelogger.New(level, conf.Get("REMOTE_LOG_URL"), time.Duration(levelFetchConfig)*time.Second)
+
+		for module, moduleLevel := range moduleLevels {
+			logging.SetModuleLevel(c.Logger, module, moduleLevel)
+		}
 
 		if err != nil {
 			c.Logger.Error("invalid value for REMOTE_LOG_FETCH_INTERVAL. setting default of 15 sec.")
@@ -107,68 +127,182 @@ func (c *Container) Create(conf config.Config) {
 
 	c.Logger.Debug("Container is being created")
 
-	c.metricsManager = metrics.NewMetricsManager(exporters.Prometheus(c.GetAppName(), c.GetAppVersion()), c.Logger)
+	c.metricsManager = metrics.NewMetricsManager(c.createMeter(conf), c.Logger,
+		metrics.WithLabelValueLimit(c.labelValueLimit(conf)))
 
 	// Register framework metrics
-	c.registerFrameworkMetrics()
+	c.registerFrameworkMetrics(conf)
 
 	// Populating an instance of app_info with the app details, the value is set as 1 to depict the no. of instances
 	c.Metrics().SetGauge("app_info", 1,
 		"app_name", c.GetAppName(), "app_version", c.GetAppVersion(), "framework_version", version.Framework)
 
-	c.Redis = redis.NewClient(conf, c.Logger, c.metricsManager)
+	redisClient := redis.NewClient(conf, logging.ForModule(c.Logger, "redis"), c.metricsManager)
+
+	// the client embedded in the datasource is nil for a Redis Cluster, whose commands are sent by the cluster client
+	if cluster := redisClient.Cluster(); cluster != nil {
+		c.Redis = cluster
+	} else {
+		c.Redis = redisClient
+	}
 
-	c.SQL = sql.NewSQL(conf, c.Logger, c.metricsManager)
+	c.SQL = sql.NewSQL(conf, logging.ForModule(c.Logger, "sql"), c.metricsManager)
 
 	switch strings.ToUpper(conf.Get("PUBSUB_BACKEND")) {
 	case "KAFKA":
 		if conf.Get("PUBSUB_BROKER") != "" {
-			partition, _ := strconv.Atoi(conf.GetOrDefault("PARTITION_SIZE", "0"))
-			offSet, _ := strconv.Atoi(conf.GetOrDefault("PUBSUB_OFFSET", "-1"))
-			batchSize, _ := strconv.Atoi(conf.GetOrDefault("KAFKA_BATCH_SIZE", strconv.Itoa(kafka.DefaultBatchSize)))
-			batchBytes, _ := strconv.Atoi(conf.GetOrDefault("KAFKA_BATCH_BYTES", strconv.Itoa(kafka.DefaultBatchBytes)))
-			batchTimeout, _ := strconv.Atoi(conf.GetOrDefault("KAFKA_BATCH_TIMEOUT", strconv.Itoa(kafka.DefaultBatchTimeout)))
-
-			c.PubSub = kafka.New(kafka.Config{
-				Broker:          conf.Get("PUBSUB_BROKER"),
-				Partition:       partition,
-				ConsumerGroupID: conf.Get("CONSUMER_ID"),
-				OffSet:          offSet,
-				BatchSize:       batchSize,
-				BatchBytes:      batchBytes,
-				BatchTimeout:    batchTimeout,
-			}, c.Logger, c.metricsManager)
+			c.PubSub = c.createKafkaPubSub(conf)
 		}
 	case "GOOGLE":
 		c.PubSub = google.New(google.Config{
 			ProjectID:        conf.Get("GOOGLE_PROJECT_ID"),
 			SubscriptionName: conf.Get("GOOGLE_SUBSCRIPTION_NAME"),
-		}, c.Logger, c.metricsManager)
+		}, logging.ForModule(c.Logger, "pubsub"), c.metricsManager)
 	case "MQTT":
 		c.PubSub = c.createMqttPubSub(conf)
 	}
 
 	c.File = file.New(c.Logger)
+
+	if path := conf.Get("FEATURE_FLAGS_FILE"); path != "" {
+		interval, err := time.ParseDuration(conf.GetOrDefault("FEATURE_FLAGS_RELOAD_INTERVAL", "30s"))
+		if err != nil {
+			c.Logger.Errorf("invalid value %v for FEATURE_FLAGS_RELOAD_INTERVAL, Err: %v", conf.Get("FEATURE_FLAGS_RELOAD_INTERVAL"), err)
+		}
+
+		c.FeatureFlags = featureflag.New(featureflag.NewFileProvider(path, interval, c.Logger))
+	}
 }
 
 func (c *Container) Close() error {
 	var err error
 
-	if !isNil(c.SQL) {
+	if !IsNil(c.SQL) {
 		err = errors.Join(err, c.SQL.Close())
 	}
 
-	if !isNil(c.Redis) {
+	if !IsNil(c.Redis) {
 		err = errors.Join(err, c.Redis.Close())
 	}
 
-	if !isNil(c.PubSub) {
+	if !IsNil(c.PubSub) {
 		err = errors.Join(err, c.PubSub.Close())
 	}
 
+	for _, svc := range c.Services {
+		if !IsNil(svc) {
+			err = errors.Join(err, svc.Close())
+		}
+	}
+
+	err = errors.Join(err, c.FeatureFlags.Close())
+
+	if c.shutdownMetrics != nil {
+		err = errors.Join(err, c.shutdownMetrics(context.Background()))
+	}
+
 	return err
 }
 
+// createMeter returns the meter of the metrics of the application, which are exposed on the Prometheus endpoint of
+// the metrics server and/or pushed over OTLP, as set in METRICS_EXPORTER. The Prometheus endpoint is used when the
+// OTLP exporter cannot be created, so that the metrics are not lost.
+func (c *Container) createMeter(conf config.Config) metric.Meter {
+	var readers []metricSdk.Reader
+
+	for _, name := range strings.Split(conf.GetOrDefault("METRICS_EXPORTER", "prometheus"), ",") {
+		var (
+			reader metricSdk.Reader
+			err    error
+		)
+
+		switch name = strings.ToLower(strings.TrimSpace(name)); name {
+		case "prometheus":
+			reader, err = exporters.PrometheusReader()
+		case "otlp":
+			var interval time.Duration
+
+			interval, err = time.ParseDuration(conf.GetOrDefault("METRICS_EXPORT_INTERVAL", "60s"))
+			if err == nil {
+				reader, err = exporters.OTLPReader(exporters.OTLPConfig{
+					Endpoint:   conf.Get("METRICS_EXPORTER_URL"),
+					Protocol:   conf.Get("METRICS_EXPORTER_PROTOCOL"),
+					Interval:   interval,
+					AuthHeader: conf.Get("METRICS_EXPORTER_AUTH_KEY"),
+					Insecure:   strings.EqualFold(conf.Get("METRICS_EXPORTER_INSECURE"), "true"),
+				})
+			}
+
+			if err == nil {
+				c.Logger.Infof("Exporting metrics over OTLP to %s", conf.Get("METRICS_EXPORTER_URL"))
+			}
+		default:
+			c.Logger.Errorf("unsupported METRICS_EXPORTER: %s, supported exporters are - prometheus, otlp", name)
+
+			continue
+		}
+
+		if err != nil {
+			c.Logger.Errorf("unable to create %s metrics exporter, error: %v", name, err)
+
+			continue
+		}
+
+		readers = append(readers, reader)
+	}
+
+	if len(readers) == 0 {
+		reader, err := exporters.PrometheusReader()
+		if err != nil {
+			return nil
+		}
+
+		readers = append(readers, reader)
+	}
+
+	// the exemplars link the metrics to the traces, so they are only recorded when the traces are exported
+	exemplars := conf.Get("TRACE_EXPORTER") != "" && !strings.EqualFold(conf.Get("METRICS_EXEMPLARS"), "false")
+
+	provider := exporters.NewMeterProvider(c.GetAppName(), exemplars, readers...)
+	c.shutdownMetrics = provider.Shutdown
+
+	return provider.Meter(c.GetAppName(), metric.WithInstrumentationVersion(c.GetAppVersion()))
+}
+
+// createKafkaPubSub returns the Kafka client configured by the PUBSUB_* and KAFKA_* configs, logging the invalid values.
+func (c *Container) createKafkaPubSub(conf config.Config) pubsub.Client {
+	partition, _ := strconv.Atoi(conf.GetOrDefault("PARTITION_SIZE", "0"))
+	offSet, err := strconv.Atoi(conf.GetOrDefault("PUBSUB_OFFSET", "-1"))
+	if err != nil {
+		c.Logger.Errorf("invalid value %v for PUBSUB_OFFSET, Err: %v", conf.Get("PUBSUB_OFFSET"), err)
+
+		offSet = -1
+	}
+
+	batchSize, _ := strconv.Atoi(conf.GetOrDefault("KAFKA_BATCH_SIZE", strconv.Itoa(kafka.DefaultBatchSize)))
+	batchBytes, _ := strconv.Atoi(conf.GetOrDefault("KAFKA_BATCH_BYTES", strconv.Itoa(kafka.DefaultBatchBytes)))
+	batchTimeout, _ := strconv.Atoi(conf.GetOrDefault("KAFKA_BATCH_TIMEOUT", strconv.Itoa(kafka.DefaultBatchTimeout)))
+
+	// the messages are committed one by one when the interval is invalid
+	commitInterval, err := time.ParseDuration(conf.GetOrDefault("KAFKA_COMMIT_INTERVAL", "0s"))
+	if err != nil {
+		c.Logger.Errorf("invalid value %v for KAFKA_COMMIT_INTERVAL, Err: %v", conf.Get("KAFKA_COMMIT_INTERVAL"), err)
+	}
+
+	return kafka.New(kafka.Config{
+		Broker:          conf.Get("PUBSUB_BROKER"),
+		Partition:       partition,
+		ConsumerGroupID: conf.Get("CONSUMER_ID"),
+		OffSet:          offSet,
+		BatchSize:       batchSize,
+		BatchBytes:      batchBytes,
+		BatchTimeout:    batchTimeout,
+		AutoOffsetReset: conf.Get("KAFKA_AUTO_OFFSET_RESET"),
+		CommitMode:      conf.GetOrDefault("KAFKA_COMMIT_MODE", kafka.CommitModeManual),
+		CommitInterval:  commitInterval,
+	}, logging.ForModule(c.Logger, "pubsub"), c.metricsManager)
+}
+
 func (c *Container) createMqttPubSub(conf config.Config) pubsub.Client {
 	var qos byte
 
@@ -182,6 +316,9 @@ func (c *Container) createMqttPubSub(conf config.Config) pubsub.Client {
 		c.Logger.Debug("MQTT_KEEP_ALIVE is not set or invalid, setting it to 30 seconds")
 	}
 
+	persistentSession, _ := strconv.ParseBool(conf.GetOrDefault("MQTT_PERSISTENT_SESSION", "false"))
+	maxReconnectInterval, _ := time.ParseDuration(conf.Get("MQTT_MAX_RECONNECT_INTERVAL"))
+
 	switch conf.Get("MQTT_QOS") {
 	case "1":
 		qos = 1
@@ -202,9 +339,12 @@ func (c *Container) createMqttPubSub(conf config.Config) pubsub.Client {
 		Order:        order,
 		KeepAlive:    keepAlive,
 		CloseTimeout: 0 * time.Millisecond,
+
+		PersistentSession:    persistentSession,
+		MaxReconnectInterval: maxReconnectInterval,
 	}
 
-	return mqtt.New(configs, c.Logger, c.metricsManager)
+	return mqtt.New(configs, logging.ForModule(c.Logger, "pubsub"), c.metricsManager)
 }
 
 // GetHTTPService returns registered HTTP services.
@@ -217,7 +357,7 @@ func (c *Container) Metrics() metrics.Manager {
 	return c.metricsManager
 }
 
-func (c *Container) registerFrameworkMetrics() {
+func (c *Container) registerFrameworkMetrics(conf config.Config) {
 	// system info metrics
 	c.Metrics().NewGauge("app_info", "Info for app_name, app_version and framework_version.")
 	c.Metrics().NewGauge("app_go_routines", "Number of Go routines running.")
@@ -226,22 +366,64 @@ func (c *Container) registerFrameworkMetrics() {
 	c.Metrics().NewGauge("app_go_numGC", "Number of completed Garbage Collector cycles.")
 	c.Metrics().NewGauge("app_go_sys", "Number of total bytes of memory.")
 
+	c.Metrics().NewCounter("app_panics_total", "Number of panics recovered in HTTP handlers, subscribers, cron jobs, background functions, event handlers and shutdown hooks.")
+
 	{ // HTTP metrics
-		httpBuckets := []float64{.001, .003, .005, .01, .02, .03, .05, .1, .2, .3, .5, .75, 1, 2, 3, 5, 10, 30}
+		httpBuckets := c.latencyBuckets(conf, "METRICS_HTTP_BUCKETS",
+			.001, .003, .005, .01, .02, .03, .05, .1, .2, .3, .5, .75, 1, 2, 3, 5, 10, 30)
 		c.Metrics().NewHistogram("app_http_response", "Response time of HTTP requests in seconds.", httpBuckets...)
+
+		// the per route histogram duplicates app_http_response with more series, so it is only registered when enabled
+		if strings.EqualFold(conf.Get("METRICS_ROUTE_LATENCY"), "true") {
+			c.Metrics().NewHistogram("app_http_route_duration", "Response time of HTTP requests per route template in seconds.",
+				httpBuckets...)
+		}
+
 		c.Metrics().NewHistogram("app_http_service_response", "Response time of HTTP service requests in seconds.", httpBuckets...)
+		c.Metrics().NewCounter("app_http_panics_total", "Number of panics recovered in HTTP handlers.")
+		c.Metrics().NewCounter("app_http_service_retries_total", "Number of retried HTTP service requests.")
+		c.Metrics().NewGauge("app_http_service_circuit_state", "State of the circuit breaker of HTTP services, 0 closed, 1 open, 2 half-open.")
+		c.Metrics().NewUpDownCounter("app_http_service_in_flight", "Number of HTTP service requests in flight through a bulkhead.")
+		c.Metrics().NewCounter("app_http_service_bulkhead_rejected_total", "Number of HTTP service requests rejected by a bulkhead.")
+		c.Metrics().NewCounter("app_http_service_rate_limited_total", "Number of HTTP service requests rejected by a rate limiter.")
 	}
 
 	{ // Redis metrics
-		redisBuckets := []float64{.05, .075, .1, .125, .15, .2, .3, .5, .75, 1, 1.25, 1.5, 2, 2.5, 3}
+		redisBuckets := c.latencyBuckets(conf, "METRICS_REDIS_BUCKETS", .05, .075, .1, .125, .15, .2, .3, .5, .75, 1, 1.25, 1.5, 2, 2.5, 3)
 		c.Metrics().NewHistogram("app_redis_stats", "Response time of Redis commands in milliseconds.", redisBuckets...)
+		c.Metrics().NewGauge("app_redis_total_connections", "Number of total connections in the Redis pool.")
+		c.Metrics().NewGauge("app_redis_idle_connections", "Number of idle connections in the Redis pool.")
+		c.Metrics().NewGauge("app_redis_pool_hits", "Number of times a free connection was found in the Redis pool.")
+		c.Metrics().NewGauge("app_redis_pool_misses", "Number of times a free connection was not found in the Redis pool.")
+		c.Metrics().NewGauge("app_redis_pool_timeouts", "Number of times a wait for a Redis connection timed out.")
 	}
 
 	{ // SQL metrics
-		sqlBuckets := []float64{.05, .075, .1, .125, .15, .2, .3, .5, .75, 1, 2, 3, 4, 5, 7.5, 10}
+		sqlBuckets := c.latencyBuckets(conf, "METRICS_SQL_BUCKETS", .05, .075, .1, .125, .15, .2, .3, .5, .75, 1, 2, 3, 4, 5, 7.5, 10)
 		c.Metrics().NewHistogram("app_sql_stats", "Response time of SQL queries in milliseconds.", sqlBuckets...)
 		c.Metrics().NewGauge("app_sql_open_connections", "Number of open SQL connections.")
 		c.Metrics().NewGauge("app_sql_inUse_connections", "Number of inUse SQL connections.")
+		c.Metrics().NewGauge("app_sql_idle_connections", "Number of idle SQL connections.")
+		c.Metrics().NewGauge("app_sql_wait_count", "Number of times a SQL connection was waited for.")
+		c.Metrics().NewGauge("app_sql_wait_duration", "Total time spent waiting for SQL connections in seconds.")
+	}
+
+	{ // WebSocket metrics
+		durationBuckets := []float64{.1, .5, 1, 5, 10, 30, 60, 300, 600, 1800, 3600}
+		c.Metrics().NewUpDownCounter("app_websocket_connections", "Number of open WebSocket connections per route.")
+		c.Metrics().NewHistogram("app_websocket_connection_duration", "Duration of WebSocket connections in seconds.",
+			durationBuckets...)
+		c.Metrics().NewCounter("app_websocket_messages_received_total", "Number of messages received on WebSocket connections.")
+		c.Metrics().NewCounter("app_websocket_messages_sent_total", "Number of messages sent on WebSocket connections.")
+		c.Metrics().NewCounter("app_websocket_received_bytes_total", "Number of bytes received on WebSocket connections.")
+		c.Metrics().NewCounter("app_websocket_sent_bytes_total", "Number of bytes sent on WebSocket connections.")
+		c.Metrics().NewCounter("app_websocket_errors_total", "Number of read and write errors on WebSocket connections.")
+	}
+
+	{ // WebSocket client metrics
+		c.Metrics().NewUpDownCounter("app_websocket_client_connections", "Number of open WebSocket client connections.")
+		c.Metrics().NewCounter("app_websocket_client_reconnects_total", "Number of WebSocket client reconnections.")
+		c.Metrics().NewCounter("app_websocket_client_dial_errors_total", "Number of failed WebSocket client connection attempts.")
 	}
 
 	// pubsub metrics
@@ -249,6 +431,47 @@ func (c *Container) registerFrameworkMetrics() {
 	c.Metrics().NewCounter("app_pubsub_publish_success_count", "Number of successful publish operations.")
 	c.Metrics().NewCounter("app_pubsub_subscribe_total_count", "Number of total subscribe operations.")
 	c.Metrics().NewCounter("app_pubsub_subscribe_success_count", "Number of successful subscribe operations.")
+	c.Metrics().NewCounter("app_pubsub_handler_success_count", "Number of messages handled successfully by the subscribers.")
+	c.Metrics().NewCounter("app_pubsub_handler_error_count", "Number of messages whose subscriber returned an error.")
+	c.Metrics().NewHistogram("app_pubsub_handler_duration", "Time taken by the subscribers to handle messages in seconds.",
+		.001, .005, .01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60)
+	c.Metrics().NewGauge("app_pubsub_consumer_lag", "Number of messages behind the latest message of the partition.")
+}
+
+// latencyBuckets returns the buckets of the latency histograms set in the config with the key, as comma separated
+// boundaries, or the default ones if the config is not set or is invalid.
+func (c *Container) latencyBuckets(conf config.Config, key string, defaults ...float64) []float64 {
+	value := conf.Get(key)
+	if value == "" {
+		return defaults
+	}
+
+	buckets, err := metrics.ParseBuckets(value)
+	if err != nil {
+		c.Logger.Errorf("invalid value of config %s, using the default buckets, err: %v", key, err)
+
+		return defaults
+	}
+
+	return buckets
+}
+
+// labelValueLimit returns the limit of the distinct values of a label of a metric set in METRICS_MAX_LABEL_VALUES, or 0,
+// which disables the limit, if it is not set or invalid.
+func (c *Container) labelValueLimit(conf config.Config) int {
+	value := conf.Get("METRICS_MAX_LABEL_VALUES")
+	if value == "" {
+		return 0
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 {
+		c.Logger.Errorf("invalid value %v for METRICS_MAX_LABEL_VALUES, the label values are not limited", value)
+
+		return 0
+	}
+
+	return limit
 }
 
 func (c *Container) GetAppName() string {
@@ -2,11 +2,17 @@ package container
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gorilla/websocket"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/mock/gomock"
 
 	"gofr.dev/pkg/gofr/config"
@@ -15,6 +21,7 @@ import (
 	gofrSql "gofr.dev/pkg/gofr/datasource/sql"
 	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/service"
+	"gofr.dev/pkg/gofr/testutil"
 	ws "gofr.dev/pkg/gofr/websocket"
 )
 
@@ -64,6 +71,21 @@ func Test_newContainerPubSubInitializationFail(t *testing.T) {
 	}
 }
 
+func TestContainer_KafkaInvalidConfigs(t *testing.T) {
+	logs := testutil.StderrOutputForFunc(func() {
+		c := &Container{Logger: logging.NewMockLogger(logging.ERROR)}
+
+		c.createKafkaPubSub(config.NewMockConfig(map[string]string{
+			"PUBSUB_BROKER":         "localhost:0",
+			"PUBSUB_OFFSET":         "latest",
+			"KAFKA_COMMIT_INTERVAL": "often",
+		}))
+	})
+
+	assert.Contains(t, logs, "invalid value latest for PUBSUB_OFFSET")
+	assert.Contains(t, logs, "invalid value often for KAFKA_COMMIT_INTERVAL")
+}
+
 func TestContainer_MQTTInitialization_Default(t *testing.T) {
 	configs := map[string]string{
 		"PUBSUB_BACKEND": "MQTT",
@@ -163,14 +185,17 @@ func TestContainer_Close(t *testing.T) {
 	mockDB, sqlMock, _ := gofrSql.NewSQLMocks(t)
 	mockRedis := NewMockRedis(controller)
 	mockPubSub := &MockPubSub{}
+	mockService := service.NewMockHTTP(controller)
 
 	mockRedis.EXPECT().Close().Return(nil)
+	mockService.EXPECT().Close().Return(nil)
 	sqlMock.ExpectClose()
 
 	c := NewContainer(config.NewMockConfig(nil))
 	c.SQL = &sqlMockDB{mockDB, &expectedQuery{}, logging.NewLogger(logging.DEBUG)}
 	c.Redis = mockRedis
 	c.PubSub = mockPubSub
+	c.Services = map[string]service.HTTP{"orders": mockService}
 
 	assert.NotNil(t, c.PubSub)
 
@@ -178,6 +203,181 @@ func TestContainer_Close(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestContainer_OTLPMetrics(t *testing.T) {
+	received := make(chan string, 1)
+
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case received <- r.URL.Path:
+		default:
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	c := NewContainer(config.NewMockConfig(map[string]string{
+		"METRICS_EXPORTER":          "prometheus, otlp",
+		"METRICS_EXPORTER_URL":      strings.TrimPrefix(collector.URL, "http://"),
+		"METRICS_EXPORTER_PROTOCOL": "http",
+		"METRICS_EXPORTER_INSECURE": "true",
+	}))
+
+	require.NotNil(t, c.Metrics())
+	require.NotNil(t, c.shutdownMetrics)
+
+	// the metrics are pushed on close, without waiting for the export interval
+	require.NoError(t, c.Close())
+
+	assert.Equal(t, "/v1/metrics", <-received)
+}
+
+func TestContainer_MetricsExporterFallback(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		configs map[string]string
+	}{
+		{"unsupported exporter", map[string]string{"METRICS_EXPORTER": "statsd"}},
+		{"otlp without url", map[string]string{"METRICS_EXPORTER": "otlp"}},
+		{"otlp with invalid protocol", map[string]string{"METRICS_EXPORTER": "otlp",
+			"METRICS_EXPORTER_URL": "localhost:4317", "METRICS_EXPORTER_PROTOCOL": "udp"}},
+		{"otlp with invalid interval", map[string]string{"METRICS_EXPORTER": "otlp",
+			"METRICS_EXPORTER_URL": "localhost:4317", "METRICS_EXPORT_INTERVAL": "often"}},
+	}
+
+	for i, tc := range testCases {
+		c := NewContainer(config.NewMockConfig(tc.configs))
+
+		assert.NotNil(t, c.Metrics(), "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.NotNil(t, c.shutdownMetrics, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestContainer_MetricsExemplars(t *testing.T) {
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "request")
+	defer span.End()
+
+	traceID := span.SpanContext().TraceID().String()
+
+	testCases := []struct {
+		desc      string
+		configs   map[string]string
+		exemplars bool
+	}{
+		{"tracing enabled", map[string]string{"APP_NAME": "exemplars-enabled", "TRACE_EXPORTER": "gofr"}, true},
+		{"exemplars disabled", map[string]string{"APP_NAME": "exemplars-disabled", "TRACE_EXPORTER": "gofr",
+			"METRICS_EXEMPLARS": "false"}, false},
+		{"tracing disabled", map[string]string{"APP_NAME": "exemplars-no-tracing"}, false},
+	}
+
+	for i, tc := range testCases {
+		c := NewContainer(config.NewMockConfig(tc.configs))
+
+		name := strings.ReplaceAll(tc.configs["APP_NAME"], "-", "_") + "_duration"
+
+		c.Metrics().NewHistogram(name, "duration", 1, 10)
+		c.Metrics().RecordHistogram(ctx, name, 2)
+
+		// the metrics of the other containers of the tests are duplicated in the default registry, which is reported as
+		// an error along with the metrics gathered
+		families, _ := prometheus.DefaultGatherer.Gather()
+
+		var bucket *dto.Bucket
+
+		for _, family := range families {
+			if family.GetName() == name {
+				bucket = family.GetMetric()[0].GetHistogram().GetBucket()[1]
+			}
+		}
+
+		require.NotNil(t, bucket, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.exemplars, hasLabel(bucket.GetExemplar().GetLabel(), "trace_id", traceID),
+			"TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func hasLabel(labels []*dto.LabelPair, name, value string) bool {
+	for _, label := range labels {
+		if label.GetName() == name && label.GetValue() == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestContainer_LatencyBuckets(t *testing.T) {
+	c := &Container{Logger: logging.NewMockLogger(logging.DEBUG)}
+
+	testCases := []struct {
+		desc    string
+		value   string
+		buckets []float64
+	}{
+		{"not set", "", []float64{1, 2}},
+		{"set", "0.1, 0.5, 1", []float64{0.1, 0.5, 1}},
+		{"unsorted", "1,0.5", []float64{1, 2}},
+		{"not a number", "0.1,fast", []float64{1, 2}},
+	}
+
+	for i, tc := range testCases {
+		conf := config.NewMockConfig(map[string]string{"METRICS_HTTP_BUCKETS": tc.value})
+
+		assert.Equal(t, tc.buckets, c.latencyBuckets(conf, "METRICS_HTTP_BUCKETS", 1, 2), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestContainer_LabelValueLimit(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		value string
+		limit int
+		log   string
+	}{
+		{"not set", "", 0, ""},
+		{"set", "100", 100, ""},
+		{"not a number", "many", 0, "invalid value many for METRICS_MAX_LABEL_VALUES"},
+		{"not positive", "-1", 0, "invalid value -1 for METRICS_MAX_LABEL_VALUES"},
+	}
+
+	for i, tc := range testCases {
+		var limit int
+
+		logs := testutil.StderrOutputForFunc(func() {
+			c := &Container{Logger: logging.NewMockLogger(logging.DEBUG)}
+
+			limit = c.labelValueLimit(config.NewMockConfig(map[string]string{"METRICS_MAX_LABEL_VALUES": tc.value}))
+		})
+
+		assert.Equal(t, tc.limit, limit, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Contains(t, logs, tc.log, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestContainer_RouteLatencyHistogram(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		value      string
+		registered bool
+	}{
+		{"not set", "", false},
+		{"disabled", "false", false},
+		{"enabled", "true", true},
+	}
+
+	for i, tc := range testCases {
+		logs := testutil.StderrOutputForFunc(func() {
+			c := &Container{Logger: logging.NewMockLogger(logging.ERROR)}
+			c.Create(config.NewMockConfig(map[string]string{"METRICS_ROUTE_LATENCY": tc.value}))
+
+			c.Metrics().RecordHistogram(context.Background(), "app_http_route_duration", 1)
+		})
+
+		assert.Equal(t, !tc.registered, strings.Contains(logs, "Metrics app_http_route_duration is not registered"),
+			"TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
 func Test_GetConnectionFromContext(t *testing.T) {
 	tests := []struct {
 		name     string
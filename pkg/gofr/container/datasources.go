@@ -29,6 +29,30 @@ type DB interface {
 	Close() error
 }
 
+// NamedDB is implemented by the SQL datasources binding named parameters, like the one of GoFr. It is separate from DB
+// so that the existing implementations of DB are not broken, and is used by type asserting the DB:
+//
+//	if named, ok := ctx.SQL.(container.NamedDB); ok {
+//		_, err := named.NamedExecContext(ctx, "INSERT INTO customers (id, name) VALUES (:id, :name)", customer)
+//	}
+type NamedDB interface {
+	// NamedExecContext and NamedQueryContext bind the :name parameters of the query from a struct or a map.
+	NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error)
+	NamedQueryContext(ctx context.Context, query string, arg any) (*sql.Rows, error)
+}
+
+// RawDB is implemented by the SQL datasources giving access to their underlying *sql.DB, like the one of GoFr. It is
+// separate from DB so that the existing implementations of DB are not broken, and is used by type asserting the DB:
+//
+//	if raw, ok := ctx.SQL.(container.RawDB); ok {
+//		conn, err := raw.Raw().Conn(ctx)
+//	}
+type RawDB interface {
+	// Raw returns the underlying *sql.DB, e.g. to use sql.Conn for session-scoped settings or driver-specific
+	// operations. The operations done on it are not logged or recorded in the metrics.
+	Raw() *sql.DB
+}
+
 type Redis interface {
 	redis.Cmdable
 	redis.HashCmdable
@@ -276,6 +300,19 @@ type MongoProvider interface {
 	provider
 }
 
+// MongoAggregator is implemented by the MongoDB clients supporting aggregation pipelines, like the one of GoFr. It is
+// separate from Mongo so that the existing implementations of Mongo are not broken, and is used by type asserting the
+// client:
+//
+//	if aggregator, ok := ctx.Mongo.(container.MongoAggregator); ok {
+//		err := aggregator.Aggregate(ctx, "orders", pipeline, &results)
+//	}
+type MongoAggregator interface {
+	// Aggregate runs an aggregation pipeline on a collection and stores the resulting documents
+	// into the provided results interface.
+	Aggregate(ctx context.Context, collection string, pipeline any, results any) error
+}
+
 // SurrealDB defines an interface representing a SurrealDB client with common database operations.
 type SurrealDB interface {
 
@@ -344,6 +381,23 @@ type KVStoreProvider interface {
 	provider
 }
 
+// BatchKVStore is implemented by the key-value stores supporting batch operations, like the BadgerDB one. It is
+// separate from KVStore so that the existing implementations of KVStore are not broken, and is used by type asserting
+// the store:
+//
+//	if store, ok := ctx.KVStore.(container.BatchKVStore); ok {
+//		values, err := store.GetAll(ctx, []string{"a", "b"})
+//	}
+type BatchKVStore interface {
+	KVStore
+
+	// GetAll returns the values of the keys which exist. SetAll and DeleteAll apply the changes in batches.
+	// When the operations fail for some of the keys, the returned error reports which keys failed.
+	GetAll(ctx context.Context, keys []string) (map[string]string, error)
+	SetAll(ctx context.Context, kv map[string]string) error
+	DeleteAll(ctx context.Context, keys []string) error
+}
+
 type PubSubProvider interface {
 	pubsub.Client
 
@@ -13,7 +13,7 @@ func (c *Container) Health(ctx context.Context) any {
 
 	const statusDown = "DOWN"
 
-	if !isNil(c.SQL) {
+	if !IsNil(c.SQL) {
 		health := c.SQL.HealthCheck()
 		if health.Status == statusDown {
 			downCount++
@@ -22,7 +22,7 @@ func (c *Container) Health(ctx context.Context) any {
 		healthMap["sql"] = health
 	}
 
-	if !isNil(c.Redis) {
+	if !IsNil(c.Redis) {
 		health := c.Redis.HealthCheck()
 		if health.Status == statusDown {
 			downCount++
@@ -69,7 +69,7 @@ func checkExternalDBHealth(ctx context.Context, c *Container, healthMap map[stri
 	}
 
 	for name, service := range services {
-		if !isNil(service) {
+		if !IsNil(service) {
 			health, err := service.HealthCheck(ctx)
 			if err != nil {
 				downCount++
@@ -86,6 +86,8 @@ func (c *Container) appHealth(healthMap map[string]any, downCount int) {
 	healthMap["name"] = c.GetAppName()
 	healthMap["version"] = c.GetAppVersion()
 
+	c.buildHealth(healthMap)
+
 	if downCount == 0 {
 		healthMap["status"] = "UP"
 	} else {
@@ -93,7 +95,8 @@ func (c *Container) appHealth(healthMap map[string]any, downCount int) {
 	}
 }
 
-func isNil(i any) bool {
+// IsNil reports whether i is nil, or an interface holding a nil pointer, e.g. a datasource which is not configured.
+func IsNil(i any) bool {
 	// Get the value of the interface
 	val := reflect.ValueOf(i)
 
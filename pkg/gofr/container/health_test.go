@@ -7,9 +7,11 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"gofr.dev/pkg/gofr/config"
 	"gofr.dev/pkg/gofr/datasource"
 	"gofr.dev/pkg/gofr/datasource/sql"
 	"gofr.dev/pkg/gofr/logging"
@@ -184,3 +186,31 @@ func registerMocks(mocks *Mocks, health string) {
 		},
 	}, nil)
 }
+
+func TestContainer_HealthBuildInfo(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		config map[string]string
+		build  any
+	}{
+		{desc: "build info set", config: map[string]string{"APP_VERSION": "v1.0.0"},
+			build: BuildInfo{Version: "v1.0.0", Commit: "abc123", BuildTime: "2024-01-01T00:00:00Z"}},
+		{desc: "build info disabled", config: map[string]string{"HEALTH_BUILD_INFO": "false"}},
+	}
+
+	for i, tc := range testCases {
+		c := NewContainer(config.NewMockConfig(tc.config))
+		c.SetBuildInfo(BuildInfo{Commit: "abc123", BuildTime: "2024-01-01T00:00:00Z"})
+		c.startTime = time.Now().Add(-time.Minute)
+
+		health, _ := c.Health(context.Background()).(map[string]any)
+
+		assert.Equal(t, tc.build, health["build"], "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		if tc.build != nil {
+			assert.Equal(t, "1m0s", health["uptime"], "TEST[%d], Failed.\n%s", i, tc.desc)
+		} else {
+			assert.NotContains(t, health, "uptime", "TEST[%d], Failed.\n%s", i, tc.desc)
+		}
+	}
+}
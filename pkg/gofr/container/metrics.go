@@ -1,6 +1,8 @@
 package container
 
-import "context"
+import (
+	"context"
+)
 
 type Metrics interface {
 	NewCounter(name, desc string)
@@ -254,6 +254,98 @@ func (mr *MockDBMockRecorder) Select(ctx, data, query any, args ...any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Select", reflect.TypeOf((*MockDB)(nil).Select), varargs...)
 }
 
+// MockNamedDB is a mock of NamedDB interface.
+type MockNamedDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockNamedDBMockRecorder
+	isgomock struct{}
+}
+
+// MockNamedDBMockRecorder is the mock recorder for MockNamedDB.
+type MockNamedDBMockRecorder struct {
+	mock *MockNamedDB
+}
+
+// NewMockNamedDB creates a new mock instance.
+func NewMockNamedDB(ctrl *gomock.Controller) *MockNamedDB {
+	mock := &MockNamedDB{ctrl: ctrl}
+	mock.recorder = &MockNamedDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNamedDB) EXPECT() *MockNamedDBMockRecorder {
+	return m.recorder
+}
+
+// NamedExecContext mocks base method.
+func (m *MockNamedDB) NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamedExecContext", ctx, query, arg)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NamedExecContext indicates an expected call of NamedExecContext.
+func (mr *MockNamedDBMockRecorder) NamedExecContext(ctx, query, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamedExecContext", reflect.TypeOf((*MockNamedDB)(nil).NamedExecContext), ctx, query, arg)
+}
+
+// NamedQueryContext mocks base method.
+func (m *MockNamedDB) NamedQueryContext(ctx context.Context, query string, arg any) (*sql.Rows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamedQueryContext", ctx, query, arg)
+	ret0, _ := ret[0].(*sql.Rows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NamedQueryContext indicates an expected call of NamedQueryContext.
+func (mr *MockNamedDBMockRecorder) NamedQueryContext(ctx, query, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamedQueryContext", reflect.TypeOf((*MockNamedDB)(nil).NamedQueryContext), ctx, query, arg)
+}
+
+// MockRawDB is a mock of RawDB interface.
+type MockRawDB struct {
+	ctrl     *gomock.Controller
+	recorder *MockRawDBMockRecorder
+	isgomock struct{}
+}
+
+// MockRawDBMockRecorder is the mock recorder for MockRawDB.
+type MockRawDBMockRecorder struct {
+	mock *MockRawDB
+}
+
+// NewMockRawDB creates a new mock instance.
+func NewMockRawDB(ctrl *gomock.Controller) *MockRawDB {
+	mock := &MockRawDB{ctrl: ctrl}
+	mock.recorder = &MockRawDBMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRawDB) EXPECT() *MockRawDBMockRecorder {
+	return m.recorder
+}
+
+// Raw mocks base method.
+func (m *MockRawDB) Raw() *sql.DB {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Raw")
+	ret0, _ := ret[0].(*sql.DB)
+	return ret0
+}
+
+// Raw indicates an expected call of Raw.
+func (mr *MockRawDBMockRecorder) Raw() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Raw", reflect.TypeOf((*MockRawDB)(nil).Raw))
+}
+
 // MockRedis is a mock of Redis interface.
 type MockRedis struct {
 	ctrl     *gomock.Controller
@@ -9752,6 +9844,44 @@ func (mr *MockMongoProviderMockRecorder) UseTracer(tracer any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTracer", reflect.TypeOf((*MockMongoProvider)(nil).UseTracer), tracer)
 }
 
+// MockMongoAggregator is a mock of MongoAggregator interface.
+type MockMongoAggregator struct {
+	ctrl     *gomock.Controller
+	recorder *MockMongoAggregatorMockRecorder
+	isgomock struct{}
+}
+
+// MockMongoAggregatorMockRecorder is the mock recorder for MockMongoAggregator.
+type MockMongoAggregatorMockRecorder struct {
+	mock *MockMongoAggregator
+}
+
+// NewMockMongoAggregator creates a new mock instance.
+func NewMockMongoAggregator(ctrl *gomock.Controller) *MockMongoAggregator {
+	mock := &MockMongoAggregator{ctrl: ctrl}
+	mock.recorder = &MockMongoAggregatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMongoAggregator) EXPECT() *MockMongoAggregatorMockRecorder {
+	return m.recorder
+}
+
+// Aggregate mocks base method.
+func (m *MockMongoAggregator) Aggregate(ctx context.Context, collection string, pipeline, results any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aggregate", ctx, collection, pipeline, results)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Aggregate indicates an expected call of Aggregate.
+func (mr *MockMongoAggregatorMockRecorder) Aggregate(ctx, collection, pipeline, results any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockMongoAggregator)(nil).Aggregate), ctx, collection, pipeline, results)
+}
+
 // Mockprovider is a mock of provider interface.
 type Mockprovider struct {
 	ctrl     *gomock.Controller
@@ -10075,6 +10205,131 @@ func (mr *MockKVStoreProviderMockRecorder) UseTracer(tracer any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTracer", reflect.TypeOf((*MockKVStoreProvider)(nil).UseTracer), tracer)
 }
 
+// MockBatchKVStore is a mock of BatchKVStore interface.
+type MockBatchKVStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchKVStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchKVStoreMockRecorder is the mock recorder for MockBatchKVStore.
+type MockBatchKVStoreMockRecorder struct {
+	mock *MockBatchKVStore
+}
+
+// NewMockBatchKVStore creates a new mock instance.
+func NewMockBatchKVStore(ctrl *gomock.Controller) *MockBatchKVStore {
+	mock := &MockBatchKVStore{ctrl: ctrl}
+	mock.recorder = &MockBatchKVStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchKVStore) EXPECT() *MockBatchKVStoreMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBatchKVStore) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBatchKVStoreMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBatchKVStore)(nil).Delete), ctx, key)
+}
+
+// DeleteAll mocks base method.
+func (m *MockBatchKVStore) DeleteAll(ctx context.Context, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAll", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAll indicates an expected call of DeleteAll.
+func (mr *MockBatchKVStoreMockRecorder) DeleteAll(ctx, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAll", reflect.TypeOf((*MockBatchKVStore)(nil).DeleteAll), ctx, keys)
+}
+
+// Get mocks base method.
+func (m *MockBatchKVStore) Get(ctx context.Context, key string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBatchKVStoreMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBatchKVStore)(nil).Get), ctx, key)
+}
+
+// GetAll mocks base method.
+func (m *MockBatchKVStore) GetAll(ctx context.Context, keys []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, keys)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockBatchKVStoreMockRecorder) GetAll(ctx, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockBatchKVStore)(nil).GetAll), ctx, keys)
+}
+
+// HealthCheck mocks base method.
+func (m *MockBatchKVStore) HealthCheck(arg0 context.Context) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthCheck", arg0)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HealthCheck indicates an expected call of HealthCheck.
+func (mr *MockBatchKVStoreMockRecorder) HealthCheck(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockBatchKVStore)(nil).HealthCheck), arg0)
+}
+
+// Set mocks base method.
+func (m *MockBatchKVStore) Set(ctx context.Context, key, value string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockBatchKVStoreMockRecorder) Set(ctx, key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockBatchKVStore)(nil).Set), ctx, key, value)
+}
+
+// SetAll mocks base method.
+func (m *MockBatchKVStore) SetAll(ctx context.Context, kv map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAll", ctx, kv)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAll indicates an expected call of SetAll.
+func (mr *MockBatchKVStoreMockRecorder) SetAll(ctx, kv any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAll", reflect.TypeOf((*MockBatchKVStore)(nil).SetAll), ctx, kv)
+}
+
 // MockPubSubProvider is a mock of PubSubProvider interface.
 type MockPubSubProvider struct {
 	ctrl     *gomock.Controller
@@ -2,15 +2,30 @@ package gofr
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"maps"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"runtime/debug"
+	"slices"
+	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/gorilla/websocket"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
 	"go.opentelemetry.io/otel/trace"
 
 	"gofr.dev/pkg/gofr/cmd/terminal"
 	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
 	"gofr.dev/pkg/gofr/http/middleware"
+	"gofr.dev/pkg/gofr/http/requestid"
+	"gofr.dev/pkg/gofr/logging"
 )
 
 type Context struct {
@@ -31,6 +46,12 @@ type Context struct {
 
 	// Terminal needs to be public as CMD applications need to access various terminal user interface(TUI) features.
 	Out terminal.Output
+
+	// In is used by CMD applications to prompt the user for input.
+	In terminal.Input
+
+	// flags holds the values of the flags declared for the subcommand of a CMD application.
+	flags map[string]string
 }
 
 type AuthInfo interface {
@@ -67,10 +88,276 @@ func (c *Context) Trace(name string) trace.Span {
 	return span
 }
 
+// TraceID returns the trace ID of the span associated with the request, or an empty string if the request is not traced.
+func (c *Context) TraceID() string {
+	traceID := trace.SpanFromContext(c.Context).SpanContext().TraceID()
+	if !traceID.IsValid() {
+		return ""
+	}
+
+	return traceID.String()
+}
+
+// SpanSetAttributes sets the attributes on the current span, i.e. the last span started by Trace which is not
+// ended, or the span of the request.
+//
+//	ctx.SpanSetAttributes(attribute.String("tenant", tenant), attribute.Int("items", len(items)))
+func (c *Context) SpanSetAttributes(kv ...attribute.KeyValue) {
+	trace.SpanFromContext(c.Context).SetAttributes(kv...)
+}
+
+// SpanAddEvent adds an event with the attributes to the current span, i.e. the last span started by Trace which is
+// not ended, or the span of the request.
+func (c *Context) SpanAddEvent(name string, kv ...attribute.KeyValue) {
+	trace.SpanFromContext(c.Context).AddEvent(name, trace.WithAttributes(kv...))
+}
+
+// SetBaggage sets the OpenTelemetry baggage member with the key, which is propagated along with the trace to the
+// services called, and the messages published, using the context afterward.
+func (c *Context) SetBaggage(key, value string) error {
+	member, err := baggage.NewMemberRaw(key, value)
+	if err != nil {
+		return err
+	}
+
+	bag, err := baggage.FromContext(c.Context).SetMember(member)
+	if err != nil {
+		return err
+	}
+
+	c.Context = baggage.ContextWithBaggage(c.Context, bag)
+
+	return nil
+}
+
+// Baggage returns the value of the OpenTelemetry baggage member with the key, set using SetBaggage or received
+// along with the trace of the request, or an empty string if there is none.
+func (c *Context) Baggage(key string) string {
+	return baggage.FromContext(c.Context).Member(key).Value()
+}
+
+// RequestID returns the ID of the HTTP request, adopted from its X-Request-Id header or generated, or an empty string
+// if the context does not belong to an HTTP request. The ID is echoed in the X-Request-Id header of the response,
+// and is sent in the requests made to the HTTP services using the context.
+func (c *Context) RequestID() string {
+	return requestid.FromContext(c.Context)
+}
+
+// WithFields returns a logger which adds the fields to its logs, along with the fields added earlier in the request
+// and the ID of the request. The fields are also added to the log written when the request completes. A custom
+// logger which is not a logging.FieldLogger is returned as it is.
+//
+//	log := ctx.WithFields(map[string]any{"user_id": userID, "tenant": tenant})
+//	log.Infof("order %v placed", orderID)
+func (c *Context) WithFields(fields map[string]any) logging.Logger {
+	return logging.WithFields(c.Logger, middleware.AddLogFields(c.Context, fields))
+}
+
+// Background runs fn in a new goroutine, e.g. to send an email or publish an event without delaying the response.
+// The context passed to fn keeps the trace, baggage, request ID and log fields of the request, and its logger adds
+// the trace and request IDs to the logs, so that they can be correlated with the request. Unlike the context of the
+// request, it is not cancelled when the request completes, times out or the client disconnects, so fn is expected
+// to bound its own work, e.g. using context.WithTimeout. The body of the request must not be read in fn, and fn is
+// not waited for when the application shuts down. A panic of fn is recovered and logged with its stack trace.
+func (c *Context) Background(fn func(ctx *Context)) {
+	bg := c.detach()
+
+	go func() {
+		defer func() {
+			if re := recover(); re != nil {
+				bg.Logger.Error(panicLog{TraceID: bg.TraceID(), RequestID: bg.RequestID(), Error: fmt.Sprint(re),
+					StackTrace: string(debug.Stack())})
+				countPanic(bg, bg.Container, "background")
+			}
+		}()
+
+		fn(bg)
+	}()
+}
+
+// detach returns a copy of the context which is not cancelled along with it, whose logger adds the trace ID,
+// request ID and log fields of the request to the logs.
+func (c *Context) detach() *Context {
+	return &Context{
+		Context:   context.WithoutCancel(c.Context),
+		Request:   c.Request,
+		Container: correlatedContainer(c.Context, c.Container),
+		flags:     c.flags,
+	}
+}
+
+// correlatedContainer returns a copy of the container whose logger adds the trace ID, request ID and log fields of ctx
+// to the logs, so that the logs of the work done on behalf of a request can be correlated with its other logs.
+func correlatedContainer(ctx context.Context, c *container.Container) *container.Container {
+	if c == nil {
+		return nil
+	}
+
+	cntnr := *c
+
+	if cntnr.Logger != nil {
+		fields := map[string]any{}
+		maps.Copy(fields, middleware.AddLogFields(ctx, nil))
+
+		if id := trace.SpanFromContext(ctx).SpanContext().TraceID(); id.IsValid() {
+			fields["trace_id"] = id.String()
+		}
+
+		if id := requestid.FromContext(ctx); id != "" {
+			fields["request_id"] = id
+		}
+
+		cntnr.Logger = logging.WithFields(cntnr.Logger, fields)
+	}
+
+	return &cntnr
+}
+
+// Flag returns the value of a flag declared for the subcommand using WithFlag or WithRequiredFlag,
+// or the default value of the flag if it was not passed.
+func (c *Context) Flag(name string) string {
+	return c.flags[name]
+}
+
 func (c *Context) Bind(i any) error {
 	return c.Request.Bind(i)
 }
 
+// BindAndValidate binds the request like Bind, and validates the struct using its `validate` tags. When fields fail
+// the validation, it returns a gofrHTTP.ErrorValidation listing all of them, which is responded with status 400.
+//
+//	type User struct {
+//		Name string `json:"name" validate:"required,min=3"`
+//		Age  int    `json:"age" validate:"gte=18"`
+//	}
+func (c *Context) BindAndValidate(i any) error {
+	if err := c.Bind(i); err != nil {
+		return err
+	}
+
+	return gofrHTTP.Validate(i)
+}
+
+// Bind binds the request to a new value of type T like ctx.Bind, and returns it, so that it need not be declared first.
+//
+//	user, err := gofr.Bind[CreateUserRequest](ctx)
+func Bind[T any](ctx *Context) (T, error) {
+	var v T
+
+	if err := ctx.Bind(&v); err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	return v, nil
+}
+
+// BindAndValidate binds the request to a new value of type T and validates it like ctx.BindAndValidate, and returns it.
+//
+//	user, err := gofr.BindAndValidate[CreateUserRequest](ctx)
+func BindAndValidate[T any](ctx *Context) (T, error) {
+	var v T
+
+	if err := ctx.BindAndValidate(&v); err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	return v, nil
+}
+
+// StreamFiles streams the files of a multipart/form-data request to handle as they are read from the request body,
+// instead of buffering the whole upload like Bind, and returns the form fields of the request. The size of the
+// request and the number of files are bounded by limits, exceeding which returns an error responded with status 413.
+//
+//	fields, err := ctx.StreamFiles(gofrHTTP.UploadLimits{MaxSize: 1 << 30, MaxFiles: 5}, func(f *gofrHTTP.FilePart) error {
+//		dst, err := ctx.File.Create(f.FileName)
+//		if err != nil {
+//			return err
+//		}
+//		defer dst.Close()
+//
+//		_, err = io.Copy(dst, f)
+//
+//		return err
+//	})
+func (c *Context) StreamFiles(limits gofrHTTP.UploadLimits, handle func(file *gofrHTTP.FilePart) error) (url.Values, error) {
+	r, ok := c.Request.(*gofrHTTP.Request)
+	if !ok {
+		return nil, errStreamingNotSupported
+	}
+
+	return r.StreamFiles(limits, handle)
+}
+
+// rawBodyRequest is implemented by the requests whose body can be read as it is, i.e. the HTTP requests.
+type rawBodyRequest interface {
+	RawBody() ([]byte, error)
+}
+
+// RawBody returns the body of the HTTP request as it is, whatever its content type, e.g. to read text, protobuf or
+// file contents which cannot be bound using Bind. The body can still be bound after it is read, and bodies larger
+// than 32 MB return an error responded with status 413.
+func (c *Context) RawBody() ([]byte, error) {
+	r, ok := c.Request.(rawBodyRequest)
+	if !ok {
+		return nil, errRawBodyNotSupported
+	}
+
+	return r.RawBody()
+}
+
+// formRequest is implemented by the requests carrying a form, i.e. the HTTP requests.
+type formRequest interface {
+	Form() url.Values
+	FormValues(key string) []string
+	FormFiles(key string) []*multipart.FileHeader
+}
+
+// Form returns all the fields of the multipart/form-data or application/x-www-form-urlencoded form submitted in the
+// body of the request, e.g. to handle forms whose fields are not known in advance. It returns nil if the request
+// carries no form.
+func (c *Context) Form() url.Values {
+	r, ok := c.Request.(formRequest)
+	if !ok {
+		return nil
+	}
+
+	return r.Form()
+}
+
+// FormValue returns the first value of the form field, or an empty string if it is not set.
+func (c *Context) FormValue(key string) string {
+	if values := c.FormValues(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// FormValues returns all the values of the form field, e.g. of the repeated fields.
+func (c *Context) FormValues(key string) []string {
+	r, ok := c.Request.(formRequest)
+	if !ok {
+		return nil
+	}
+
+	return r.FormValues(key)
+}
+
+// FormFiles returns the headers of the files uploaded in the multipart form field, which are opened using Open.
+// The files beyond the first 32 MB of the form are buffered on disk, and removed once the request is handled.
+func (c *Context) FormFiles(key string) []*multipart.FileHeader {
+	r, ok := c.Request.(formRequest)
+	if !ok {
+		return nil
+	}
+
+	return r.FormFiles(key)
+}
+
 // WriteMessageToSocket writes a message to the WebSocket connection associated with the context.
 // The data parameter can be of type string, []byte, or any struct that can be marshaled to JSON.
 // It retrieves the WebSocket connection from the context and sends the message as a TextMessage.
@@ -86,6 +373,128 @@ func (c *Context) WriteMessageToSocket(data any) error {
 	return conn.WriteMessage(websocket.TextMessage, message)
 }
 
+// headerRequest is implemented by the requests which have headers, i.e. the HTTP requests and WebSocket handshakes.
+type headerRequest interface {
+	Header(key string) string
+	Headers() http.Header
+}
+
+// Header returns the value of the header of the request with the given name, or an empty string if the request,
+// e.g. of a cmd application, has no headers.
+func (c *Context) Header(name string) string {
+	r, ok := c.Request.(headerRequest)
+	if !ok {
+		return ""
+	}
+
+	return r.Header(name)
+}
+
+// Headers returns a copy of the headers of the request, or nil if the request has no headers.
+func (c *Context) Headers() http.Header {
+	r, ok := c.Request.(headerRequest)
+	if !ok {
+		return nil
+	}
+
+	return r.Headers()
+}
+
+// BearerToken returns the token of the Authorization header of the request if it uses the Bearer scheme,
+// and an empty string otherwise.
+func (c *Context) BearerToken() string {
+	scheme, token, ok := strings.Cut(c.Header("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+
+	return strings.TrimSpace(token)
+}
+
+// ClientIP returns the IP of the client of the HTTP request. The X-Forwarded-For and X-Real-IP headers are only used
+// when the request is received from one of the trusted proxies, configured using TRUSTED_PROXIES or
+// App.SetTrustedProxies, and the IP of the peer is returned otherwise. It returns an empty string for the requests
+// which are not HTTP requests.
+func (c *Context) ClientIP() string {
+	return middleware.ClientIPFromContext(c.Request.Context())
+}
+
+// IsClientGone returns true once the client of the HTTP request has closed the connection, e.g. a client of a long
+// poll or a stream which went away. The context of the request is cancelled then, so the datasource and HTTP service
+// calls made with it fail, and handlers doing expensive work can check it to stop early. It returns false for the
+// requests which are not HTTP requests, and when the request timed out.
+func (c *Context) IsClientGone() bool {
+	r, ok := c.Request.(*gofrHTTP.Request)
+	if !ok {
+		return false
+	}
+
+	return errors.Is(r.Context().Err(), context.Canceled)
+}
+
+// tlsRequest is implemented by the requests which may be received over TLS, i.e. the HTTP requests and WebSocket
+// handshakes.
+type tlsRequest interface {
+	TLS() *tls.ConnectionState
+}
+
+// TLSConnectionState returns the TLS state of the connection of the request, or nil if the request was not received
+// over TLS, e.g. of a cmd application or a plaintext HTTP server.
+func (c *Context) TLSConnectionState() *tls.ConnectionState {
+	r, ok := c.Request.(tlsRequest)
+	if !ok {
+		return nil
+	}
+
+	return r.TLS()
+}
+
+// ClientCertCN returns the common name of the client certificate of the request. It is only set when the server
+// verifies the client certificates, i.e. CLIENT_CA_FILE is configured, and is empty otherwise.
+func (c *Context) ClientCertCN() string {
+	state := c.TLSConnectionState()
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return ""
+	}
+
+	return state.VerifiedChains[0][0].Subject.CommonName
+}
+
+var (
+	errStreamingNotSupported = errors.New("streaming files is only supported for HTTP requests")
+	errRawBodyNotSupported   = errors.New("reading the raw body is only supported for HTTP requests")
+	errSessionAuthNotEnabled = errors.New("session authentication is not enabled")
+)
+
+// Session returns the session of the request when the session authentication is enabled using
+// App.EnableSessionAuth, and nil otherwise.
+func (c *Context) Session() *Session {
+	session, _ := c.Request.Context().Value(middleware.SessionKey).(*Session)
+
+	return session
+}
+
+// Login starts a new session storing values, e.g. the ID of the user which has been authenticated, and issues the
+// session and CSRF cookies in the response.
+func (c *Context) Login(values map[string]string) error {
+	session := c.Session()
+	if session == nil {
+		return errSessionAuthNotEnabled
+	}
+
+	return session.Login(c, values)
+}
+
+// Logout deletes the session of the request and expires its cookies in the response.
+func (c *Context) Logout() error {
+	session := c.Session()
+	if session == nil {
+		return errSessionAuthNotEnabled
+	}
+
+	return session.Logout(c)
+}
+
 type authInfo struct {
 	claims   jwt.MapClaims
 	username string
@@ -111,6 +520,14 @@ func (c *Context) GetAuthInfo() AuthInfo {
 	}
 }
 
+// HasScope returns true if the token validated by the OAuth authentication grants the scope. The scopes are read from
+// the claim set in OAUTH_SCOPE_CLAIM, or from the scope or scp claim by default.
+func (c *Context) HasScope(scope string) bool {
+	scopes, _ := c.Request.Context().Value(middleware.JWTScopes).([]string)
+
+	return slices.Contains(scopes, scope)
+}
+
 // GetClaims returns a response of jwt.MapClaims type when OAuth is enabled.
 // It returns nil if called, when OAuth is not enabled.
 func (a *authInfo) GetClaims() jwt.MapClaims {
@@ -137,20 +554,30 @@ func (a *authInfo) GetAPIKey() string {
 // }
 
 func newContext(w Responder, r Request, c *container.Container) *Context {
-	return &Context{
+	ctx := &Context{
 		Context:   r.Context(),
 		Request:   r,
 		responder: w,
 		Container: c,
 	}
+
+	// the logs written using ctx.Logger carry the ID of the request, so that they can be correlated with its other logs
+	if id := requestid.FromContext(ctx.Context); id != "" && c != nil && c.Logger != nil {
+		cntnr := *c
+		cntnr.Logger = logging.WithFields(c.Logger, map[string]any{"request_id": id})
+		ctx.Container = &cntnr
+	}
+
+	return ctx
 }
 
-func newCMDContext(w Responder, r Request, c *container.Container, out terminal.Output) *Context {
+func newCMDContext(w Responder, r Request, c *container.Container, out terminal.Output, in terminal.Input) *Context {
 	return &Context{
 		Context:   r.Context(),
 		responder: w,
 		Request:   r,
 		Container: c,
 		Out:       out,
+		In:        in,
 	}
 }
@@ -3,25 +3,38 @@ package gofr
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/mock/gomock"
 
+	cmd2 "gofr.dev/pkg/gofr/cmd"
 	"gofr.dev/pkg/gofr/config"
 	"gofr.dev/pkg/gofr/container"
 	gofrHTTP "gofr.dev/pkg/gofr/http"
 	"gofr.dev/pkg/gofr/http/middleware"
+	"gofr.dev/pkg/gofr/http/requestid"
 	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/testutil"
 	"gofr.dev/pkg/gofr/version"
+	gofrWebSocket "gofr.dev/pkg/gofr/websocket"
 )
 
 func Test_newContextSuccess(t *testing.T) {
@@ -46,6 +59,28 @@ func Test_newContextSuccess(t *testing.T) {
 	require.NoError(t, err, "TEST Failed \n unable to read body")
 }
 
+func Test_newContextRequestIDLogger(t *testing.T) {
+	c := &container.Container{}
+
+	logs := testutil.StdoutOutputForFunc(func() {
+		c.Logger = logging.NewLogger(logging.INFO)
+
+		req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+		req = req.WithContext(requestid.NewContext(req.Context(), "req-1"))
+
+		ctx := newContext(nil, gofrHTTP.NewRequest(req), c)
+
+		ctx.Logger.Info("order placed")
+		ctx.WithFields(map[string]any{"user_id": 42}).Info("order shipped")
+		c.Logger.Info("app started")
+	})
+
+	assert.Contains(t, logs, `"message":"order placed","fields":{"request_id":"req-1"}`)
+	assert.Contains(t, logs, `"message":"order shipped","fields":{"request_id":"req-1","user_id":42}`)
+
+	assert.Contains(t, logs, `"message":"app started","gofrVersion"`, "the logger of the container must not be changed")
+}
+
 func TestContext_AddTrace(t *testing.T) {
 	tp := trace.NewTracerProvider()
 	otel.SetTracerProvider(tp)
@@ -76,6 +111,76 @@ func TestContext_AddTrace(t *testing.T) {
 	assert.NotEqual(t, spanID, newSpanID)
 }
 
+func TestContext_TraceID(t *testing.T) {
+	tp := trace.NewTracerProvider()
+	otel.SetTracerProvider(tp)
+
+	tracedCtx, span := otel.GetTracerProvider().Tracer("gofr-"+version.Framework).Start(context.Background(), "start")
+	defer span.End()
+
+	ctx := Context{Context: tracedCtx}
+
+	assert.Equal(t, span.SpanContext().TraceID().String(), ctx.TraceID())
+
+	ctx = Context{Context: context.Background()}
+
+	assert.Empty(t, ctx.TraceID())
+}
+
+func TestContext_SpanAttributesAndEvents(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(trace.NewTracerProvider(trace.WithSpanProcessor(recorder)))
+
+	tracedCtx, rootSpan := otel.GetTracerProvider().Tracer("gofr-"+version.Framework).Start(context.Background(), "root")
+
+	ctx := Context{Context: tracedCtx}
+
+	ctx.SpanSetAttributes(attribute.String("tenant", "acme"))
+
+	span := ctx.Trace("work")
+	ctx.SpanSetAttributes(attribute.Int("items", 3))
+	ctx.SpanAddEvent("cache miss", attribute.String("key", "user:1"))
+	span.End()
+
+	rootSpan.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, "work", spans[0].Name())
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("items", 3)}, spans[0].Attributes())
+	require.Len(t, spans[0].Events(), 1)
+	assert.Equal(t, "cache miss", spans[0].Events()[0].Name)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("key", "user:1")}, spans[0].Events()[0].Attributes)
+
+	assert.Equal(t, "root", spans[1].Name())
+	assert.Equal(t, []attribute.KeyValue{attribute.String("tenant", "acme")}, spans[1].Attributes())
+}
+
+func TestContext_Baggage(t *testing.T) {
+	propagator := propagation.Baggage{}
+
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	req.Header.Set("Baggage", "tenant=acme")
+
+	ctx := Context{Context: propagator.Extract(req.Context(), propagation.HeaderCarrier(req.Header))}
+
+	assert.Equal(t, "acme", ctx.Baggage("tenant"), "baggage received with the request")
+	assert.Empty(t, ctx.Baggage("user"))
+
+	require.NoError(t, ctx.SetBaggage("user", "jane doe"))
+	require.Error(t, ctx.SetBaggage("", "value"))
+
+	assert.Equal(t, "jane doe", ctx.Baggage("user"))
+	assert.Equal(t, "acme", ctx.Baggage("tenant"))
+
+	header := http.Header{}
+	propagator.Inject(ctx, propagation.HeaderCarrier(header))
+
+	assert.Contains(t, header.Get("Baggage"), "user=jane%20doe", "baggage propagated downstream")
+	assert.Contains(t, header.Get("Baggage"), "tenant=acme", "baggage propagated downstream")
+}
+
 func TestContext_WriteMessageToSocket(t *testing.T) {
 	port := testutil.GetFreePort(t)
 
@@ -183,3 +288,311 @@ func TestGetAuthInfo_JWTClaims(t *testing.T) {
 
 	assert.Equal(t, claims, res)
 }
+
+func TestRequireScope(t *testing.T) {
+	handler := RequireScope("users:write", func(*Context) (any, error) {
+		return "ok", nil
+	})
+
+	tests := []struct {
+		desc   string
+		scopes any
+		resp   any
+		err    error
+	}{
+		{"scope granted", []string{"users:read", "users:write"}, "ok", nil},
+		{"scope not granted", []string{"users:read"}, nil, gofrHTTP.ErrorInsufficientScope{Scope: "users:write"}},
+		{"no scopes", nil, nil, gofrHTTP.ErrorInsufficientScope{Scope: "users:write"}},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		req = req.WithContext(context.WithValue(req.Context(), middleware.JWTScopes, tc.scopes))
+
+		c := &Context{Context: req.Context(), Request: gofrHTTP.NewRequest(req)}
+
+		resp, err := handler(c)
+
+		assert.Equal(t, tc.resp, resp, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.err, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestContext_WithFields(t *testing.T) {
+	logs := testutil.StdoutOutputForFunc(func() {
+		c := &Context{
+			Context:   context.Background(),
+			Container: &container.Container{Logger: logging.NewLogger(logging.INFO)},
+		}
+
+		c.WithFields(map[string]any{"user_id": 42}).Info("order placed")
+	})
+
+	assert.Contains(t, logs, `"message":"order placed","fields":{"user_id":42}`)
+}
+
+func TestContext_Background(t *testing.T) {
+	otel.SetTracerProvider(trace.NewTracerProvider())
+
+	tracedCtx, span := otel.GetTracerProvider().Tracer("gofr-"+version.Framework).Start(context.Background(), "request")
+	defer span.End()
+
+	reqCtx, cancel := context.WithCancel(requestid.NewContext(tracedCtx, "req-1"))
+
+	done := make(chan error)
+
+	logs := testutil.StdoutOutputForFunc(func() {
+		c := &Context{
+			Context:   reqCtx,
+			Container: &container.Container{Logger: logging.NewLogger(logging.INFO)},
+		}
+
+		c.Background(func(ctx *Context) {
+			cancel()
+
+			ctx.Logger.Info("email sent")
+
+			done <- ctx.Err()
+		})
+
+		require.NoError(t, <-done)
+	})
+
+	assert.Contains(t, logs, `"message":"email sent"`)
+	assert.Contains(t, logs, `"request_id":"req-1"`)
+	assert.Contains(t, logs, `"trace_id":"`+span.SpanContext().TraceID().String()+`"`)
+}
+
+func TestContext_BackgroundPanic(t *testing.T) {
+	c, mocks := container.NewMockContainer(t)
+
+	done := make(chan struct{})
+
+	mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_panics_total", "source", "background").
+		Do(func(context.Context, string, ...string) { close(done) })
+
+	ctx := &Context{Context: context.Background(), Container: c}
+
+	logs := testutil.StderrOutputForFunc(func() {
+		c.Logger = logging.NewMockLogger(logging.ERROR)
+
+		ctx.Background(func(*Context) { panic("background panic") })
+
+		<-done
+	})
+
+	assert.Contains(t, logs, "background panic")
+}
+
+func TestContext_Headers(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		authorization string
+		token         string
+	}{
+		{desc: "bearer token", authorization: "Bearer abc.def.ghi", token: "abc.def.ghi"},
+		{desc: "lowercase scheme", authorization: "bearer abc.def.ghi", token: "abc.def.ghi"},
+		{desc: "basic auth", authorization: "Basic dXNlcjpwYXNz", token: ""},
+		{desc: "no authorization header", authorization: "", token: ""},
+	}
+
+	for i, tc := range testCases {
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		req.Header.Set("X-Tenant", "acme")
+
+		if tc.authorization != "" {
+			req.Header.Set("Authorization", tc.authorization)
+		}
+
+		ctx := &Context{Context: req.Context(), Request: gofrHTTP.NewRequest(req)}
+
+		assert.Equal(t, "acme", ctx.Header("x-tenant"), "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, "acme", ctx.Headers().Get("X-Tenant"), "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.token, ctx.BearerToken(), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestContext_HeadersWithoutHTTPRequest(t *testing.T) {
+	ctx := &Context{Context: context.Background(), Request: &cmd2.Request{}}
+
+	assert.Empty(t, ctx.Header("Authorization"))
+	assert.Nil(t, ctx.Headers())
+	assert.Empty(t, ctx.BearerToken())
+}
+
+func TestContext_RawBody(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("plain text"))
+	req.Header.Set("Content-Type", "text/plain")
+
+	ctx := &Context{Context: req.Context(), Request: gofrHTTP.NewRequest(req)}
+
+	body, err := ctx.RawBody()
+
+	require.NoError(t, err)
+	assert.Equal(t, "plain text", string(body))
+
+	ctx = &Context{Context: context.Background(), Request: &cmd2.Request{}}
+
+	_, err = ctx.RawBody()
+
+	assert.Equal(t, errRawBodyNotSupported, err)
+}
+
+func TestContext_Form(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("name=gofr&tag=go&tag=framework"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	ctx := &Context{Context: req.Context(), Request: gofrHTTP.NewRequest(req)}
+
+	assert.Equal(t, url.Values{"name": {"gofr"}, "tag": {"go", "framework"}}, ctx.Form())
+	assert.Equal(t, "gofr", ctx.FormValue("name"))
+	assert.Equal(t, []string{"go", "framework"}, ctx.FormValues("tag"))
+	assert.Nil(t, ctx.FormFiles("file"))
+
+	ctx = &Context{Context: context.Background(), Request: &cmd2.Request{}}
+
+	assert.Nil(t, ctx.Form())
+	assert.Empty(t, ctx.FormValue("name"))
+	assert.Nil(t, ctx.FormFiles("file"))
+}
+
+func TestContext_IsClientGone(t *testing.T) {
+	reqCtx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody).WithContext(reqCtx)
+
+	// the context of the handler is derived from the one of the request, e.g. to time out the request
+	handlerCtx, cancelHandler := context.WithTimeout(reqCtx, time.Minute)
+	defer cancelHandler()
+
+	ctx := &Context{Context: handlerCtx, Request: gofrHTTP.NewRequest(req)}
+
+	assert.False(t, ctx.IsClientGone())
+
+	cancel()
+
+	assert.True(t, ctx.IsClientGone())
+	require.ErrorIs(t, ctx.Err(), context.Canceled, "the context of the handler should be cancelled")
+
+	ctx = &Context{Context: context.Background(), Request: &cmd2.Request{}}
+
+	assert.False(t, ctx.IsClientGone())
+}
+
+func TestContext_HeadersWithWebSocketConnection(t *testing.T) {
+	conn := &gofrWebSocket.Connection{HandshakeHeader: http.Header{"Authorization": {"Bearer ws-token"}}}
+	ctx := &Context{Context: context.Background(), Request: conn}
+
+	assert.Equal(t, "Bearer ws-token", ctx.Header("Authorization"))
+	assert.Equal(t, "ws-token", ctx.BearerToken())
+}
+
+func TestContext_TLSConnectionState(t *testing.T) {
+	verified := &tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: "billing-service"}}}},
+	}
+
+	testCases := []struct {
+		desc    string
+		request Request
+		state   *tls.ConnectionState
+		cn      string
+	}{
+		{desc: "plaintext HTTP request", request: gofrHTTP.NewRequest(httptest.NewRequest(http.MethodGet, "/", http.NoBody))},
+		{desc: "TLS without client certificate", request: newTLSRequest(&tls.ConnectionState{}), state: &tls.ConnectionState{}},
+		{desc: "verified client certificate", request: newTLSRequest(verified), state: verified, cn: "billing-service"},
+		{desc: "WebSocket connection", request: &gofrWebSocket.Connection{HandshakeTLS: verified}, state: verified,
+			cn: "billing-service"},
+		{desc: "cmd request", request: &cmd2.Request{}},
+	}
+
+	for i, tc := range testCases {
+		ctx := &Context{Context: context.Background(), Request: tc.request}
+
+		assert.Equal(t, tc.state, ctx.TLSConnectionState(), "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.cn, ctx.ClientCertCN(), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func newTLSRequest(state *tls.ConnectionState) *gofrHTTP.Request {
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	req.TLS = state
+
+	return gofrHTTP.NewRequest(req)
+}
+
+func TestContext_SessionNotEnabled(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/login", http.NoBody)
+	ctx := &Context{Context: req.Context(), Request: gofrHTTP.NewRequest(req)}
+
+	assert.Nil(t, ctx.Session())
+	require.ErrorIs(t, ctx.Login(map[string]string{"user": "alice"}), errSessionAuthNotEnabled)
+	require.ErrorIs(t, ctx.Logout(), errSessionAuthNotEnabled)
+}
+
+func TestContext_BindAndValidate(t *testing.T) {
+	type product struct {
+		Name  string  `json:"name" validate:"required"`
+		Price float64 `json:"price" validate:"gt=0"`
+	}
+
+	tests := []struct {
+		desc string
+		body string
+		err  error
+	}{
+		{"valid", `{"name":"chips","price":1.5}`, nil},
+		{"invalid fields", `{"price":0}`, gofrHTTP.ErrorValidation{Fields: []gofrHTTP.FieldError{
+			{Field: "name", Rule: "required", Message: "name is required"},
+			{Field: "price", Rule: "gt", Message: "price must be greater than 0"},
+		}}},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/products", bytes.NewBufferString(tc.body))
+		req.Header.Set("Content-Type", "application/json")
+
+		ctx := &Context{Context: req.Context(), Request: gofrHTTP.NewRequest(req)}
+
+		var p product
+
+		err := ctx.BindAndValidate(&p)
+
+		assert.Equal(t, tc.err, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestBind(t *testing.T) {
+	type product struct {
+		Name  string  `json:"name" validate:"required"`
+		Price float64 `json:"price" validate:"gt=0"`
+	}
+
+	newContext := func(body string) *Context {
+		req := httptest.NewRequest(http.MethodPost, "/products", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		return &Context{Context: req.Context(), Request: gofrHTTP.NewRequest(req)}
+	}
+
+	p, err := Bind[product](newContext(`{"name":"chips","price":1.5}`))
+
+	require.NoError(t, err)
+	assert.Equal(t, product{Name: "chips", Price: 1.5}, p)
+
+	p, err = Bind[product](newContext(`{"name":`))
+
+	require.Error(t, err)
+	assert.Zero(t, p)
+
+	p, err = BindAndValidate[product](newContext(`{"name":"chips","price":1.5}`))
+
+	require.NoError(t, err)
+	assert.Equal(t, product{Name: "chips", Price: 1.5}, p)
+
+	p, err = BindAndValidate[product](newContext(`{"name":"chips"}`))
+
+	assert.Equal(t, gofrHTTP.ErrorValidation{Fields: []gofrHTTP.FieldError{
+		{Field: "price", Rule: "gt", Message: "price must be greater than 0"},
+	}}, err)
+	assert.Zero(t, p)
+}
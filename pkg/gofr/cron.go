@@ -5,11 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"runtime/debug"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 
 	"gofr.dev/pkg/gofr/container"
@@ -48,8 +50,28 @@ type job struct {
 	month     map[int]struct{}
 	dayOfWeek map[int]struct{}
 
-	name string
-	fn   CronFunc
+	id       string
+	name     string
+	schedule string
+	fn       CronFunc
+
+	// lockTTL is set when the job is registered with WithCronLeaderLock.
+	lockTTL time.Duration
+}
+
+// CronOption is used to configure a cron job while adding it to the cron tab.
+type CronOption func(j *job)
+
+// WithCronLeaderLock ensures that only one instance of a horizontally scaled application executes a scheduled
+// run of the job. Before each run, the instances race to acquire a lock in Redis for that particular tick and only
+// the winner executes the job. The lock expires after the given ttl, so it is released even if the holder dies mid-run.
+//
+// Redis must be configured for the lock to be acquired. If it is not, the job runs on every instance, as it
+// would without this option.
+func WithCronLeaderLock(ttl time.Duration) CronOption {
+	return func(j *job) {
+		j.lockTTL = ttl
+	}
 }
 
 type tick struct {
@@ -256,7 +278,7 @@ func (c *Crontab) runScheduled(t time.Time) {
 
 	for _, j := range jb {
 		if j.tick(getTick(t)) {
-			go j.run(c.container)
+			go j.run(c.container, t)
 		}
 	}
 }
@@ -272,11 +294,23 @@ func getTick(t time.Time) *tick {
 	}
 }
 
-func (j *job) run(cntnr *container.Container) {
+func (j *job) run(cntnr *container.Container, t time.Time) {
+	if j.lockTTL > 0 && !j.acquireLock(cntnr, t) {
+		return
+	}
+
 	ctx, span := otel.GetTracerProvider().Tracer("gofr-"+version.Framework).
 		Start(context.Background(), j.name)
 	defer span.End()
 
+	// a panic is recovered so that the job keeps running on the next ticks
+	defer func() {
+		if re := recover(); re != nil && cntnr != nil {
+			cntnr.Logger.Error(panicLog{Job: j.name, Error: fmt.Sprint(re), StackTrace: string(debug.Stack())})
+			countPanic(ctx, cntnr, "cron")
+		}
+	}()
+
 	j.fn(&Context{
 		Context:   ctx,
 		Container: cntnr,
@@ -284,6 +318,34 @@ func (j *job) run(cntnr *container.Container) {
 	})
 }
 
+// acquireLock tries to acquire the lock for the run scheduled at time t. The lock key contains the scheduled
+// time (truncated to the second) so that every tick is contended separately and a lock is never released
+// explicitly, it only expires after the configured ttl.
+func (j *job) acquireLock(cntnr *container.Container, t time.Time) bool {
+	if cntnr == nil || container.IsNil(cntnr.Redis) {
+		if cntnr != nil {
+			cntnr.Warnf("redis is not configured, running cron job %v without leader lock", j.name)
+		}
+
+		return true
+	}
+
+	key := fmt.Sprintf("gofr:cron:%s:%d", j.name, t.Truncate(time.Second).Unix())
+
+	acquired, err := cntnr.Redis.SetNX(context.Background(), key, cntnr.GetAppName(), j.lockTTL).Result()
+	if err != nil {
+		cntnr.Errorf("error acquiring lock for cron job %v, skipping this run, err: %v", j.name, err)
+
+		return false
+	}
+
+	if !acquired {
+		cntnr.Debugf("lock for cron job %v is held by another instance, skipping this run", j.name)
+	}
+
+	return acquired
+}
+
 func (j *job) tick(t *tick) bool {
 	if _, ok := j.min[t.min]; !ok {
 		return false
@@ -319,20 +381,133 @@ func (j *job) tick(t *tick) bool {
 }
 
 // AddJob to cron tab, returns error if the cron syntax can't be parsed or is out of bounds.
-func (c *Crontab) AddJob(schedule, jobName string, fn CronFunc) error {
+func (c *Crontab) AddJob(schedule, jobName string, fn CronFunc, opts ...CronOption) error {
+	_, err := c.Add(schedule, jobName, fn, opts...)
+
+	return err
+}
+
+// ScheduledJob describes a job present in the cron tab.
+type ScheduledJob struct {
+	ID       string    `json:"id"`
+	Name     string    `json:"name"`
+	Schedule string    `json:"schedule"`
+	NextRun  time.Time `json:"nextRun"`
+}
+
+// Add adds a job to the cron tab and returns the id of the job, which can be used to remove it later.
+// It returns an error if the cron syntax can't be parsed or is out of bounds.
+//
+// Unlike App.AddCronJob, Add can be called while the application is running, for example from an HTTP handler.
+func (c *Crontab) Add(schedule, jobName string, fn CronFunc, opts ...CronOption) (string, error) {
 	j, err := parseSchedule(schedule)
 	if err != nil {
-		return err
+		return "", err
 	}
 
+	j.id = uuid.NewString()
 	j.name = jobName
+	j.schedule = schedule
 	j.fn = fn
 
+	for _, opt := range opts {
+		opt(j)
+	}
+
 	c.mu.Lock()
 	c.jobs = append(c.jobs, j)
 	c.mu.Unlock()
 
-	return nil
+	return j.id, nil
+}
+
+// Remove removes the job with the given id from the cron tab. An execution of the job which is already in progress
+// is not interrupted, but the job will not be scheduled again.
+func (c *Crontab) Remove(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, j := range c.jobs {
+		if j.id == id {
+			c.jobs = append(c.jobs[:i], c.jobs[i+1:]...)
+
+			return nil
+		}
+	}
+
+	return errJobNotFound{id: id}
+}
+
+// Jobs returns all the jobs currently present in the cron tab along with the time of their next run.
+func (c *Crontab) Jobs() []ScheduledJob {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := time.Now()
+	jobs := make([]ScheduledJob, 0, len(c.jobs))
+
+	for _, j := range c.jobs {
+		jobs = append(jobs, ScheduledJob{
+			ID:       j.id,
+			Name:     j.name,
+			Schedule: j.schedule,
+			NextRun:  j.nextRun(now),
+		})
+	}
+
+	return jobs
+}
+
+// maxNextRunSearch bounds the search for the next run of a job whose schedule can never be satisfied, like "0 0 31 2 *".
+const maxNextRunSearch = 5
+
+// nextRun returns the first time after t at which the job will be executed. Instead of checking every second,
+// it skips the complete month, day, hour or minute which does not match the schedule.
+// A zero time is returned if the job is not scheduled to run in the next few years.
+func (j *job) nextRun(t time.Time) time.Time {
+	next := t.Truncate(time.Second).Add(time.Second)
+	limit := next.AddDate(maxNextRunSearch, 0, 0)
+	loc := next.Location()
+
+	for next.Before(limit) {
+		year, month, day := next.Date()
+		tck := getTick(next)
+
+		if _, ok := j.month[tck.month]; !ok {
+			next = time.Date(year, month+1, 1, 0, 0, 0, 0, loc)
+
+			continue
+		}
+
+		_, dayOK := j.day[tck.day]
+		_, dayOfWeekOK := j.dayOfWeek[tck.dayOfWeek]
+
+		if !dayOK && !dayOfWeekOK {
+			next = time.Date(year, month, day+1, 0, 0, 0, 0, loc)
+
+			continue
+		}
+
+		if _, ok := j.hour[tck.hour]; !ok {
+			next = time.Date(year, month, day, tck.hour+1, 0, 0, 0, loc)
+
+			continue
+		}
+
+		if _, ok := j.min[tck.min]; !ok || (j.sec == nil && tck.sec != 0) {
+			next = time.Date(year, month, day, tck.hour, tck.min+1, 0, 0, loc)
+
+			continue
+		}
+
+		if j.tick(tck) {
+			return next
+		}
+
+		next = next.Add(time.Second)
+	}
+
+	return time.Time{}
 }
 
 var errBadScheduleFormat = errors.New("schedule string must have five components like * * * * *")
@@ -349,6 +524,14 @@ func (e errOutOfRange) Error() string {
 		"range %d-%d", e.rangeVal, e.input, e.rangeVal, e.min, e.max)
 }
 
+type errJobNotFound struct {
+	id string
+}
+
+func (e errJobNotFound) Error() string {
+	return fmt.Sprintf("cron job with id %s not found", e.id)
+}
+
 type errParsing struct {
 	invalidPart string
 	base        string
@@ -6,9 +6,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/testutil"
 )
 
@@ -240,6 +244,115 @@ func TestCronTab_runScheduled(t *testing.T) {
 	assert.Contains(t, out, "hello from cron")
 }
 
+func TestJob_runPanic(t *testing.T) {
+	c, mocks := container.NewMockContainer(t)
+
+	mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_panics_total", "source", "cron")
+
+	j := &job{name: "panicking-job", fn: func(*Context) { panic("cron panic") }}
+
+	logs := testutil.StderrOutputForFunc(func() {
+		c.Logger = logging.NewMockLogger(logging.ERROR)
+
+		assert.NotPanics(t, func() { j.run(c, time.Now()) })
+	})
+
+	assert.Contains(t, logs, "panicking-job")
+	assert.Contains(t, logs, "cron panic")
+}
+
+func TestCronTab_AddJob_WithLeaderLock(t *testing.T) {
+	c := NewCron(nil)
+
+	err := c.AddJob("* * * * *", "test-job", func(*Context) {}, WithCronLeaderLock(time.Minute))
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Minute, c.jobs[0].lockTTL)
+}
+
+func TestCronTab_AddRemoveJobs(t *testing.T) {
+	c := NewCron(nil)
+
+	id, err := c.Add("0 9 * * *", "daily-job", func(*Context) {})
+	require.NoError(t, err)
+
+	_, err = c.Add("* * * *", "invalid-job", func(*Context) {})
+	require.ErrorIs(t, err, errBadScheduleFormat)
+
+	jobs := c.Jobs()
+	require.Len(t, jobs, 1)
+
+	assert.Equal(t, id, jobs[0].ID)
+	assert.Equal(t, "daily-job", jobs[0].Name)
+	assert.Equal(t, "0 9 * * *", jobs[0].Schedule)
+	assert.Equal(t, 9, jobs[0].NextRun.Hour())
+	assert.Equal(t, 0, jobs[0].NextRun.Minute())
+
+	require.NoError(t, c.Remove(id))
+	assert.Empty(t, c.Jobs())
+
+	assert.Equal(t, errJobNotFound{id: id}, c.Remove(id))
+}
+
+func TestJob_nextRun(t *testing.T) {
+	from := time.Date(2024, 1, 31, 10, 30, 15, 500, time.UTC)
+
+	testCases := []struct {
+		schedule string
+		expected time.Time
+	}{
+		{"* * * * * *", time.Date(2024, 1, 31, 10, 30, 16, 0, time.UTC)},
+		{"* * * * *", time.Date(2024, 1, 31, 10, 31, 0, 0, time.UTC)},
+		{"*/10 * * * * *", time.Date(2024, 1, 31, 10, 30, 20, 0, time.UTC)},
+		{"0 9 * * *", time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)},
+		{"15 10 1 3 *", time.Date(2024, 3, 1, 10, 15, 0, 0, time.UTC)},
+		{"0 0 * * 1", time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 2 *", time.Time{}},
+	}
+
+	for i, tc := range testCases {
+		j, err := parseSchedule(tc.schedule)
+		require.NoError(t, err)
+
+		assert.Equal(t, tc.expected, j.nextRun(from), "TEST[%d], Failed.\n%s", i, tc.schedule)
+	}
+}
+
+func TestJob_acquireLock(t *testing.T) {
+	tm := time.Date(2024, 1, 1, 1, 1, 1, 1, time.UTC)
+	key := fmt.Sprintf("gofr:cron:test-job:%d", tm.Truncate(time.Second).Unix())
+
+	testCases := []struct {
+		desc     string
+		result   *redis.BoolCmd
+		expected bool
+	}{
+		{desc: "lock acquired", result: redis.NewBoolResult(true, nil), expected: true},
+		{desc: "lock held by another instance", result: redis.NewBoolResult(false, nil), expected: false},
+		{desc: "error acquiring lock", result: redis.NewBoolResult(false, testutil.CustomError{ErrorMessage: "conn refused"}),
+			expected: false},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, mocks := container.NewMockContainer(t)
+
+			j := &job{name: "test-job", lockTTL: time.Minute}
+
+			mocks.Redis.EXPECT().SetNX(gomock.Any(), key, gomock.Any(), time.Minute).Return(tc.result)
+
+			assert.Equal(t, tc.expected, j.acquireLock(c, tm), "TEST[%d], Failed.\n%s", i, tc.desc)
+		})
+	}
+}
+
+func TestJob_acquireLock_RedisNotConfigured(t *testing.T) {
+	j := &job{name: "test-job", lockTTL: time.Minute}
+
+	assert.True(t, j.acquireLock(&container.Container{Logger: logging.NewMockLogger(logging.DEBUG)}, time.Now()))
+	assert.True(t, j.acquireLock(nil, time.Now()))
+}
+
 func TestJob_tick(t *testing.T) {
 	tck := &tick{1, 1, 1, 1, 1, 1}
 
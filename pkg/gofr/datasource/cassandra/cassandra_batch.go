@@ -14,7 +14,7 @@ func (c *Client) ExecuteBatch(name string) error {
 }
 
 func (c *Client) ExecuteBatchCAS(name string, dest ...any) (bool, error) {
-	return c.ExecuteBatchCASWithCtx(context.Background(), name, dest)
+	return c.ExecuteBatchCASWithCtx(context.Background(), name, dest...)
 }
 
 func (c *Client) BatchQueryWithCtx(ctx context.Context, name, stmt string, values ...any) error {
@@ -50,7 +50,7 @@ func (c *Client) ExecuteBatchWithCtx(ctx context.Context, name string) error {
 		return errBatchNotInitialised
 	}
 
-	return c.cassandra.session.executeBatch(b)
+	return c.cassandra.session.executeBatch(ctx, b)
 }
 
 func (c *Client) ExecuteBatchCASWithCtx(ctx context.Context, name string, dest ...any) (bool, error) {
@@ -67,5 +67,5 @@ func (c *Client) ExecuteBatchCASWithCtx(ctx context.Context, name string, dest .
 		return false, errBatchNotInitialised
 	}
 
-	return c.cassandra.session.executeBatchCAS(b, dest...)
+	return c.cassandra.session.executeBatchCAS(ctx, b, dest...)
 }
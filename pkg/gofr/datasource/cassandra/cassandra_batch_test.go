@@ -1,6 +1,7 @@
 package cassandra
 
 import (
+	"context"
 	"testing"
 
 	"github.com/gocql/gocql"
@@ -46,10 +47,10 @@ func Test_ExecuteBatch(t *testing.T) {
 		expErr   error
 	}{
 		{"execute batch success", func() {
-			mockDeps.mockSession.EXPECT().executeBatch(mockDeps.mockBatch).Return(nil).Times(1)
+			mockDeps.mockSession.EXPECT().executeBatch(context.Background(), mockDeps.mockBatch).Return(nil).Times(1)
 		}, nil},
 		{"execute batch failure", func() {
-			mockDeps.mockSession.EXPECT().executeBatch(mockDeps.mockBatch).Return(errMock).Times(1)
+			mockDeps.mockSession.EXPECT().executeBatch(context.Background(), mockDeps.mockBatch).Return(errMock).Times(1)
 		}, errMock},
 		{"batch not initialized", func() {
 			client.cassandra.batches = nil
@@ -65,6 +66,19 @@ func Test_ExecuteBatch(t *testing.T) {
 	}
 }
 
+func Test_ExecuteBatchWithCtx(t *testing.T) {
+	client, mockDeps := initTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockDeps.mockSession.EXPECT().executeBatch(ctx, mockDeps.mockBatch).Return(nil)
+
+	err := client.ExecuteBatchWithCtx(ctx, mockBatchName)
+
+	assert.NoError(t, err, "Test Failed")
+}
+
 func Test_newBatch(t *testing.T) {
 	cassSession := &cassandraSession{session: &gocql.Session{}}
 
@@ -116,10 +130,10 @@ func Test_ExecuteBatchCAS(t *testing.T) {
 		expErr   error
 	}{
 		{"success case: struct slice", &mockStructSlice, func() {
-			mockDeps.mockSession.EXPECT().executeBatchCAS(mockDeps.mockBatch, gomock.Any()).Return(true, nil).Times(1)
+			mockDeps.mockSession.EXPECT().executeBatchCAS(context.Background(), mockDeps.mockBatch, gomock.Any()).Return(true, nil).Times(1)
 		}, &mockStructSlice, nil},
 		{"failure case: executeBatchCAS returns error", &mockStructSlice, func() {
-			mockDeps.mockSession.EXPECT().executeBatchCAS(mockDeps.mockBatch, gomock.Any()).Return(false, assert.AnError).Times(1)
+			mockDeps.mockSession.EXPECT().executeBatchCAS(context.Background(), mockDeps.mockBatch, gomock.Any()).Return(false, assert.AnError).Times(1)
 		}, &mockStructSlice, assert.AnError},
 		{"failure case: batch not initialized", &mockStructSlice, func() {
 			client.cassandra.batches = nil
@@ -136,3 +150,19 @@ func Test_ExecuteBatchCAS(t *testing.T) {
 		assert.Equalf(t, applied, tc.expErr == nil, "TEST[%d], Failed.\n%s", i, tc.desc)
 	}
 }
+
+func Test_ExecuteBatchCASWithCtx(t *testing.T) {
+	client, mockDeps := initTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var dest []any
+
+	mockDeps.mockSession.EXPECT().executeBatchCAS(ctx, mockDeps.mockBatch, &dest).Return(true, nil)
+
+	applied, err := client.ExecuteBatchCASWithCtx(ctx, mockBatchName, &dest)
+
+	assert.True(t, applied, "Test Failed")
+	assert.NoError(t, err, "Test Failed")
+}
@@ -1,6 +1,8 @@
 package cassandra
 
 import (
+	"context"
+
 	"github.com/gocql/gocql"
 )
 
@@ -18,8 +20,8 @@ type clusterConfig interface {
 type session interface {
 	query(stmt string, values ...any) query
 	newBatch(batchtype gocql.BatchType) batch
-	executeBatch(batch batch) error
-	executeBatchCAS(b batch, dest ...any) (bool, error)
+	executeBatch(ctx context.Context, batch batch) error
+	executeBatchCAS(ctx context.Context, b batch, dest ...any) (bool, error)
 }
 
 // query defines methods for interacting with a Cassandra query.
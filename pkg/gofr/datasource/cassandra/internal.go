@@ -1,6 +1,7 @@
 package cassandra
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -112,16 +113,16 @@ func (c *cassandraSession) newBatch(batchType gocql.BatchType) batch {
 
 // executeBatch executes a batch operation and returns nil if successful otherwise an error is returned describing the failure.
 // This method wraps the `ExecuteBatch` method of the underlying `session` object.
-func (c *cassandraSession) executeBatch(b batch) error {
-	gocqlBatch := b.getBatch()
+func (c *cassandraSession) executeBatch(ctx context.Context, b batch) error {
+	gocqlBatch := b.getBatch().WithContext(ctx)
 
 	return c.session.ExecuteBatch(gocqlBatch)
 }
 
 // executeBatchCAS executes a batch operation and returns true if successful.
 // This method wraps the `executeBatchCAS` method of the underlying `session` object.
-func (c *cassandraSession) executeBatchCAS(b batch, dest ...any) (bool, error) {
-	gocqlBatch := b.getBatch()
+func (c *cassandraSession) executeBatchCAS(ctx context.Context, b batch, dest ...any) (bool, error) {
+	gocqlBatch := b.getBatch().WithContext(ctx)
 
 	applied, _, err := c.session.ExecuteBatchCAS(gocqlBatch, dest...)
 
@@ -9,6 +9,7 @@
 package cassandra
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gocql "github.com/gocql/gocql"
@@ -77,23 +78,23 @@ func (m *Mocksession) EXPECT() *MocksessionMockRecorder {
 }
 
 // executeBatch mocks base method.
-func (m *Mocksession) executeBatch(batch batch) error {
+func (m *Mocksession) executeBatch(ctx context.Context, batch batch) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "executeBatch", batch)
+	ret := m.ctrl.Call(m, "executeBatch", ctx, batch)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // executeBatch indicates an expected call of executeBatch.
-func (mr *MocksessionMockRecorder) executeBatch(batch any) *gomock.Call {
+func (mr *MocksessionMockRecorder) executeBatch(ctx, batch any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "executeBatch", reflect.TypeOf((*Mocksession)(nil).executeBatch), batch)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "executeBatch", reflect.TypeOf((*Mocksession)(nil).executeBatch), ctx, batch)
 }
 
 // executeBatchCAS mocks base method.
-func (m *Mocksession) executeBatchCAS(b batch, dest ...any) (bool, error) {
+func (m *Mocksession) executeBatchCAS(ctx context.Context, b batch, dest ...any) (bool, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{b}
+	varargs := []any{ctx, b}
 	for _, a := range dest {
 		varargs = append(varargs, a)
 	}
@@ -104,9 +105,9 @@ func (m *Mocksession) executeBatchCAS(b batch, dest ...any) (bool, error) {
 }
 
 // executeBatchCAS indicates an expected call of executeBatchCAS.
-func (mr *MocksessionMockRecorder) executeBatchCAS(b any, dest ...any) *gomock.Call {
+func (mr *MocksessionMockRecorder) executeBatchCAS(ctx, b any, dest ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{b}, dest...)
+	varargs := append([]any{ctx, b}, dest...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "executeBatchCAS", reflect.TypeOf((*Mocksession)(nil).executeBatchCAS), varargs...)
 }
 
@@ -1,6 +1,7 @@
 package datasource
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/pkg/errors"
@@ -32,3 +33,17 @@ func (e ErrorDB) WithStack() ErrorDB {
 func (ErrorDB) StatusCode() int {
 	return http.StatusInternalServerError
 }
+
+// ErrorDatasourceUnavailable is returned for the operations on a datasource which is not connected, e.g. as it was down
+// when the application started, while it is being reconnected in the background.
+type ErrorDatasourceUnavailable struct {
+	Datasource string
+}
+
+func (e ErrorDatasourceUnavailable) Error() string {
+	return fmt.Sprintf("datasource %s is unavailable", e.Datasource)
+}
+
+func (ErrorDatasourceUnavailable) StatusCode() int {
+	return http.StatusServiceUnavailable
+}
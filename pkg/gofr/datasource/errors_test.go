@@ -34,3 +34,10 @@ func TestErrorDB_StatusCode(t *testing.T) {
 
 	assert.Equal(t, expectedCode, dbErr.StatusCode(), "TEST Failed.\n")
 }
+
+func TestErrorDatasourceUnavailable(t *testing.T) {
+	err := ErrorDatasourceUnavailable{Datasource: "sql"}
+
+	assert.Equal(t, "datasource sql is unavailable", err.Error())
+	assert.Equal(t, http.StatusServiceUnavailable, err.StatusCode())
+}
@@ -3,6 +3,8 @@ package file
 import (
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
 
 	"gofr.dev/pkg/gofr/datasource"
 )
@@ -115,3 +117,29 @@ func (fileSystem) ReadDir(dir string) ([]FileInfo, error) {
 
 	return fileInfo, err
 }
+
+// Glob returns the files and directories matching the pattern, with the matched paths as their names.
+// Patterns without `**` are matched using filepath.Glob, while the others walk the directories under the pattern.
+func (f fileSystem) Glob(pattern string) ([]FileInfo, error) {
+	if strings.Contains(pattern, "**") {
+		return GlobWalk(filepath.ToSlash(pattern), f.ReadDir)
+	}
+
+	names, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	fileInfo := make([]FileInfo, 0, len(names))
+
+	for _, name := range names {
+		info, err := os.Stat(name)
+		if err != nil {
+			return fileInfo, err
+		}
+
+		fileInfo = append(fileInfo, globInfo{FileInfo: info, name: name})
+	}
+
+	return fileInfo, nil
+}
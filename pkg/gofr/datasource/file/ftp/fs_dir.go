@@ -291,3 +291,29 @@ func (f *FileSystem) ReadDir(dir string) ([]file_interface.FileInfo, error) {
 
 	return fileInfo, nil
 }
+
+// Glob returns the files and directories matching the pattern, relative to the remote directory, with the matched
+// paths as their names. FTP has no pattern matching, so the directories under the pattern are listed recursively.
+func (f *FileSystem) Glob(pattern string) ([]file_interface.FileInfo, error) {
+	var msg string
+
+	status := statusError
+
+	defer f.sendOperationStats(&FileLog{
+		Operation: "Glob",
+		Location:  f.config.RemoteDir,
+		Status:    &status,
+		Message:   &msg,
+	}, time.Now())
+
+	fileInfo, err := file_interface.GlobWalk(pattern, f.ReadDir)
+	if err != nil {
+		f.logger.Errorf("Glob failed. Error matching %q : %v", pattern, err)
+		return nil, err
+	}
+
+	status = statusSuccess
+	msg = fmt.Sprintf("Found %d entries matching %q", len(fileInfo), pattern)
+
+	return fileInfo, nil
+}
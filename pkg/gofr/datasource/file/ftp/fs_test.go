@@ -973,3 +973,48 @@ func runReadDirTest(
 		assert.ElementsMatch(t, tt.expectedName, names)
 	}
 }
+
+func TestGlob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFtpConn := NewMockserverConn(ctrl)
+	mockLogger := NewMockLogger(ctrl)
+	mockMetrics := NewMockMetrics(ctrl)
+
+	fs := &FileSystem{
+		conn:    mockFtpConn,
+		config:  &Config{RemoteDir: "/ftp/one"},
+		logger:  mockLogger,
+		metrics: mockMetrics,
+	}
+
+	mockLogger.EXPECT().Debug(gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Errorf(gomock.Any(), gomock.Any()).AnyTimes()
+	mockMetrics.EXPECT().RecordHistogram(gomock.Any(), appFTPStats, gomock.Any(),
+		"type", gomock.Any(), "status", gomock.Any()).AnyTimes()
+
+	mockFtpConn.EXPECT().List("/ftp/one/logs").Return([]*ftp.Entry{
+		{Name: "app.log", Type: ftp.EntryTypeFile, Time: time.Now()},
+		{Name: "app.txt", Type: ftp.EntryTypeFile, Time: time.Now()},
+		{Name: "2024", Type: ftp.EntryTypeFolder, Time: time.Now()},
+	}, nil)
+	mockFtpConn.EXPECT().List("/ftp/one/logs/2024").Return([]*ftp.Entry{
+		{Name: "db.log", Type: ftp.EntryTypeFile, Time: time.Now()},
+	}, nil)
+
+	files, err := fs.Glob("logs/**/*.log")
+	require.NoError(t, err)
+
+	var names []string
+	for _, file := range files {
+		names = append(names, file.Name())
+	}
+
+	assert.ElementsMatch(t, []string{"logs/app.log", "logs/2024/db.log"}, names)
+
+	mockFtpConn.EXPECT().List("/ftp/one").Return(nil, errMockSentinel)
+
+	_, err = fs.Glob("*.log")
+	require.ErrorIs(t, err, errMockSentinel)
+}
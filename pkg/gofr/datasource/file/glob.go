@@ -0,0 +1,150 @@
+package file
+
+import (
+	"path"
+	"strings"
+)
+
+const globMeta = `*?[\`
+
+// MatchGlob reports whether name matches the slash separated pattern. The pattern syntax is the one of path.Match,
+// where `*` and `?` do not match the separator, along with `**` as a complete path segment, which matches zero or
+// more directories. The only possible error is path.ErrBadPattern.
+func MatchGlob(pattern, name string) (bool, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return false, err
+	}
+
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/")), nil
+}
+
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// collapse consecutive ** as they match the same
+			for len(pattern) > 1 && pattern[1] == "**" {
+				pattern = pattern[1:]
+			}
+
+			for i := 0; i <= len(name); i++ {
+				if matchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
+
+// GlobDir returns the longest directory of the slash separated pattern which has no pattern characters, so that
+// only the files under it need to be matched. It returns "." when the first segment has pattern characters.
+func GlobDir(pattern string) string {
+	segments := strings.Split(pattern, "/")
+
+	// the last segment is the file name, so it is never a part of the directory
+	i := 0
+	for ; i < len(segments)-1 && !strings.ContainsAny(segments[i], globMeta); i++ {
+	}
+
+	dir := strings.Join(segments[:i], "/")
+
+	switch {
+	case dir == "" && strings.HasPrefix(pattern, "/"):
+		return "/"
+	case dir == "":
+		return "."
+	default:
+		return dir
+	}
+}
+
+// GlobWalk returns the files and directories matching the slash separated pattern, by reading the directories under
+// GlobDir(pattern) recursively using readDir. It is used by the file systems which have no native way to list the
+// files matching a pattern. The names of the returned FileInfo are the matched paths.
+func GlobWalk(pattern string, readDir func(dir string) ([]FileInfo, error)) ([]FileInfo, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+
+	patternSegments := strings.Split(pattern, "/")
+	recursive := strings.Contains(pattern, "**")
+
+	var (
+		matches []FileInfo
+		walk    func(dir string) error
+	)
+
+	walk = func(dir string) error {
+		entries, err := readDir(dir)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			name := path.Base(entry.Name())
+			if name == "." || name == ".." {
+				continue
+			}
+
+			entryPath := joinGlobPath(dir, name)
+			entrySegments := strings.Split(entryPath, "/")
+
+			if matchSegments(patternSegments, entrySegments) {
+				matches = append(matches, globInfo{FileInfo: entry, name: entryPath})
+			}
+
+			// without **, the directories as deep as the pattern cannot contain any match
+			if entry.IsDir() && (recursive || len(entrySegments) < len(patternSegments)) {
+				if err := walk(entryPath); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	err := walk(GlobDir(pattern))
+
+	return matches, err
+}
+
+func joinGlobPath(dir, name string) string {
+	if dir == "." {
+		return name
+	}
+
+	return path.Join(dir, name)
+}
+
+// WithName returns info with name as its name. It is used by Glob to report the matched paths instead of the base names.
+func WithName(info FileInfo, name string) FileInfo {
+	return globInfo{FileInfo: info, name: name}
+}
+
+// globInfo is the FileInfo of a file matched by a pattern, whose name is the matched path instead of the base name.
+type globInfo struct {
+	FileInfo
+	name string
+}
+
+func (g globInfo) Name() string {
+	return g.name
+}
+
+func (g globInfo) ETag() string {
+	return ETag(g.FileInfo)
+}
@@ -0,0 +1,132 @@
+package file
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr/logging"
+)
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		match   bool
+	}{
+		{"*.txt", "a.txt", true},
+		{"*.txt", "dir/a.txt", false},
+		{"dir/?.txt", "dir/a.txt", true},
+		{"dir/[ab].txt", "dir/c.txt", false},
+		{"**/*.txt", "a.txt", true},
+		{"**/*.txt", "dir/sub/a.txt", true},
+		{"dir/**", "dir/sub/a.txt", true},
+		{"dir/**/a.txt", "dir/a.txt", true},
+		{"dir/**/**/a.txt", "dir/x/y/a.txt", true},
+		{"dir/**/a.txt", "other/a.txt", false},
+		{"/logs/*.log", "/logs/app.log", true},
+	}
+
+	for i, tc := range tests {
+		match, err := MatchGlob(tc.pattern, tc.name)
+
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.pattern)
+		assert.Equal(t, tc.match, match, "TEST[%d], Failed.\n%s matching %s", i, tc.pattern, tc.name)
+	}
+
+	_, err := MatchGlob("dir/[", "dir/a")
+	require.ErrorIs(t, err, path.ErrBadPattern)
+}
+
+func TestGlobDir(t *testing.T) {
+	tests := []struct {
+		pattern string
+		dir     string
+	}{
+		{"*.txt", "."},
+		{"a.txt", "."},
+		{"dir/sub/*.txt", "dir/sub"},
+		{"dir/**/a.txt", "dir"},
+		{"dir/*/a.txt", "dir"},
+		{"/*.txt", "/"},
+		{"/logs/2024/*.log", "/logs/2024"},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.dir, GlobDir(tc.pattern), "TEST[%d], Failed.\n%s", i, tc.pattern)
+	}
+}
+
+func Test_LocalFileSystemGlob(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, name := range []string{"a.txt", "b.csv", "sub/c.txt", "sub/deep/d.txt"} {
+		name = filepath.Join(dir, name)
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(name), os.ModePerm))
+		require.NoError(t, os.WriteFile(name, []byte("data"), 0600))
+	}
+
+	fileStore := New(logging.NewMockLogger(logging.DEBUG))
+
+	tests := []struct {
+		pattern  string
+		expected []string
+	}{
+		{"*.txt", []string{"a.txt"}},
+		{"*/*.txt", []string{"sub/c.txt"}},
+		{"**/*.txt", []string{"a.txt", "sub/c.txt", "sub/deep/d.txt"}},
+		{"sub/**", []string{"sub/c.txt", "sub/deep", "sub/deep/d.txt"}},
+		{"*.json", nil},
+	}
+
+	for i, tc := range tests {
+		files, err := fileStore.Glob(path.Join(filepath.ToSlash(dir), tc.pattern))
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.pattern)
+
+		var names []string
+
+		for _, f := range files {
+			rel, err := filepath.Rel(dir, f.Name())
+			require.NoError(t, err)
+
+			names = append(names, filepath.ToSlash(rel))
+		}
+
+		sort.Strings(names)
+
+		assert.Equal(t, tc.expected, names, "TEST[%d], Failed.\n%s", i, tc.pattern)
+	}
+}
+
+func TestGlobWalk_ReadDirError(t *testing.T) {
+	_, err := GlobWalk("missing/**", New(logging.NewMockLogger(logging.DEBUG)).ReadDir)
+
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+type etagFileInfo struct {
+	FileInfo
+	etag string
+}
+
+func (e etagFileInfo) ETag() string {
+	return e.etag
+}
+
+func TestETag(t *testing.T) {
+	info, err := os.Stat(t.TempDir())
+	require.NoError(t, err)
+
+	assert.Empty(t, ETag(info), "local files have no ETag")
+
+	tagged := etagFileInfo{FileInfo: info, etag: "abc123"}
+
+	assert.Equal(t, "abc123", ETag(tagged))
+	assert.Equal(t, "abc123", ETag(WithName(tagged, "dir/name")), "ETag should be kept for the matched files")
+}
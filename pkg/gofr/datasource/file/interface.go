@@ -30,6 +30,22 @@ type FileInfo interface {
 	IsDir() bool        // abbreviation for Mode().IsDir()
 }
 
+// ETagger is implemented by the FileInfo of the file systems which store a tag of the content of the files, like the
+// ETag of the objects in S3. The tag can be compared with an earlier one to detect whether a file has changed without
+// reading it.
+type ETagger interface {
+	ETag() string
+}
+
+// ETag returns the tag of the content of the file, or an empty string if its file system does not provide one.
+func ETag(info FileInfo) string {
+	if e, ok := info.(ETagger); ok {
+		return e.ETag()
+	}
+
+	return ""
+}
+
 type RowReader interface {
 	Next() bool
 	Scan(any) error
@@ -75,6 +91,10 @@ type FileSystem interface {
 	// Stat returns the file/directory information in the directory.
 	Stat(name string) (FileInfo, error)
 
+	// Glob returns the files/directories matching the pattern, with the matched paths as their names.
+	// The pattern supports `*`, `?` and `[...]` within a path segment, and `**` for any number of directories.
+	Glob(pattern string) ([]FileInfo, error)
+
 	// ChDir changes the current directory.
 	ChDir(dirname string) error
 
@@ -143,6 +143,43 @@ func (mr *MockFileMockRecorder) WriteAt(p, off any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAt", reflect.TypeOf((*MockFile)(nil).WriteAt), p, off)
 }
 
+// MockETagger is a mock of ETagger interface.
+type MockETagger struct {
+	ctrl     *gomock.Controller
+	recorder *MockETaggerMockRecorder
+}
+
+// MockETaggerMockRecorder is the mock recorder for MockETagger.
+type MockETaggerMockRecorder struct {
+	mock *MockETagger
+}
+
+// NewMockETagger creates a new mock instance.
+func NewMockETagger(ctrl *gomock.Controller) *MockETagger {
+	mock := &MockETagger{ctrl: ctrl}
+	mock.recorder = &MockETaggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockETagger) EXPECT() *MockETaggerMockRecorder {
+	return m.recorder
+}
+
+// ETag mocks base method.
+func (m *MockETagger) ETag() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ETag")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ETag indicates an expected call of ETag.
+func (mr *MockETaggerMockRecorder) ETag() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ETag", reflect.TypeOf((*MockETagger)(nil).ETag))
+}
+
 // MockRowReader is a mock of RowReader interface.
 type MockRowReader struct {
 	ctrl     *gomock.Controller
@@ -411,6 +448,21 @@ func (mr *MockFileSystemProviderMockRecorder) Getwd() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Getwd", reflect.TypeOf((*MockFileSystemProvider)(nil).Getwd))
 }
 
+// Glob mocks base method.
+func (m *MockFileSystemProvider) Glob(pattern string) ([]FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Glob", pattern)
+	ret0, _ := ret[0].([]FileInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Glob indicates an expected call of Glob.
+func (mr *MockFileSystemProviderMockRecorder) Glob(pattern any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Glob", reflect.TypeOf((*MockFileSystemProvider)(nil).Glob), pattern)
+}
+
 // Mkdir mocks base method.
 func (m *MockFileSystemProvider) Mkdir(name string, perm os.FileMode) error {
 	m.ctrl.T.Helper()
@@ -30,6 +30,7 @@ type S3File struct {
 	contentType  string
 	body         io.ReadCloser
 	lastModified time.Time
+	etag         string
 }
 
 var (
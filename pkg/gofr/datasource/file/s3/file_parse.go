@@ -159,6 +159,13 @@ func (f *S3File) Name() string {
 	return path.Base(f.name)
 }
 
+// ETag returns the entity tag of the object, which is the MD5 hash of its content unless the object was uploaded
+// in multiple parts or encrypted using a KMS key. It can be compared with an earlier value to detect whether the object
+// has changed without downloading it. It is empty for the directories.
+func (f *S3File) ETag() string {
+	return f.etag
+}
+
 // Mode is not supported for the current implementation of S3 buckets.
 // This method is included to adhere to the FileSystem interface in GoFr.
 //
@@ -190,6 +190,7 @@ func (f *FileSystem) Create(name string) (file.File, error) {
 		contentType:  *res.ContentType,
 		lastModified: *res.LastModified,
 		size:         *res.ContentLength,
+		etag:         trimETag(res.ETag),
 	}, nil
 }
 
@@ -265,6 +266,7 @@ func (f *FileSystem) Open(name string) (file.File, error) {
 		contentType:  *res.ContentType,
 		lastModified: *res.LastModified,
 		size:         *res.ContentLength,
+		etag:         trimETag(res.ETag),
 	}, nil
 }
 
@@ -142,6 +142,11 @@ func (f *FileSystem) RemoveAll(name string) error {
 	return nil
 }
 
+// trimETag returns the ETag of an object without the quotes which S3 returns it with.
+func trimETag(etag *string) string {
+	return strings.Trim(aws.ToString(etag), `"`)
+}
+
 func getRelativepath(key, filePath string) string {
 	relativepath := strings.TrimPrefix(key, filePath)
 	oneLevelDeepPathIndex := strings.Index(relativepath, string(filepath.Separator))
@@ -217,6 +222,7 @@ func (f *FileSystem) ReadDir(name string) ([]file.FileInfo, error) {
 			size:         *entries.Contents[i].Size,
 			name:         f.config.BucketName + string(filepath.Separator) + *entries.Contents[i].Key,
 			lastModified: *entries.Contents[i].LastModified,
+			etag:         trimETag(entries.Contents[i].ETag),
 		})
 	}
 
@@ -228,6 +234,92 @@ func (f *FileSystem) ReadDir(name string) ([]file.FileInfo, error) {
 	return fileInfo, nil
 }
 
+// Glob returns the files in the S3 bucket whose keys match the pattern, with the keys as their names.
+//
+// Only the objects under the directory of the pattern, i.e. the part before the first path segment with pattern
+// characters, are listed from S3, and their keys are matched on the client. Directories are not returned, as they
+// are only the prefixes of the keys in S3.
+func (f *FileSystem) Glob(pattern string) ([]file.FileInfo, error) {
+	var msg string
+
+	st := statusErr
+
+	defer f.sendOperationStats(&FileLog{
+		Operation: "GLOB",
+		Location:  getLocation(f.config.BucketName),
+		Status:    &st,
+		Message:   &msg,
+	}, time.Now())
+
+	if _, err := file.MatchGlob(pattern, ""); err != nil {
+		msg = fmt.Sprintf("Invalid pattern %q", pattern)
+		return nil, err
+	}
+
+	prefix := file.GlobDir(pattern) + string(filepath.Separator)
+	if prefix == "."+string(filepath.Separator) {
+		prefix = ""
+	}
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(f.config.BucketName),
+		Prefix: aws.String(prefix),
+	}
+
+	fileInfo := make([]file.FileInfo, 0)
+
+	for {
+		res, err := f.conn.ListObjectsV2(context.TODO(), input)
+		if err != nil {
+			msg = fmt.Sprintf("Error retrieving objects: %v", err)
+			return nil, err
+		}
+
+		fileInfo = append(fileInfo, f.matchObjects(pattern, res.Contents)...)
+
+		if res.IsTruncated == nil || !*res.IsTruncated {
+			break
+		}
+
+		input.ContinuationToken = res.NextContinuationToken
+	}
+
+	st = statusSuccess
+	msg = fmt.Sprintf("Found %d files matching %q", len(fileInfo), pattern)
+
+	return fileInfo, nil
+}
+
+// matchObjects returns the FileInfo of the objects whose keys match the pattern.
+func (f *FileSystem) matchObjects(pattern string, objects []types.Object) []file.FileInfo {
+	fileInfo := make([]file.FileInfo, 0)
+
+	for i := range objects {
+		key := *objects[i].Key
+
+		// keys ending with the separator are the placeholders of the directories
+		if strings.HasSuffix(key, string(filepath.Separator)) {
+			continue
+		}
+
+		if ok, _ := file.MatchGlob(pattern, key); !ok {
+			continue
+		}
+
+		fileInfo = append(fileInfo, file.WithName(&S3File{
+			conn:         f.conn,
+			logger:       f.logger,
+			metrics:      f.metrics,
+			size:         *objects[i].Size,
+			name:         f.config.BucketName + string(filepath.Separator) + key,
+			lastModified: *objects[i].LastModified,
+			etag:         trimETag(objects[i].ETag),
+		}, key))
+	}
+
+	return fileInfo
+}
+
 // ChDir is not supported in S3 as the bucket is constant and the filesystem requires a full path relative to the selected bucket.
 //
 // This method attempts to change the current directory, but S3 does not support directory changes due to its flat file structure.
@@ -385,6 +477,7 @@ func (f *FileSystem) Stat(name string) (file.FileInfo, error) {
 		name:         f.config.BucketName + string(filepath.Separator) + *res.Contents[0].Key,
 		contentType:  filetype,
 		lastModified: *res.Contents[0].LastModified,
+		etag:         trimETag(res.Contents[0].ETag),
 	}, nil
 }
 
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	file "gofr.dev/pkg/gofr/datasource/file"
 )
 
 var errMock = errors.New("mocked error")
@@ -385,6 +386,77 @@ func runReadDirTest(t *testing.T, fs *FileSystem, dirPath string, expectedResult
 	assert.Equal(t, expectedResults, results, "Mismatch in results for path: %v", dirPath)
 }
 
+func Test_Glob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockS3 := NewMocks3Client(ctrl)
+	mockLogger := NewMockLogger(ctrl)
+	mockMetrics := NewMockMetrics(ctrl)
+
+	config := &Config{BucketName: "test-bucket"}
+
+	fs := &FileSystem{conn: mockS3, logger: mockLogger, config: config, metrics: mockMetrics}
+
+	mockLogger.EXPECT().Debug(gomock.Any()).AnyTimes()
+
+	mockS3.EXPECT().ListObjectsV2(gomock.Any(), &s3.ListObjectsV2Input{
+		Bucket: aws.String("test-bucket"),
+		Prefix: aws.String("logs/"),
+	}).Return(&s3.ListObjectsV2Output{
+		Contents: []types.Object{
+			{Key: aws.String("logs/"), Size: aws.Int64(0), LastModified: aws.Time(time.Now())},
+			{Key: aws.String("logs/app.log"), Size: aws.Int64(1), LastModified: aws.Time(time.Now()), ETag: aws.String(`"abc123"`)},
+			{Key: aws.String("logs/app.txt"), Size: aws.Int64(2), LastModified: aws.Time(time.Now())},
+		},
+		IsTruncated:           aws.Bool(true),
+		NextContinuationToken: aws.String("next"),
+	}, nil)
+
+	mockS3.EXPECT().ListObjectsV2(gomock.Any(), &s3.ListObjectsV2Input{
+		Bucket:            aws.String("test-bucket"),
+		Prefix:            aws.String("logs/"),
+		ContinuationToken: aws.String("next"),
+	}).Return(&s3.ListObjectsV2Output{
+		Contents: []types.Object{
+			{Key: aws.String("logs/2024/01/db.log"), Size: aws.Int64(3), LastModified: aws.Time(time.Now())},
+		},
+		IsTruncated: aws.Bool(false),
+	}, nil)
+
+	res, err := fs.Glob("logs/**/*.log")
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(res))
+	for _, entry := range res {
+		names = append(names, entry.Name())
+	}
+
+	assert.Equal(t, []string{"logs/app.log", "logs/2024/01/db.log"}, names)
+	assert.Equal(t, "abc123", file.ETag(res[0]), "ETag should be returned without quotes")
+	assert.Empty(t, file.ETag(res[1]))
+}
+
+func Test_GlobError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockS3 := NewMocks3Client(ctrl)
+	mockLogger := NewMockLogger(ctrl)
+
+	fs := &FileSystem{conn: mockS3, logger: mockLogger, config: &Config{BucketName: "test-bucket"}}
+
+	mockLogger.EXPECT().Debug(gomock.Any()).AnyTimes()
+
+	_, err := fs.Glob("logs/[")
+	require.Error(t, err, "invalid pattern should return an error")
+
+	mockS3.EXPECT().ListObjectsV2(gomock.Any(), gomock.Any()).Return(nil, errMock)
+
+	_, err = fs.Glob("*.log")
+	require.ErrorIs(t, err, errMock)
+}
+
 func TestRemove(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
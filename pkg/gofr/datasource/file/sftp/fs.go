@@ -226,6 +226,22 @@ func (f *FileSystem) ReadDir(dir string) ([]file.FileInfo, error) {
 	return newDirs, nil
 }
 
+// Glob returns the files and directories matching the pattern, with the matched paths as their names.
+// The directories under the pattern are listed recursively to match their entries.
+func (f *FileSystem) Glob(pattern string) ([]file.FileInfo, error) {
+	status := statusSuccess
+
+	defer f.sendOperationStats(&FileLog{Operation: "GLOB", Location: pattern, Status: &status}, time.Now())
+
+	fileInfo, err := file.GlobWalk(pattern, f.ReadDir)
+	if err != nil {
+		status = statusError
+		return nil, err
+	}
+
+	return fileInfo, nil
+}
+
 func (f *FileSystem) Stat(name string) (file.FileInfo, error) {
 	status := statusSuccess
 
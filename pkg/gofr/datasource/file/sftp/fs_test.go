@@ -4,6 +4,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/pkg/sftp"
 	"github.com/stretchr/testify/require"
@@ -282,6 +283,42 @@ func TestFiles_ReadDir(t *testing.T) {
 	}
 }
 
+type mockFileInfo struct {
+	name  string
+	isDir bool
+}
+
+func (m mockFileInfo) Name() string     { return m.name }
+func (mockFileInfo) Size() int64        { return 0 }
+func (mockFileInfo) Mode() os.FileMode  { return 0 }
+func (mockFileInfo) ModTime() time.Time { return time.Time{} }
+func (m mockFileInfo) IsDir() bool      { return m.isDir }
+func (mockFileInfo) Sys() any           { return nil }
+
+func TestFiles_Glob(t *testing.T) {
+	client, mocks := getMocks(t)
+
+	mocks.client.EXPECT().ReadDir("logs").Return([]os.FileInfo{
+		mockFileInfo{name: "app.log"}, mockFileInfo{name: "app.txt"}, mockFileInfo{name: "2024", isDir: true},
+	}, nil)
+	mocks.client.EXPECT().ReadDir("logs/2024").Return([]os.FileInfo{mockFileInfo{name: "db.log"}}, nil)
+
+	files, err := client.Glob("logs/**/*.log")
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		names = append(names, f.Name())
+	}
+
+	require.Equal(t, []string{"logs/app.log", "logs/2024/db.log"}, names)
+
+	mocks.client.EXPECT().ReadDir(".").Return(nil, errOpenFile)
+
+	_, err = client.Glob("*.log")
+	require.ErrorIs(t, err, errOpenFile)
+}
+
 func TestFiles_Stat(t *testing.T) {
 	client, mocks := getMocks(t)
 
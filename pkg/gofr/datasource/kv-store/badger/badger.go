@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -124,6 +125,121 @@ func (c *Client) Delete(ctx context.Context, key string) error {
 	})
 }
 
+// GetAll returns the values of the keys in a single transaction. The keys which do not exist are omitted from the
+// result, and the keys which could not be read are reported in a *BatchError returned along with the other values.
+func (c *Client) GetAll(ctx context.Context, keys []string) (map[string]string, error) {
+	span := c.addTrace(ctx, "getall", strings.Join(keys, ","))
+
+	defer c.sendOperationStats(time.Now(), "GETALL", "getall", span, keys...)
+
+	values := make(map[string]string, len(keys))
+	failed := make(map[string]error)
+
+	txn := c.db.NewTransaction(false)
+	defer txn.Discard()
+
+	for _, key := range keys {
+		item, err := txn.Get([]byte(key))
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			continue
+		}
+
+		if err != nil {
+			failed[key] = err
+
+			continue
+		}
+
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			failed[key] = err
+
+			continue
+		}
+
+		values[key] = string(value)
+	}
+
+	return values, newBatchError(failed)
+}
+
+// SetAll sets the key-value pairs in as few transactions as possible. The keys which could not be set are reported
+// in a *BatchError, while the others are set.
+func (c *Client) SetAll(ctx context.Context, kv map[string]string) error {
+	keys := make([]string, 0, len(kv))
+	for key := range kv {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	span := c.addTrace(ctx, "setall", strings.Join(keys, ","))
+
+	defer c.sendOperationStats(time.Now(), "SETALL", "setall", span, keys...)
+
+	return c.useBatch(keys, func(txn *badger.Txn, key string) error {
+		return txn.Set([]byte(key), []byte(kv[key]))
+	})
+}
+
+// DeleteAll deletes the keys in as few transactions as possible. The keys which could not be deleted are reported
+// in a *BatchError, while the others are deleted.
+func (c *Client) DeleteAll(ctx context.Context, keys []string) error {
+	span := c.addTrace(ctx, "deleteall", strings.Join(keys, ","))
+
+	defer c.sendOperationStats(time.Now(), "DELETEALL", "deleteall", span, keys...)
+
+	return c.useBatch(keys, func(txn *badger.Txn, key string) error {
+		return txn.Delete([]byte(key))
+	})
+}
+
+// useBatch applies f to each of the keys, committing the transaction and starting a new one whenever it grows
+// too big. The keys of a transaction which fails to commit are all reported as failed.
+func (c *Client) useBatch(keys []string, f func(txn *badger.Txn, key string) error) error {
+	failed := make(map[string]error)
+	pending := make([]string, 0, len(keys))
+
+	txn := c.db.NewTransaction(true)
+
+	commit := func() {
+		err := txn.Commit()
+		if err != nil {
+			c.logger.Debugf("error while committing transaction: %v", err)
+
+			for _, key := range pending {
+				failed[key] = err
+			}
+		}
+
+		pending = pending[:0]
+	}
+
+	for _, key := range keys {
+		err := f(txn, key)
+		if errors.Is(err, badger.ErrTxnTooBig) {
+			commit()
+
+			txn = c.db.NewTransaction(true)
+			err = f(txn, key)
+		}
+
+		if err != nil {
+			c.logger.Debugf("error while executing transaction for key: %v, error: %v", key, err)
+
+			failed[key] = err
+
+			continue
+		}
+
+		pending = append(pending, key)
+	}
+
+	commit()
+
+	return newBatchError(failed)
+}
+
 func (c *Client) useTransaction(f func(txn *badger.Txn) error) error {
 	txn := c.db.NewTransaction(true)
 	defer txn.Discard()
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/dgraph-io/badger/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -79,3 +80,47 @@ func Test_ClientHealthCheck(t *testing.T) {
 	require.NoError(t, err)
 	assert.Contains(t, fmt.Sprint(val), "UP")
 }
+
+func Test_ClientSetAllGetAll(t *testing.T) {
+	cl := setupDB(t)
+
+	err := cl.SetAll(context.Background(), map[string]string{"key1": "value1", "key2": "value2"})
+	require.NoError(t, err)
+
+	values, err := cl.GetAll(context.Background(), []string{"key1", "key2", "missing"})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"key1": "value1", "key2": "value2"}, values)
+}
+
+func Test_ClientSetAllPartialFailure(t *testing.T) {
+	cl := setupDB(t)
+
+	err := cl.SetAll(context.Background(), map[string]string{"": "empty", "key": "value"})
+
+	var batchErr *BatchError
+
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, []string{""}, batchErr.Keys())
+	require.ErrorIs(t, err, badger.ErrEmptyKey)
+
+	val, err := cl.Get(context.Background(), "key")
+
+	require.NoError(t, err)
+	assert.Equal(t, "value", val)
+}
+
+func Test_ClientDeleteAll(t *testing.T) {
+	cl := setupDB(t)
+
+	err := cl.SetAll(context.Background(), map[string]string{"key1": "value1", "key2": "value2", "key3": "value3"})
+	require.NoError(t, err)
+
+	err = cl.DeleteAll(context.Background(), []string{"key1", "key2"})
+	require.NoError(t, err)
+
+	values, err := cl.GetAll(context.Background(), []string{"key1", "key2", "key3"})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"key3": "value3"}, values)
+}
@@ -0,0 +1,48 @@
+package badger
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// BatchError is returned by GetAll, SetAll and DeleteAll when the operation fails for some of the keys.
+// Failed holds the error of each of the keys which failed.
+type BatchError struct {
+	Failed map[string]error
+}
+
+// newBatchError returns a *BatchError for the failed keys, or nil if there are none.
+func newBatchError(failed map[string]error) error {
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return &BatchError{Failed: failed}
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("operation failed for %d keys: %s", len(e.Failed), strings.Join(e.Keys(), ", "))
+}
+
+// Keys returns the keys which failed in sorted order.
+func (e *BatchError) Keys() []string {
+	keys := make([]string, 0, len(e.Failed))
+	for key := range e.Failed {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Unwrap returns the errors of the keys, so that they can be matched using errors.Is and errors.As.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failed))
+	for _, key := range e.Keys() {
+		errs = append(errs, e.Failed[key])
+	}
+
+	return errs
+}
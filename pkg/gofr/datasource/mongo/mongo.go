@@ -227,6 +227,24 @@ func (c *Client) Find(ctx context.Context, collection string, filter, results an
 	return nil
 }
 
+// Aggregate runs the aggregation pipeline on the specified collection and binds the resulting documents to results.
+func (c *Client) Aggregate(ctx context.Context, collection string, pipeline, results any) error {
+	tracerCtx, span := c.addTrace(ctx, "aggregate", collection)
+
+	// the stats are sent and the span is ended whether the aggregation fails or not
+	defer c.sendOperationStats(&QueryLog{Query: "aggregate", Collection: collection, Filter: pipeline}, time.Now(),
+		"aggregate", span)
+
+	cur, err := c.Database.Collection(collection).Aggregate(tracerCtx, pipeline)
+	if err != nil {
+		return err
+	}
+
+	defer cur.Close(ctx)
+
+	return cur.All(ctx, results)
+}
+
 // FindOne retrieves a single document from the specified collection based on the provided filter and binds response to result.
 func (c *Client) FindOne(ctx context.Context, collection string, filter, result any) error {
 	tracerCtx, span := c.addTrace(ctx, "findOne", collection)
@@ -381,6 +381,58 @@ func Test_FindMultipleCommands(t *testing.T) {
 	})
 }
 
+func Test_AggregateCommands(t *testing.T) {
+	// Create a connected client using the mock database
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	metrics := NewMockMetrics(ctrl)
+	logger := NewMockLogger(ctrl)
+
+	cl := Client{metrics: metrics, tracer: otel.GetTracerProvider().Tracer("gofr-mongo")}
+
+	// the stats are recorded for the failed aggregation as well
+	metrics.EXPECT().RecordHistogram(context.Background(), "app_mongo_stats", gomock.Any(), "hostname",
+		gomock.Any(), "database", gomock.Any(), "type", "aggregate").Times(2)
+
+	logger.EXPECT().Debug(gomock.Any()).Times(2)
+
+	cl.logger = logger
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$group", Value: bson.D{{Key: "_id", Value: "$name"}, {Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}}}}},
+	}
+
+	mt.Run("AggregateSuccess", func(mt *mtest.T) {
+		cl.Database = mt.DB
+
+		var results []bson.M
+
+		first := mtest.CreateCursorResponse(0, "foo.bar", mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "john"},
+			{Key: "count", Value: int32(2)},
+		})
+
+		mt.AddMockResponses(first)
+
+		err := cl.Aggregate(context.Background(), mt.Coll.Name(), pipeline, &results)
+
+		require.NoError(t, err, "Unexpected error during Aggregate operation")
+		assert.Equal(t, []bson.M{{"_id": "john", "count": int32(2)}}, results)
+	})
+
+	mt.Run("AggregateCursorError", func(mt *mtest.T) {
+		cl.Database = mt.DB
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		err := cl.Aggregate(context.Background(), mt.Coll.Name(), pipeline, nil)
+
+		require.ErrorContains(t, err, "database response does not contain a cursor")
+	})
+}
+
 func Test_FindOneCommands(t *testing.T) {
 	// Create a connected client using the mock database
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
@@ -0,0 +1,58 @@
+package pubsub
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// BatchPublisher is implemented by the clients that can publish multiple messages to a topic using the
+// native batching of the broker.
+type BatchPublisher interface {
+	PublishBatch(ctx context.Context, topic string, messages [][]byte) error
+}
+
+// BatchError is returned when some of the messages of a batch could not be published.
+type BatchError struct {
+	// Errors maps the index of each failed message in the batch to the error it failed with.
+	Errors map[int]error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("failed to publish %d message(s) of the batch", len(e.Errors))
+}
+
+// Failed returns the indexes of the failed messages in ascending order.
+func (e *BatchError) Failed() []int {
+	indexes := make([]int, 0, len(e.Errors))
+
+	for i := range e.Errors {
+		indexes = append(indexes, i)
+	}
+
+	sort.Ints(indexes)
+
+	return indexes
+}
+
+// PublishBatch publishes the messages to the topic in a single batch if the publisher implements BatchPublisher,
+// otherwise the messages are published one at a time. A *BatchError is returned if any of the messages failed.
+func PublishBatch(ctx context.Context, p Publisher, topic string, messages [][]byte) error {
+	if bp, ok := p.(BatchPublisher); ok {
+		return bp.PublishBatch(ctx, topic, messages)
+	}
+
+	batchErr := &BatchError{Errors: make(map[int]error)}
+
+	for i, message := range messages {
+		if err := p.Publish(ctx, topic, message); err != nil {
+			batchErr.Errors[i] = err
+		}
+	}
+
+	if len(batchErr.Errors) > 0 {
+		return batchErr
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errPublish = errors.New("publish error")
+
+type mockPublisher struct {
+	published [][]byte
+}
+
+func (p *mockPublisher) Publish(_ context.Context, _ string, message []byte) error {
+	if string(message) == "fail" {
+		return errPublish
+	}
+
+	p.published = append(p.published, message)
+
+	return nil
+}
+
+type mockBatchPublisher struct {
+	mockPublisher
+
+	batches int
+}
+
+func (p *mockBatchPublisher) PublishBatch(_ context.Context, _ string, messages [][]byte) error {
+	p.batches++
+	p.published = append(p.published, messages...)
+
+	return nil
+}
+
+func TestPublishBatch_Fallback(t *testing.T) {
+	p := &mockPublisher{}
+
+	err := PublishBatch(context.Background(), p, "test", [][]byte{[]byte("a"), []byte("fail"), []byte("b"), []byte("fail")})
+
+	var batchErr *BatchError
+
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, []int{1, 3}, batchErr.Failed())
+	assert.Equal(t, errPublish, batchErr.Errors[1])
+	assert.Equal(t, "failed to publish 2 message(s) of the batch", batchErr.Error())
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b")}, p.published)
+}
+
+func TestPublishBatch_BatchPublisher(t *testing.T) {
+	p := &mockBatchPublisher{}
+
+	err := PublishBatch(context.Background(), p, "test", [][]byte{[]byte("a"), []byte("b")})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, p.batches)
+	assert.Len(t, p.published, 2)
+}
@@ -5,12 +5,14 @@ package google
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
 
 	gcPubSub "cloud.google.com/go/pubsub"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 
 	"gofr.dev/pkg/gofr/datasource/pubsub"
 )
@@ -95,6 +97,7 @@ func (g *googleClient) Publish(ctx context.Context, topic string, message []byte
 	start := time.Now()
 	result := t.Publish(ctx, &gcPubSub.Message{
 		Data:        message,
+		Attributes:  traceAttributes(ctx),
 		PublishTime: time.Now(),
 	})
 	end := time.Since(start)
@@ -121,6 +124,79 @@ func (g *googleClient) Publish(ctx context.Context, topic string, message []byte
 	return nil
 }
 
+// PublishBatch publishes all the messages before waiting for their results, letting the client batch them.
+// If some of the messages fail, a *pubsub.BatchError with the indexes of the failed messages is returned.
+func (g *googleClient) PublishBatch(ctx context.Context, topic string, messages [][]byte) error {
+	ctx, span := otel.GetTracerProvider().Tracer("gofr").Start(ctx, "publish-batch-gcp")
+	defer span.End()
+
+	for range messages {
+		g.metrics.IncrementCounter(ctx, "app_pubsub_publish_total_count", "topic", topic)
+	}
+
+	t, err := g.getTopic(ctx, topic)
+	if err != nil {
+		g.logger.Errorf("could not create topic '%s', error: %v", topic, err)
+
+		return err
+	}
+
+	results := make([]*gcPubSub.PublishResult, len(messages))
+
+	start := time.Now()
+
+	for i, message := range messages {
+		results[i] = t.Publish(ctx, &gcPubSub.Message{
+			Data:        message,
+			Attributes:  traceAttributes(ctx),
+			PublishTime: time.Now(),
+		})
+	}
+
+	batchErr := &pubsub.BatchError{Errors: make(map[int]error)}
+
+	for i, result := range results {
+		if _, err = result.Get(ctx); err != nil {
+			batchErr.Errors[i] = err
+			continue
+		}
+
+		g.metrics.IncrementCounter(ctx, "app_pubsub_publish_success_count", "topic", topic)
+	}
+
+	end := time.Since(start)
+
+	g.logger.Debug(&pubsub.Log{
+		Mode:          "PUB",
+		CorrelationID: span.SpanContext().TraceID().String(),
+		MessageValue:  fmt.Sprintf("batch of %d messages", len(messages)),
+		Topic:         topic,
+		Host:          g.ProjectID,
+		PubSubBackend: "GCP",
+		Time:          end.Microseconds(),
+	})
+
+	if len(batchErr.Errors) > 0 {
+		g.logger.Errorf("failed to publish %d of %d messages to google topic '%s'", len(batchErr.Errors), len(messages), topic)
+
+		return batchErr
+	}
+
+	return nil
+}
+
+// traceAttributes returns the trace context of ctx as message attributes, so that subscribers can continue the trace.
+func traceAttributes(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	if len(carrier) == 0 {
+		return nil
+	}
+
+	return carrier
+}
+
 func (g *googleClient) Subscribe(ctx context.Context, topic string) (*pubsub.Message, error) {
 	var end time.Duration
 
@@ -143,7 +219,7 @@ func (g *googleClient) Subscribe(ctx context.Context, topic string) (*pubsub.Mes
 		start := time.Now()
 
 		processMessage := func(ctx context.Context, msg *gcPubSub.Message) {
-			m := pubsub.NewMessage(ctx)
+			m := pubsub.NewMessage(otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(msg.Attributes)))
 			end = time.Since(start)
 
 			m.Topic = topic
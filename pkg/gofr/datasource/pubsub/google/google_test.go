@@ -10,6 +10,9 @@ import (
 	"cloud.google.com/go/pubsub/pstest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
@@ -28,6 +31,14 @@ var (
 func getGoogleClient(t *testing.T) *gcPubSub.Client {
 	t.Helper()
 
+	client, _ := getGoogleClientWithServer(t)
+
+	return client
+}
+
+func getGoogleClientWithServer(t *testing.T) (*gcPubSub.Client, *pstest.Server) {
+	t.Helper()
+
 	srv := pstest.NewServer()
 
 	conn, err := grpc.NewClient(srv.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -40,7 +51,7 @@ func getGoogleClient(t *testing.T) *gcPubSub.Client {
 		t.Errorf("could not initialize a test client")
 	}
 
-	return client
+	return client, srv
 }
 
 func TestGoogleClient_New(t *testing.T) {
@@ -125,6 +136,74 @@ func TestGoogleClient_Publish_Success(t *testing.T) {
 	assert.Contains(t, out, "GCP")
 }
 
+func TestGoogleClient_PublishBatch(t *testing.T) {
+	client := getGoogleClient(t)
+	defer client.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMetrics := NewMockMetrics(ctrl)
+
+	topic := "test-topic"
+
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_publish_total_count", "topic", topic).Times(2)
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_publish_success_count", "topic", topic).Times(2)
+
+	out := testutil.StdoutOutputForFunc(func() {
+		g := &googleClient{
+			logger: logging.NewMockLogger(logging.DEBUG),
+			client: client,
+			Config: Config{
+				ProjectID:        "test",
+				SubscriptionName: "sub",
+			},
+			metrics: mockMetrics,
+		}
+
+		err := g.PublishBatch(context.Background(), topic, [][]byte{[]byte("first"), []byte("second")})
+
+		require.NoError(t, err)
+	})
+
+	assert.Contains(t, out, "batch of 2 messages")
+	assert.Contains(t, out, "GCP")
+}
+
+func TestGoogleClient_PublishBatchTraceAttributes(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+
+	client, srv := getGoogleClientWithServer(t)
+	defer client.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMetrics := NewMockMetrics(ctrl)
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), gomock.Any(), "topic", "test-topic").AnyTimes()
+
+	g := &googleClient{logger: logging.NewMockLogger(logging.ERROR), client: client,
+		Config: Config{ProjectID: "test", SubscriptionName: "sub"}, metrics: mockMetrics}
+
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	err := g.PublishBatch(ctx, "test-topic", [][]byte{[]byte("first"), []byte("second")})
+	require.NoError(t, err)
+
+	messages := srv.Messages()
+	require.Len(t, messages, 2)
+
+	for i, msg := range messages {
+		assert.Contains(t, msg.Attributes["traceparent"], "00-01000000000000000000000000000000-",
+			"TEST[%d], Failed.\nmessage should carry the trace context", i)
+	}
+}
+
 func TestGoogleClient_PublishTopic_Error(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -15,3 +15,8 @@ func newGoogleMessage(msg *gcPubSub.Message) *googleMessage {
 func (gm *googleMessage) Commit() {
 	gm.msg.Ack()
 }
+
+// Nack asks Google Pub/Sub to redeliver the message.
+func (gm *googleMessage) Nack() {
+	gm.msg.Nack()
+}
@@ -31,6 +31,18 @@ type Committer interface {
 	Commit()
 }
 
+// Nacker is implemented by the Committers of the backends which can ask the broker to redeliver a message whose
+// handling failed, instead of waiting for its acknowledgement to time out.
+type Nacker interface {
+	Nack()
+}
+
+// Dropper is implemented by the Committers of the backends which can tell the broker to stop redelivering a message
+// which can never be handled, instead of acknowledging it as handled.
+type Dropper interface {
+	Drop()
+}
+
 type Logger interface {
 	Debugf(format string, args ...any)
 	Debug(args ...any)
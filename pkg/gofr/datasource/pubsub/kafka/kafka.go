@@ -5,11 +5,15 @@ package kafka
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/segmentio/kafka-go"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 
 	"gofr.dev/pkg/gofr/datasource/pubsub"
 )
@@ -21,12 +25,25 @@ var (
 	errBatchSize                = errors.New("KAFKA_BATCH_SIZE must be greater than 0")
 	errBatchBytes               = errors.New("KAFKA_BATCH_BYTES must be greater than 0")
 	errBatchTimeout             = errors.New("KAFKA_BATCH_TIMEOUT must be greater than 0")
+	errInvalidOffsetReset       = errors.New("KAFKA_AUTO_OFFSET_RESET must be either earliest or latest")
+	errInvalidCommitMode        = errors.New("KAFKA_COMMIT_MODE must be either manual or auto")
+	errCommitInterval           = errors.New("KAFKA_COMMIT_INTERVAL must not be negative")
 )
 
 const (
 	DefaultBatchSize    = 100
 	DefaultBatchBytes   = 1048576
 	DefaultBatchTimeout = 1000
+
+	// OffsetResetEarliest starts a new consumer group from the oldest message of the topic.
+	OffsetResetEarliest = "earliest"
+	// OffsetResetLatest starts a new consumer group from the messages published after it subscribed.
+	OffsetResetLatest = "latest"
+
+	// CommitModeManual commits a message only after the subscribe handler returns nil.
+	CommitModeManual = "manual"
+	// CommitModeAuto commits a message as soon as it is read, irrespective of the handler result.
+	CommitModeAuto = "auto"
 )
 
 type Config struct {
@@ -37,6 +54,14 @@ type Config struct {
 	BatchSize       int
 	BatchBytes      int
 	BatchTimeout    int
+
+	// AutoOffsetReset is the offset a consumer group starts from when it has no committed offset, either
+	// earliest or latest. It takes precedence over OffSet if set.
+	AutoOffsetReset string
+	// CommitMode is either manual (default) or auto.
+	CommitMode string
+	// CommitInterval flushes commits to the broker periodically instead of on every message if greater than 0.
+	CommitInterval time.Duration
 }
 
 type kafkaClient struct {
@@ -121,6 +146,22 @@ func validateConfigs(conf Config) error {
 		return errBatchTimeout
 	}
 
+	switch strings.ToLower(conf.AutoOffsetReset) {
+	case "", OffsetResetEarliest, OffsetResetLatest:
+	default:
+		return errInvalidOffsetReset
+	}
+
+	switch strings.ToLower(conf.CommitMode) {
+	case "", CommitModeManual, CommitModeAuto:
+	default:
+		return errInvalidCommitMode
+	}
+
+	if conf.CommitInterval < 0 {
+		return errCommitInterval
+	}
+
 	return nil
 }
 
@@ -137,9 +178,10 @@ func (k *kafkaClient) Publish(ctx context.Context, topic string, message []byte)
 	start := time.Now()
 	err := k.writer.WriteMessages(ctx,
 		kafka.Message{
-			Topic: topic,
-			Value: message,
-			Time:  time.Now(),
+			Topic:   topic,
+			Value:   message,
+			Headers: traceHeaders(ctx),
+			Time:    time.Now(),
 		},
 	)
 	end := time.Since(start)
@@ -164,6 +206,99 @@ func (k *kafkaClient) Publish(ctx context.Context, topic string, message []byte)
 	return nil
 }
 
+// PublishBatch publishes the messages to the topic in a single call, letting the writer batch them.
+// If only some of the messages fail, a *pubsub.BatchError with the indexes of the failed messages is returned.
+func (k *kafkaClient) PublishBatch(ctx context.Context, topic string, messages [][]byte) error {
+	ctx, span := otel.GetTracerProvider().Tracer("gofr").Start(ctx, "kafka-publish-batch")
+	defer span.End()
+
+	for range messages {
+		k.metrics.IncrementCounter(ctx, "app_pubsub_publish_total_count", "topic", topic)
+	}
+
+	if k.writer == nil || topic == "" {
+		return errPublisherNotConfigured
+	}
+
+	msgs := make([]kafka.Message, len(messages))
+
+	for i, message := range messages {
+		msgs[i] = kafka.Message{
+			Topic:   topic,
+			Value:   message,
+			Headers: traceHeaders(ctx),
+			Time:    time.Now(),
+		}
+	}
+
+	start := time.Now()
+	err := k.writer.WriteMessages(ctx, msgs...)
+	end := time.Since(start)
+
+	var writeErrs kafka.WriteErrors
+
+	if err != nil && !errors.As(err, &writeErrs) {
+		k.logger.Errorf("failed to publish batch of %d messages to kafka broker, error: %v", len(messages), err)
+
+		return err
+	}
+
+	k.logger.Debug(&pubsub.Log{
+		Mode:          "PUB",
+		CorrelationID: span.SpanContext().TraceID().String(),
+		MessageValue:  fmt.Sprintf("batch of %d messages", len(messages)),
+		Topic:         topic,
+		Host:          k.config.Broker,
+		PubSubBackend: "KAFKA",
+		Time:          end.Microseconds(),
+	})
+
+	batchErr := &pubsub.BatchError{Errors: make(map[int]error)}
+
+	for i := range messages {
+		if i < len(writeErrs) && writeErrs[i] != nil {
+			batchErr.Errors[i] = writeErrs[i]
+			continue
+		}
+
+		k.metrics.IncrementCounter(ctx, "app_pubsub_publish_success_count", "topic", topic)
+	}
+
+	if len(batchErr.Errors) > 0 {
+		k.logger.Errorf("failed to publish %d of %d messages to kafka broker", len(batchErr.Errors), len(messages))
+
+		return batchErr
+	}
+
+	return nil
+}
+
+// traceHeaders returns the trace context of ctx as message headers, so that consumers can continue the trace.
+func traceHeaders(ctx context.Context) []kafka.Header {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	headers := make([]kafka.Header, 0, len(carrier))
+
+	for key, value := range carrier {
+		headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+	}
+
+	return headers
+}
+
+// extractTrace returns ctx carrying the trace context of the message headers, so that the message is handled in the
+// trace of its publisher.
+func extractTrace(ctx context.Context, headers []kafka.Header) context.Context {
+	carrier := propagation.MapCarrier{}
+
+	for _, header := range headers {
+		carrier[header.Key] = string(header.Value)
+	}
+
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
+
 func (k *kafkaClient) Subscribe(ctx context.Context, topic string) (*pubsub.Message, error) {
 	if k.config.ConsumerGroupID == "" {
 		k.logger.Error("cannot subscribe as consumer_id is not provided in configs")
@@ -191,7 +326,18 @@ func (k *kafkaClient) Subscribe(ctx context.Context, topic string) (*pubsub.Mess
 
 	// Read a single message from the topic
 	reader = k.reader[topic]
-	msg, err := reader.FetchMessage(ctx)
+	autoCommit := strings.EqualFold(k.config.CommitMode, CommitModeAuto)
+
+	var msg kafka.Message
+
+	var err error
+
+	if autoCommit {
+		// ReadMessage commits the message as soon as it is read
+		msg, err = reader.ReadMessage(ctx)
+	} else {
+		msg, err = reader.FetchMessage(ctx)
+	}
 
 	if err != nil {
 		k.logger.Errorf("failed to read message from kafka topic %s: %v", topic, err)
@@ -199,10 +345,13 @@ func (k *kafkaClient) Subscribe(ctx context.Context, topic string) (*pubsub.Mess
 		return nil, err
 	}
 
-	m := pubsub.NewMessage(ctx)
+	m := pubsub.NewMessage(extractTrace(ctx, msg.Headers))
 	m.Value = msg.Value
 	m.Topic = topic
-	m.Committer = newKafkaMessage(&msg, k.reader[topic], k.logger)
+
+	if !autoCommit {
+		m.Committer = newKafkaMessage(&msg, k.reader[topic], k.logger)
+	}
 
 	end := time.Since(start)
 
@@ -218,6 +367,12 @@ func (k *kafkaClient) Subscribe(ctx context.Context, topic string) (*pubsub.Mess
 
 	k.metrics.IncrementCounter(ctx, "app_pubsub_subscribe_success_count", "topic", topic, "consumer_group", k.config.ConsumerGroupID)
 
+	// the high water mark is the offset of the next message to be written to the partition, zero when it is unknown
+	if msg.HighWaterMark > 0 {
+		k.metrics.SetGauge("app_pubsub_consumer_lag", float64(msg.HighWaterMark-msg.Offset-1), "topic", topic,
+			"partition", strconv.Itoa(msg.Partition), "consumer_group", k.config.ConsumerGroupID)
+	}
+
 	return m, err
 }
 
@@ -239,18 +394,30 @@ func (k *kafkaClient) Close() (err error) {
 
 func (k *kafkaClient) getNewReader(topic string) Reader {
 	reader := kafka.NewReader(kafka.ReaderConfig{
-		GroupID:     k.config.ConsumerGroupID,
-		Brokers:     []string{k.config.Broker},
-		Topic:       topic,
-		MinBytes:    10e3,
-		MaxBytes:    10e6,
-		Dialer:      k.dialer,
-		StartOffset: int64(k.config.OffSet),
+		GroupID:        k.config.ConsumerGroupID,
+		Brokers:        []string{k.config.Broker},
+		Topic:          topic,
+		MinBytes:       10e3,
+		MaxBytes:       10e6,
+		Dialer:         k.dialer,
+		StartOffset:    k.config.startOffset(),
+		CommitInterval: k.config.CommitInterval,
 	})
 
 	return reader
 }
 
+func (c *Config) startOffset() int64 {
+	switch strings.ToLower(c.AutoOffsetReset) {
+	case OffsetResetEarliest:
+		return kafka.FirstOffset
+	case OffsetResetLatest:
+		return kafka.LastOffset
+	default:
+		return int64(c.OffSet)
+	}
+}
+
 func (k *kafkaClient) DeleteTopic(_ context.Context, name string) error {
 	return k.conn.DeleteTopics(name)
 }
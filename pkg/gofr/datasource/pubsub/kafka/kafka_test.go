@@ -5,10 +5,14 @@ import (
 	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/segmentio/kafka-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/mock/gomock"
 
 	"gofr.dev/pkg/gofr/datasource/pubsub"
@@ -47,6 +51,29 @@ func TestValidateConfigs(t *testing.T) {
 			config:   Config{Broker: "kafkabroker", BatchSize: 1, BatchBytes: 1, BatchTimeout: 0},
 			expected: errBatchTimeout,
 		},
+		{
+			name: "Invalid AutoOffsetReset",
+			config: Config{Broker: "kafkabroker", BatchSize: 1, BatchBytes: 1, BatchTimeout: 1,
+				AutoOffsetReset: "beginning"},
+			expected: errInvalidOffsetReset,
+		},
+		{
+			name:     "Invalid CommitMode",
+			config:   Config{Broker: "kafkabroker", BatchSize: 1, BatchBytes: 1, BatchTimeout: 1, CommitMode: "sync"},
+			expected: errInvalidCommitMode,
+		},
+		{
+			name: "Negative CommitInterval",
+			config: Config{Broker: "kafkabroker", BatchSize: 1, BatchBytes: 1, BatchTimeout: 1,
+				CommitInterval: -time.Second},
+			expected: errCommitInterval,
+		},
+		{
+			name: "Valid offset reset and commit mode",
+			config: Config{Broker: "kafkabroker", BatchSize: 1, BatchBytes: 1, BatchTimeout: 1,
+				AutoOffsetReset: "Earliest", CommitMode: CommitModeAuto},
+			expected: nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -149,6 +176,103 @@ func TestKafkaClient_Publish(t *testing.T) {
 	assert.Contains(t, logs, "test")
 }
 
+func TestKafkaClient_PublishBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	errWrite := testutil.CustomError{ErrorMessage: "write error"}
+	messages := [][]byte{[]byte(`one`), []byte(`two`), []byte(`three`)}
+
+	testCases := []struct {
+		desc       string
+		writeErr   error
+		successes  int
+		expErr     error
+		expFailed  []int
+		expLogText string
+	}{
+		{desc: "all messages published", successes: 3},
+		{desc: "partial failure", writeErr: kafka.WriteErrors{nil, errWrite, nil}, successes: 2,
+			expFailed: []int{1}, expLogText: "failed to publish 1 of 3 messages"},
+		{desc: "batch failed", writeErr: errWrite, expErr: errWrite, expLogText: "failed to publish batch of 3 messages"},
+	}
+
+	for i, tc := range testCases {
+		mockWriter := NewMockWriter(ctrl)
+		mockMetrics := NewMockMetrics(ctrl)
+		k := &kafkaClient{writer: mockWriter, metrics: mockMetrics}
+
+		mockWriter.EXPECT().WriteMessages(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.writeErr)
+		mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_publish_total_count", "topic", "test").Times(3)
+		mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_publish_success_count", "topic", "test").
+			Times(tc.successes)
+
+		var err error
+
+		logs := testutil.StderrOutputForFunc(func() {
+			k.logger = logging.NewMockLogger(logging.DEBUG)
+
+			err = k.PublishBatch(context.Background(), "test", messages)
+		})
+
+		var batchErr *pubsub.BatchError
+
+		switch {
+		case tc.expFailed != nil:
+			require.ErrorAs(t, err, &batchErr, "TEST[%d], Failed.\n%s", i, tc.desc)
+			assert.Equal(t, tc.expFailed, batchErr.Failed(), "TEST[%d], Failed.\n%s", i, tc.desc)
+		default:
+			assert.Equal(t, tc.expErr, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+		}
+
+		assert.Contains(t, logs, tc.expLogText, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestKafkaClient_PublishBatchTraceHeaders(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWriter := NewMockWriter(ctrl)
+	mockMetrics := NewMockMetrics(ctrl)
+	k := &kafkaClient{writer: mockWriter, metrics: mockMetrics, logger: logging.NewMockLogger(logging.ERROR)}
+
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), gomock.Any(), "topic", "test").AnyTimes()
+
+	var written []kafka.Message
+
+	mockWriter.EXPECT().WriteMessages(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, msgs ...kafka.Message) error {
+			written = msgs
+			return nil
+		})
+
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	err := k.PublishBatch(ctx, "test", [][]byte{[]byte(`one`), []byte(`two`)})
+	require.NoError(t, err)
+
+	require.Len(t, written, 2)
+
+	for i, msg := range written {
+		require.Len(t, msg.Headers, 1, "TEST[%d], Failed.\nmessage should carry the trace context", i)
+		assert.Equal(t, "traceparent", msg.Headers[0].Key, "TEST[%d], Failed.\nmessage should carry the trace context", i)
+		assert.Contains(t, string(msg.Headers[0].Value), "00-01000000000000000000000000000000-",
+			"TEST[%d], Failed.\nmessage should carry the trace context", i)
+	}
+
+	// the consumer continues the trace of the publisher
+	spanCtx := trace.SpanContextFromContext(extractTrace(context.Background(), written[0].Headers))
+	assert.Equal(t, trace.TraceID{1}, spanCtx.TraceID())
+}
+
 func TestKafkaClient_SubscribeSuccess(t *testing.T) {
 	var (
 		msg *pubsub.Message
@@ -206,6 +330,90 @@ func TestKafkaClient_SubscribeSuccess(t *testing.T) {
 	assert.Contains(t, logs, "test")
 }
 
+func TestKafkaClient_SubscribeAutoCommit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReader := NewMockReader(ctrl)
+	mockMetrics := NewMockMetrics(ctrl)
+	k := &kafkaClient{
+		dialer: &kafka.Dialer{},
+		reader: map[string]Reader{
+			"test": mockReader,
+		},
+		logger: logging.NewMockLogger(logging.ERROR),
+		config: Config{
+			ConsumerGroupID: "consumer",
+			Broker:          "kafkabroker",
+			CommitMode:      CommitModeAuto,
+		},
+		mu:      &sync.RWMutex{},
+		metrics: mockMetrics,
+	}
+
+	mockReader.EXPECT().ReadMessage(gomock.Any()).
+		Return(kafka.Message{Value: []byte(`hello`), Topic: "test"}, nil)
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_subscribe_total_count", "topic", "test",
+		"consumer_group", gomock.Any())
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_subscribe_success_count", "topic", "test",
+		"consumer_group", gomock.Any())
+
+	msg, err := k.Subscribe(context.TODO(), "test")
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`hello`), msg.Value)
+	assert.Nil(t, msg.Committer, "auto committed messages should not be committed again")
+}
+
+func TestKafkaClient_SubscribeConsumerLag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockReader := NewMockReader(ctrl)
+	mockMetrics := NewMockMetrics(ctrl)
+	k := &kafkaClient{
+		dialer: &kafka.Dialer{},
+		reader: map[string]Reader{
+			"test": mockReader,
+		},
+		logger: logging.NewMockLogger(logging.ERROR),
+		config: Config{
+			ConsumerGroupID: "consumer",
+			Broker:          "kafkabroker",
+			CommitMode:      CommitModeAuto,
+		},
+		mu:      &sync.RWMutex{},
+		metrics: mockMetrics,
+	}
+
+	mockReader.EXPECT().ReadMessage(gomock.Any()).
+		Return(kafka.Message{Value: []byte(`hello`), Topic: "test", Partition: 2, Offset: 10, HighWaterMark: 15}, nil)
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_subscribe_total_count", "topic", "test",
+		"consumer_group", "consumer")
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_subscribe_success_count", "topic", "test",
+		"consumer_group", "consumer")
+	mockMetrics.EXPECT().SetGauge("app_pubsub_consumer_lag", float64(4), "topic", "test", "partition", "2",
+		"consumer_group", "consumer")
+
+	_, err := k.Subscribe(context.Background(), "test")
+
+	require.NoError(t, err)
+}
+
+func TestConfig_startOffset(t *testing.T) {
+	testCases := []struct {
+		config   Config
+		expected int64
+	}{
+		{Config{OffSet: 5}, 5},
+		{Config{OffSet: 5, AutoOffsetReset: OffsetResetEarliest}, kafka.FirstOffset},
+		{Config{OffSet: 5, AutoOffsetReset: "LATEST"}, kafka.LastOffset},
+	}
+
+	for i, tc := range testCases {
+		assert.Equal(t, tc.expected, tc.config.startOffset(), "TEST[%d], Failed.\n", i)
+	}
+}
+
 func TestKafkaClient_Subscribe_ErrConsumerGroupID(t *testing.T) {
 	k := &kafkaClient{
 		dialer: &kafka.Dialer{},
@@ -4,4 +4,5 @@ import "context"
 
 type Metrics interface {
 	IncrementCounter(ctx context.Context, name string, labels ...string)
+	SetGauge(name string, value float64, labels ...string)
 }
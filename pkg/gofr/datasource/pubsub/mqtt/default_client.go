@@ -47,7 +47,12 @@ func getMQTTClientOptions(config *Config) *mqtt.ClientOptions {
 	options := mqtt.NewClientOptions()
 	options.AddBroker(fmt.Sprintf("%s://%s:%d", config.Protocol, config.Hostname, config.Port))
 
-	clientID := getClientID(config.ClientID)
+	// the broker identifies a persistent session by the client ID, so it must not change across restarts
+	clientID := config.ClientID
+	if !config.PersistentSession || clientID == "" {
+		clientID = getClientID(config.ClientID)
+	}
+
 	options.SetClientID(clientID)
 
 	if config.Username != "" {
@@ -62,6 +67,11 @@ func getMQTTClientOptions(config *Config) *mqtt.ClientOptions {
 	options.SetResumeSubs(config.RetrieveRetained)
 	options.SetAutoReconnect(true)
 	options.SetKeepAlive(config.KeepAlive)
+	options.SetCleanSession(!config.PersistentSession)
+
+	if config.MaxReconnectInterval > 0 {
+		options.SetMaxReconnectInterval(config.MaxReconnectInterval)
+	}
 
 	return options
 }
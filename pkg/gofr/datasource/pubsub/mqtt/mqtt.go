@@ -50,6 +50,13 @@ type Config struct {
 	RetrieveRetained bool
 	KeepAlive        time.Duration
 	CloseTimeout     time.Duration
+	// PersistentSession disables the clean session flag, so that the broker keeps the subscriptions and queues
+	// QoS 1 and 2 messages while the client is disconnected. The ClientID is used as is instead of as a suffix
+	// to a random ID, so it must be unique for every instance.
+	PersistentSession bool
+	// MaxReconnectInterval is the maximum wait between reconnect attempts, which back off exponentially.
+	// Defaults to 10 minutes if not set.
+	MaxReconnectInterval time.Duration
 }
 
 type subscription struct {
@@ -64,6 +71,14 @@ func New(config *Config, logger Logger, metrics Metrics) *MQTT {
 		return getDefaultClient(config, logger, metrics)
 	}
 
+	// a random client ID would start a new session on every restart, leaving the persistent one behind on the broker
+	if config.PersistentSession && config.ClientID == "" {
+		logger.Errorf("MQTT_PERSISTENT_SESSION requires MQTT_CLIENT_ID_SUFFIX to be set to a stable client ID, " +
+			"using a clean session instead")
+
+		config.PersistentSession = false
+	}
+
 	options := getMQTTClientOptions(config)
 	subs := make(map[string]subscription)
 	mu := new(sync.RWMutex)
@@ -96,7 +111,10 @@ func (m *MQTT) Subscribe(ctx context.Context, topic string) (*pubsub.Message, er
 		token := m.Client.Subscribe(topic, m.config.QoS, subs.handler)
 
 		if token.Wait() && token.Error() != nil {
+			m.mu.Unlock()
+
 			m.logger.Errorf("error getting a message from MQTT, error: %v", token.Error())
+
 			return nil, token.Error()
 		}
 
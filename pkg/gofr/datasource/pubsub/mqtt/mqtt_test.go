@@ -63,6 +63,25 @@ func TestMQTT_New(t *testing.T) {
 	assert.Contains(t, out, "could not connect to MQTT")
 }
 
+func TestMQTT_NewPersistentSessionWithoutClientID(t *testing.T) {
+	var client *MQTT
+
+	conf := Config{
+		Protocol:          "tcp",
+		Hostname:          "localhost",
+		Port:              1883,
+		PersistentSession: true,
+	}
+
+	out := testutil.StderrOutputForFunc(func() {
+		mockLogger := logging.NewMockLogger(logging.ERROR)
+		client = New(&conf, mockLogger, nil)
+	})
+
+	assert.Contains(t, out, "MQTT_PERSISTENT_SESSION requires MQTT_CLIENT_ID_SUFFIX")
+	assert.False(t, client.config.PersistentSession)
+}
+
 // TestMQTT_EmptyConfigs test the scenario where configs are not provided and
 // a client tries to connect to the public broker.
 func TestMQTT_EmptyConfigs(t *testing.T) {
@@ -97,6 +116,25 @@ func TestMQTT_getMQTTClientOptions(t *testing.T) {
 	assert.Equal(t, conf.Username, options.Username)
 	assert.Equal(t, conf.Password, options.Password)
 	assert.Equal(t, conf.Order, options.Order)
+	assert.True(t, options.CleanSession)
+}
+
+func TestMQTT_getMQTTClientOptionsPersistentSession(t *testing.T) {
+	conf := Config{
+		Protocol:             "tcp",
+		Hostname:             "localhost",
+		Port:                 1883,
+		ClientID:             "sensor-ingest",
+		PersistentSession:    true,
+		MaxReconnectInterval: 30 * time.Second,
+	}
+
+	options := getMQTTClientOptions(&conf)
+
+	assert.False(t, options.CleanSession)
+	assert.Equal(t, "sensor-ingest", options.ClientID)
+	assert.True(t, options.AutoReconnect)
+	assert.Equal(t, 30*time.Second, options.MaxReconnectInterval)
 }
 
 func TestMQTT_Ping(t *testing.T) {
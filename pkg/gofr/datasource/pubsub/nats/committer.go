@@ -25,9 +25,18 @@ func (c *natsCommitter) Commit() {
 	}
 }
 
-// Nak naks the message.
-func (c *natsCommitter) Nak() error {
-	return c.msg.Nak()
+// Nack asks NATS to redeliver the message, up to the MaxDeliver of the consumer.
+func (c *natsCommitter) Nack() {
+	if err := c.msg.Nak(); err != nil {
+		log.Println("Error naking message:", err)
+	}
+}
+
+// Drop terminates the message, so that NATS does not redeliver it.
+func (c *natsCommitter) Drop() {
+	if err := c.msg.Term(); err != nil {
+		log.Println("Error terminating message:", err)
+	}
 }
 
 // Rollback rolls back the message.
@@ -41,25 +41,43 @@ func TestNATSCommitter_Commit(t *testing.T) {
 	})
 }
 
-func TestNATSCommitter_Nak(t *testing.T) {
+func TestNATSCommitter_Nack(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockMsg := NewMockMsg(ctrl)
 	committer := createTestCommitter(mockMsg)
 
-	t.Run("Successful Nak", func(t *testing.T) {
+	t.Run("Successful Nack", func(_ *testing.T) {
 		mockMsg.EXPECT().Nak().Return(nil)
 
-		err := committer.Nak()
-		assert.NoError(t, err)
+		committer.Nack()
 	})
 
-	t.Run("Failed Nak", func(t *testing.T) {
+	t.Run("Failed Nack", func(_ *testing.T) {
 		mockMsg.EXPECT().Nak().Return(assert.AnError)
 
-		err := committer.Nak()
-		assert.Error(t, err)
+		committer.Nack()
+	})
+}
+
+func TestNATSCommitter_Drop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMsg := NewMockMsg(ctrl)
+	committer := createTestCommitter(mockMsg)
+
+	t.Run("Successful Drop", func(_ *testing.T) {
+		mockMsg.EXPECT().Term().Return(nil)
+
+		committer.Drop()
+	})
+
+	t.Run("Failed Drop", func(_ *testing.T) {
+		mockMsg.EXPECT().Term().Return(assert.AnError)
+
+		committer.Drop()
 	})
 }
 
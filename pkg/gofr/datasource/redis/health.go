@@ -4,9 +4,11 @@ package redis
 
 import (
 	"context"
-	"strconv"
+	"sync"
 	"time"
 
+	"github.com/redis/go-redis/v9"
+
 	"gofr.dev/pkg/gofr/datasource"
 )
 
@@ -15,18 +17,22 @@ func (r *Redis) HealthCheck() datasource.Health {
 		Details: make(map[string]any),
 	}
 
-	h.Details["host"] = r.config.HostName + ":" + strconv.Itoa(r.config.Port)
+	h.Details["host"] = r.config.address()
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	ctx, cancel := context.WithTimeout(probeContext(context.Background()), time.Second)
 	defer cancel()
 
-	if r.Client == nil {
+	if r.Universal == nil {
 		h.Status = datasource.StatusDown
 		h.Details["error"] = "redis not connected"
 
 		return h
 	}
 
+	if cluster, ok := r.Universal.(*redis.ClusterClient); ok {
+		return clusterHealthCheck(ctx, cluster, h)
+	}
+
 	info, err := r.InfoMap(ctx, "Stats").Result()
 	if err != nil {
 		h.Status = datasource.StatusDown
@@ -40,3 +46,42 @@ func (r *Redis) HealthCheck() datasource.Health {
 
 	return h
 }
+
+// clusterHealthCheck reports the status of every master shard of the cluster. The cluster is reported
+// down if any of its shards is not reachable.
+func clusterHealthCheck(ctx context.Context, cluster *redis.ClusterClient, h datasource.Health) datasource.Health {
+	var mu sync.Mutex
+
+	shards := make(map[string]any)
+
+	err := cluster.ForEachMaster(ctx, func(ctx context.Context, shard *redis.Client) error {
+		shardHealth := map[string]any{"status": datasource.StatusUp}
+
+		info, err := shard.InfoMap(ctx, "Stats").Result()
+		if err != nil {
+			shardHealth["status"] = datasource.StatusDown
+			shardHealth["error"] = err.Error()
+		} else {
+			shardHealth["stats"] = info["Stats"]
+		}
+
+		mu.Lock()
+		shards[shard.Options().Addr] = shardHealth
+		mu.Unlock()
+
+		return err
+	})
+
+	h.Details["shards"] = shards
+
+	if err != nil {
+		h.Status = datasource.StatusDown
+		h.Details["error"] = err.Error()
+
+		return h
+	}
+
+	h.Status = datasource.StatusUp
+
+	return h
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"regexp"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -15,9 +16,26 @@ import (
 
 // redisHook is a custom Redis hook for logging queries and their durations.
 type redisHook struct {
-	config  *Config
-	logger  datasource.Logger
-	metrics Metrics
+	config      *Config
+	logger      datasource.Logger
+	metrics     Metrics
+	unavailable *atomic.Bool
+}
+
+type probeKey struct{}
+
+// probeContext marks the commands checking the connection, which are sent to Redis even while it is unavailable.
+func probeContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, probeKey{}, true)
+}
+
+// errUnavailable returns datasource.ErrorDatasourceUnavailable if Redis is not connected and the command is not a probe.
+func (r *redisHook) errUnavailable(ctx context.Context) error {
+	if r.unavailable == nil || !r.unavailable.Load() || ctx.Value(probeKey{}) != nil {
+		return nil
+	}
+
+	return datasource.ErrorDatasourceUnavailable{Datasource: "redis"}
 }
 
 // QueryLog represents a logged Redis query.
@@ -73,8 +91,13 @@ func (r *redisHook) sendOperationStats(start time.Time, query string, args ...an
 		Args:     args,
 	})
 
+	hostname := r.config.HostName
+	if len(r.config.ClusterNodes) > 0 {
+		hostname = r.config.address()
+	}
+
 	r.metrics.RecordHistogram(context.Background(), "app_redis_stats",
-		float64(duration), "hostname", r.config.HostName, "type", query)
+		float64(duration), "hostname", hostname, "type", query)
 }
 
 // DialHook implements the redis.DialHook interface.
@@ -85,6 +108,12 @@ func (*redisHook) DialHook(next redis.DialHook) redis.DialHook {
 // ProcessHook implements the redis.ProcessHook interface.
 func (r *redisHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
 	return func(ctx context.Context, cmd redis.Cmder) error {
+		if err := r.errUnavailable(ctx); err != nil {
+			cmd.SetErr(err)
+
+			return err
+		}
+
 		start := time.Now()
 		err := next(ctx, cmd)
 		r.sendOperationStats(start, cmd.Name(), cmd.Args()...)
@@ -96,6 +125,14 @@ func (r *redisHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
 // ProcessPipelineHook implements the redis.ProcessPipelineHook interface.
 func (r *redisHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
 	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if err := r.errUnavailable(ctx); err != nil {
+			for _, cmd := range cmds {
+				cmd.SetErr(err)
+			}
+
+			return err
+		}
+
 		start := time.Now()
 		err := next(ctx, cmds)
 		r.sendOperationStats(start, "pipeline", cmds[:len(cmds)-1])
@@ -4,4 +4,5 @@ import "context"
 
 type Metrics interface {
 	RecordHistogram(ctx context.Context, name string, value float64, labels ...string)
+	SetGauge(name string, value float64, labels ...string)
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	otel "github.com/redis/go-redis/extra/redisotel/v9"
@@ -14,8 +16,11 @@ import (
 )
 
 const (
-	redisPingTimeout = 5 * time.Second
-	defaultRedisPort = 6379
+	redisPingTimeout       = 5 * time.Second
+	reconnectMinBackoff    = time.Second
+	reconnectMaxBackoff    = 30 * time.Second
+	defaultRedisPort       = 6379
+	poolStatsPushFrequency = 10 * time.Second
 )
 
 type Config struct {
@@ -25,54 +30,174 @@ type Config struct {
 	Port     int
 	DB       int
 	Options  *redis.Options
+
+	// ClusterNodes are the seed nodes of a Redis Cluster. If set, a cluster client is used instead of
+	// connecting to HostName and Port.
+	ClusterNodes   []string
+	ClusterOptions *redis.ClusterOptions
 }
 
 type Redis struct {
+	// Client is nil when connected to a Redis Cluster, whose commands are sent using Universal or the Cluster.
 	*redis.Client
+
+	// Universal is the client connected to Redis, i.e. the Client, or the cluster client when connected to a
+	// Redis Cluster.
+	Universal redis.UniversalClient
+
 	logger datasource.Logger
 	config *Config
+
+	// unavailable is set while Redis could not be connected to since the start, failing the commands fast with
+	// datasource.ErrorDatasourceUnavailable until it is connected.
+	unavailable atomic.Bool
+
+	// stop stops the goroutines pushing the metrics and reconnecting.
+	stop context.CancelFunc
 }
 
 // NewClient return a redis client if connection is successful based on Config.
-// In case of error, it returns an error as second parameter.
+// If REDIS_CLUSTER_NODES is set, the client connects to a Redis Cluster using the given seed nodes,
+// and routes commands and pipelines to the shard owning the key.
 func NewClient(c config.Config, logger datasource.Logger, metrics Metrics) *Redis {
 	redisConfig := getRedisConfig(c)
 
-	// if Hostname is not provided, we won't try to connect to Redis
-	if redisConfig.HostName == "" {
+	// if Hostname or cluster nodes are not provided, we won't try to connect to Redis
+	if redisConfig.HostName == "" && len(redisConfig.ClusterNodes) == 0 {
 		return nil
 	}
 
-	logger.Debugf("connecting to redis at '%s:%d' on database %d", redisConfig.HostName, redisConfig.Port, redisConfig.DB)
+	r := &Redis{config: redisConfig, logger: logger}
+
+	if len(redisConfig.ClusterNodes) > 0 {
+		logger.Debugf("connecting to redis cluster at '%s'", redisConfig.address())
+
+		r.Universal = redis.NewClusterClient(redisConfig.ClusterOptions)
+	} else {
+		logger.Debugf("connecting to redis at '%s' on database %d", redisConfig.address(), redisConfig.DB)
 
-	rc := redis.NewClient(redisConfig.Options)
-	rc.AddHook(&redisHook{config: redisConfig, logger: logger, metrics: metrics})
+		r.Client = redis.NewClient(redisConfig.Options)
+		r.Universal = r.Client
+	}
+
+	r.Universal.AddHook(&redisHook{config: redisConfig, logger: logger, metrics: metrics, unavailable: &r.unavailable})
+
+	// the hooks are added before the client is used, as go-redis does not synchronise adding them with the commands
+	if err := otel.InstrumentTracing(r.Universal); err != nil {
+		logger.Errorf("could not add tracing instrumentation, error: %s", err)
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	r.stop = stop
+
+	if err := r.ping(ctx); err == nil {
+		r.connected()
+	} else {
+		logger.Errorf("could not connect to redis at '%s' , error: %s", redisConfig.address(), err)
 
-	ctx, cancel := context.WithTimeout(context.TODO(), redisPingTimeout)
+		r.unavailable.Store(true)
+
+		go r.reconnect(ctx)
+	}
+
+	go pushPoolMetrics(ctx, r.Universal, redisConfig, metrics)
+
+	return r
+}
+
+func (r *Redis) ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(probeContext(ctx), redisPingTimeout)
 	defer cancel()
 
-	if err := rc.Ping(ctx).Err(); err == nil {
-		if err = otel.InstrumentTracing(rc); err != nil {
-			logger.Errorf("could not add tracing instrumentation, error: %s", err)
+	return r.Universal.Ping(ctx).Err()
+}
+
+func (r *Redis) connected() {
+	r.logger.Infof("connected to redis at %s on database %d", r.config.address(), r.config.DB)
+}
+
+// reconnect retries to connect to Redis with an exponential backoff, until it is connected or ctx is done.
+func (r *Redis) reconnect(ctx context.Context) {
+	for backoff := reconnectMinBackoff; ; backoff = min(2*backoff, reconnectMaxBackoff) {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
 		}
 
-		logger.Infof("connected to redis at %s:%d on database %d", redisConfig.HostName, redisConfig.Port, redisConfig.DB)
-	} else {
-		logger.Errorf("could not connect to redis at '%s:%d' , error: %s", redisConfig.HostName, redisConfig.Port, err)
+		r.logger.Debugf("retrying redis connection at '%s'", r.config.address())
+
+		if err := r.ping(ctx); err == nil {
+			r.unavailable.Store(false)
+			r.connected()
+
+			return
+		}
 	}
+}
 
-	return &Redis{Client: rc, config: redisConfig, logger: logger}
+// pushPoolMetrics periodically publishes the connection pool stats until the context is cancelled.
+func pushPoolMetrics(ctx context.Context, client redis.UniversalClient, redisConfig *Config, metrics Metrics) {
+	ticker := time.NewTicker(poolStatsPushFrequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			recordPoolStats(client.PoolStats(), redisConfig.address(), metrics)
+		}
+	}
+}
+
+func recordPoolStats(stats *redis.PoolStats, hostname string, metrics Metrics) {
+	metrics.SetGauge("app_redis_total_connections", float64(stats.TotalConns), "hostname", hostname)
+	metrics.SetGauge("app_redis_idle_connections", float64(stats.IdleConns), "hostname", hostname)
+	metrics.SetGauge("app_redis_pool_hits", float64(stats.Hits), "hostname", hostname)
+	metrics.SetGauge("app_redis_pool_misses", float64(stats.Misses), "hostname", hostname)
+	metrics.SetGauge("app_redis_pool_timeouts", float64(stats.Timeouts), "hostname", hostname)
 }
 
 // Close shuts down the Redis client, ensuring the current dataset is saved before exiting.
 func (r *Redis) Close() error {
-	if r.Client != nil {
-		return r.Client.Close()
+	if r.stop != nil {
+		r.stop()
+	}
+
+	if r.Universal != nil {
+		return r.Universal.Close()
 	}
 
 	return nil
 }
 
+// Cluster is the Redis datasource connected to a Redis Cluster, sending the commands using its cluster client.
+type Cluster struct {
+	redis.UniversalClient
+
+	redis *Redis
+}
+
+// Cluster returns the datasource sending the commands to the Redis Cluster r is connected to, or nil if r is
+// connected to a single Redis server.
+func (r *Redis) Cluster() *Cluster {
+	if r == nil || r.Client != nil || r.Universal == nil {
+		return nil
+	}
+
+	return &Cluster{UniversalClient: r.Universal, redis: r}
+}
+
+func (c *Cluster) HealthCheck() datasource.Health {
+	return c.redis.HealthCheck()
+}
+
+// Close shuts down the cluster client and stops pushing its metrics.
+func (c *Cluster) Close() error {
+	return c.redis.Close()
+}
+
 func getRedisConfig(c config.Config) *Config {
 	var redisConfig = &Config{}
 
@@ -114,9 +239,33 @@ func getRedisConfig(c config.Config) *Config {
 
 	redisConfig.Options = options
 
+	if nodes := c.Get("REDIS_CLUSTER_NODES"); nodes != "" {
+		for _, node := range strings.Split(nodes, ",") {
+			if node = strings.TrimSpace(node); node != "" {
+				redisConfig.ClusterNodes = append(redisConfig.ClusterNodes, node)
+			}
+		}
+
+		// a Redis Cluster only supports database 0
+		redisConfig.ClusterOptions = &redis.ClusterOptions{
+			Addrs:    redisConfig.ClusterNodes,
+			Username: redisConfig.Username,
+			Password: redisConfig.Password,
+		}
+	}
+
 	return redisConfig
 }
 
+// address returns the address of the Redis server, or the seed nodes for a Redis Cluster.
+func (c *Config) address() string {
+	if len(c.ClusterNodes) > 0 {
+		return strings.Join(c.ClusterNodes, ",")
+	}
+
+	return fmt.Sprintf("%s:%d", c.HostName, c.Port)
+}
+
 // TODO - if we make Redis an interface and expose from container we can avoid c.Redis(c, command) using methods on c and still pass c.
 // type Redis interface {
 //	Get(string) (string, error)
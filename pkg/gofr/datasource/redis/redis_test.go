@@ -2,15 +2,18 @@ package redis
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"gofr.dev/pkg/gofr/config"
+	"gofr.dev/pkg/gofr/datasource"
 	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/testutil"
 )
@@ -38,7 +41,48 @@ func Test_NewClient_InvalidPort(t *testing.T) {
 	mockMetrics.EXPECT().RecordHistogram(gomock.Any(), "app_redis_stats", gomock.Any(), "hostname", gomock.Any(), "type", "ping")
 
 	client := NewClient(mockConfig, mockLogger, mockMetrics)
+	defer client.Close()
+
 	assert.NotNil(t, client.Client, "Test_NewClient_InvalidPort Failed! Expected redis client not to be nil")
+	assert.Nil(t, client.Cluster(), "Test_NewClient_InvalidPort Failed! Expected no cluster for a single server")
+}
+
+func TestRedis_UnavailableAtStartup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	port := testutil.GetFreePort(t)
+
+	mockMetrics := NewMockMetrics(ctrl)
+	mockMetrics.EXPECT().RecordHistogram(gomock.Any(), "app_redis_stats", gomock.Any(), "hostname", gomock.Any(), "type", gomock.Any()).
+		AnyTimes()
+
+	client := NewClient(config.NewMockConfig(map[string]string{"REDIS_HOST": "localhost", "REDIS_PORT": strconv.Itoa(port)}),
+		logging.NewMockLogger(logging.ERROR), mockMetrics)
+	defer client.Close()
+
+	_, err := client.Get(context.Background(), "key").Result()
+	require.Equal(t, datasource.ErrorDatasourceUnavailable{Datasource: "redis"}, err)
+
+	_, err = client.Pipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		pipe.Get(context.Background(), "key")
+
+		return nil
+	})
+	require.Equal(t, datasource.ErrorDatasourceUnavailable{Datasource: "redis"}, err)
+
+	assert.Equal(t, datasource.StatusDown, client.HealthCheck().Status)
+
+	s := miniredis.NewMiniRedis()
+	require.NoError(t, s.StartAddr("localhost:"+strconv.Itoa(port)))
+
+	defer s.Close()
+
+	require.Eventually(t, func() bool {
+		return !client.unavailable.Load()
+	}, 5*time.Second, 10*time.Millisecond, "the client should reconnect once Redis is up")
+
+	require.NoError(t, client.Set(context.Background(), "key", "value", 0).Err())
 }
 
 func TestRedis_QueryLogging(t *testing.T) {
@@ -148,3 +192,58 @@ func TestRedis_Close(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestRedis_ClusterClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, err := miniredis.Run()
+	require.NoError(t, err)
+
+	defer s.Close()
+
+	mockMetric := NewMockMetrics(ctrl)
+	mockMetric.EXPECT().RecordHistogram(gomock.Any(), "app_redis_stats", gomock.Any(),
+		"hostname", s.Addr(), "type", gomock.Any()).AnyTimes()
+
+	client := NewClient(config.NewMockConfig(map[string]string{
+		"REDIS_CLUSTER_NODES": " " + s.Addr() + " ,",
+	}), logging.NewMockLogger(logging.ERROR), mockMetric)
+
+	require.NotNil(t, client)
+	assert.Nil(t, client.Client, "standalone client should not be set for a cluster")
+	assert.Equal(t, []string{s.Addr()}, client.config.ClusterNodes)
+
+	cluster := client.Cluster()
+	require.NotNil(t, cluster)
+
+	err = cluster.Set(context.Background(), "key", "value", time.Minute).Err()
+	require.NoError(t, err)
+
+	val, err := cluster.Get(context.Background(), "key").Result()
+	require.NoError(t, err)
+	assert.Equal(t, "value", val)
+
+	health := cluster.HealthCheck()
+
+	// miniredis does not support the Stats section of INFO, so the shard is reported down
+	assert.Equal(t, datasource.StatusDown, health.Status)
+	assert.Equal(t, s.Addr(), health.Details["host"])
+	assert.Contains(t, health.Details["shards"], s.Addr())
+
+	require.NoError(t, cluster.Close())
+}
+
+func Test_recordPoolStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMetrics := NewMockMetrics(ctrl)
+
+	mockMetrics.EXPECT().SetGauge("app_redis_total_connections", float64(4), "hostname", "localhost:6379")
+	mockMetrics.EXPECT().SetGauge("app_redis_idle_connections", float64(3), "hostname", "localhost:6379")
+	mockMetrics.EXPECT().SetGauge("app_redis_pool_hits", float64(10), "hostname", "localhost:6379")
+	mockMetrics.EXPECT().SetGauge("app_redis_pool_misses", float64(2), "hostname", "localhost:6379")
+	mockMetrics.EXPECT().SetGauge("app_redis_pool_timeouts", float64(1), "hostname", "localhost:6379")
+
+	recordPoolStats(&redis.PoolStats{Hits: 10, Misses: 2, Timeouts: 1, TotalConns: 4, IdleConns: 3},
+		"localhost:6379", mockMetrics)
+}
@@ -11,6 +11,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"gofr.dev/pkg/gofr/datasource"
@@ -23,6 +24,11 @@ type DB struct {
 	logger  datasource.Logger
 	config  *DBConfig
 	metrics Metrics
+	stmts   *stmtCache
+
+	// unavailable is set while the database could not be connected to since the start, failing the operations fast
+	// with datasource.ErrorDatasourceUnavailable until it is connected.
+	unavailable atomic.Bool
 }
 
 type Log struct {
@@ -65,13 +71,32 @@ func getOperationType(query string) string {
 	return words[0]
 }
 
+// errUnavailable returns datasource.ErrorDatasourceUnavailable if the database is not connected.
+func (d *DB) errUnavailable() error {
+	if d.unavailable.Load() {
+		return datasource.ErrorDatasourceUnavailable{Datasource: "sql"}
+	}
+
+	return nil
+}
+
 func (d *DB) Query(query string, args ...any) (*sql.Rows, error) {
+	if err := d.errUnavailable(); err != nil {
+		return nil, err
+	}
+
 	defer d.sendOperationStats(time.Now(), "Query", query, args...)
+
 	return d.DB.Query(query, args...)
 }
 
 func (d *DB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if err := d.errUnavailable(); err != nil {
+		return nil, err
+	}
+
 	defer d.sendOperationStats(time.Now(), "QueryContext", query, args...)
+
 	return d.DB.QueryContext(ctx, query, args...)
 }
 
@@ -79,32 +104,85 @@ func (d *DB) Dialect() string {
 	return d.config.Dialect
 }
 
+// Raw returns the underlying *sql.DB, e.g. to use a sql.Conn for session-scoped settings, or driver-specific
+// operations like bulk copies. It shares the connection pool configured for the DB, and the operations done on it
+// are traced by the instrumented driver, but they are not logged or recorded in the metrics, and do not fail fast
+// while the database is unavailable.
+func (d *DB) Raw() *sql.DB {
+	return d.DB
+}
+
+// QueryRow executes a query that is expected to return at most one row. Unlike the other operations, it does not fail
+// fast while the database is unavailable at startup, as a *sql.Row cannot carry the error, which is returned by Scan.
 func (d *DB) QueryRow(query string, args ...any) *sql.Row {
 	defer d.sendOperationStats(time.Now(), "QueryRow", query, args...)
 	return d.DB.QueryRow(query, args...)
 }
 
+// QueryRowContext executes a query that is expected to return at most one row. If DB_STMT_CACHE_SIZE is set,
+// the query is executed using a cached prepared statement. Like QueryRow, it does not fail fast while the database is
+// unavailable at startup.
 func (d *DB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
 	defer d.sendOperationStats(time.Now(), "QueryRowContext", query, args...)
+
+	if d.stmts != nil {
+		if cs, err := d.stmts.acquire(ctx, d.DB, query); err == nil {
+			row := cs.stmt.QueryRowContext(ctx, args...)
+			d.stmts.release(cs, row.Err())
+
+			return row
+		}
+	}
+
 	return d.DB.QueryRowContext(ctx, query, args...)
 }
 
 func (d *DB) Exec(query string, args ...any) (sql.Result, error) {
+	if err := d.errUnavailable(); err != nil {
+		return nil, err
+	}
+
 	defer d.sendOperationStats(time.Now(), "Exec", query, args...)
+
 	return d.DB.Exec(query, args...)
 }
 
+// ExecContext executes a query without returning any rows. If DB_STMT_CACHE_SIZE is set,
+// the query is executed using a cached prepared statement.
 func (d *DB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if err := d.errUnavailable(); err != nil {
+		return nil, err
+	}
+
 	defer d.sendOperationStats(time.Now(), "ExecContext", query, args...)
+
+	if d.stmts != nil {
+		if cs, err := d.stmts.acquire(ctx, d.DB, query); err == nil {
+			res, err := cs.stmt.ExecContext(ctx, args...)
+			d.stmts.release(cs, err)
+
+			return res, err
+		}
+	}
+
 	return d.DB.ExecContext(ctx, query, args...)
 }
 
 func (d *DB) Prepare(query string) (*sql.Stmt, error) {
+	if err := d.errUnavailable(); err != nil {
+		return nil, err
+	}
+
 	defer d.sendOperationStats(time.Now(), "Prepare", query)
+
 	return d.DB.Prepare(query)
 }
 
 func (d *DB) Begin() (*Tx, error) {
+	if err := d.errUnavailable(); err != nil {
+		return nil, err
+	}
+
 	tx, err := d.DB.Begin()
 	if err != nil {
 		return nil, err
@@ -114,6 +192,10 @@ func (d *DB) Begin() (*Tx, error) {
 }
 
 func (d *DB) Close() error {
+	if d.stmts != nil {
+		d.stmts.purge()
+	}
+
 	if d.DB != nil {
 		return d.DB.Close()
 	}
@@ -217,6 +299,11 @@ func (d *DB) Select(ctx context.Context, data any, query string, args ...any) {
 		return
 	}
 
+	if err := d.errUnavailable(); err != nil {
+		d.logger.Errorf("error running query: %v", err)
+		return
+	}
+
 	// First confirm that what we got in v is a pointer else it won't be settable
 	rvo := reflect.ValueOf(data)
 	if rvo.Kind() != reflect.Ptr {
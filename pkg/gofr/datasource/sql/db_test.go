@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"gofr.dev/pkg/gofr/datasource"
 	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/testutil"
 )
@@ -30,7 +31,7 @@ func getDB(t *testing.T, logLevel logging.Level) (*DB, sqlmock.Sqlmock) {
 		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 	}
 
-	db := &DB{mockDB, logging.NewMockLogger(logLevel), nil, nil}
+	db := &DB{DB: mockDB, logger: logging.NewMockLogger(logLevel)}
 	db.config = &DBConfig{}
 
 	return db, mock
@@ -463,6 +464,58 @@ func TestDB_QueryContextError(t *testing.T) {
 	assert.Contains(t, out, "QueryContext SELECT")
 }
 
+func TestDB_Unavailable(t *testing.T) {
+	db, mock := getDB(t, logging.INFO)
+	defer db.DB.Close()
+
+	db.unavailable.Store(true)
+
+	expected := datasource.ErrorDatasourceUnavailable{Datasource: "sql"}
+
+	_, err := db.Query("SELECT 1")
+	require.Equal(t, expected, err, "TEST Failed.\n%s", "Query")
+
+	_, err = db.QueryContext(context.Background(), "SELECT 1")
+	require.Equal(t, expected, err, "TEST Failed.\n%s", "QueryContext")
+
+	_, err = db.Exec("DELETE FROM users")
+	require.Equal(t, expected, err, "TEST Failed.\n%s", "Exec")
+
+	_, err = db.ExecContext(context.Background(), "DELETE FROM users")
+	require.Equal(t, expected, err, "TEST Failed.\n%s", "ExecContext")
+
+	_, err = db.Prepare("SELECT 1")
+	require.Equal(t, expected, err, "TEST Failed.\n%s", "Prepare")
+
+	_, err = db.Begin()
+	require.Equal(t, expected, err, "TEST Failed.\n%s", "Begin")
+
+	var ids []int
+
+	db.Select(context.Background(), &ids, "SELECT id FROM users")
+	assert.Empty(t, ids, "TEST Failed.\n%s", "Select")
+
+	require.NoError(t, mock.ExpectationsWereMet(), "the database should not be called while unavailable")
+}
+
+func TestDB_Raw(t *testing.T) {
+	db, mock := getDB(t, logging.INFO)
+	defer db.DB.Close()
+
+	assert.Same(t, db.DB, db.Raw())
+
+	mock.ExpectExec("SET search_path TO tenant_a").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	conn, err := db.Raw().Conn(context.Background())
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	_, err = conn.ExecContext(context.Background(), "SET search_path TO tenant_a")
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestDB_QueryRow(t *testing.T) {
 	var (
 		row *sql.Row
@@ -0,0 +1,262 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var (
+	errNamedArgType    = errors.New("argument of a named query must be a struct or a map with string keys")
+	errNamedArgMissing = errors.New("named parameter not found in the argument")
+)
+
+// NamedExecContext executes the query after replacing its :name parameters with the bind vars of the dialect, bound
+// from the fields of the struct, or the values of the map, arg. The fields are named by their `db` tag, or their name
+// in snake case like in Select.
+//
+//	_, err := ctx.SQL.NamedExecContext(ctx, "INSERT INTO users (id, name) VALUES (:id, :name)", user)
+func (d *DB) NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error) {
+	query, args, err := bindNamed(d.config.Dialect, query, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.ExecContext(ctx, query, args...)
+}
+
+// NamedQueryContext executes the query after binding its :name parameters from arg like NamedExecContext.
+//
+//	rows, err := ctx.SQL.NamedQueryContext(ctx, "SELECT * FROM users WHERE city = :city", map[string]any{"city": city})
+func (d *DB) NamedQueryContext(ctx context.Context, query string, arg any) (*sql.Rows, error) {
+	query, args, err := bindNamed(d.config.Dialect, query, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.QueryContext(ctx, query, args...)
+}
+
+// bindNamed returns the query with its named parameters replaced with the bind vars of the dialect, and the values
+// of the parameters from arg in their order.
+func bindNamed(dialect, query string, arg any) (string, []any, error) {
+	query, names := compileNamed(dialect, query)
+
+	values, err := namedValues(arg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	args := make([]any, len(names))
+
+	for i, name := range names {
+		v, ok := values(name)
+		if !ok {
+			return "", nil, fmt.Errorf("%w: %s", errNamedArgMissing, name)
+		}
+
+		args[i] = v
+	}
+
+	return query, args, nil
+}
+
+// compileNamed replaces the :name parameters of the query with the bind vars of the dialect, and returns the names
+// of the parameters in order. The colons in quoted strings and identifiers, comments and, for PostgreSQL, dollar-quoted
+// strings, and those of the casts, e.g. ::text, are kept as they are.
+func compileNamed(dialect, query string) (compiled string, names []string) {
+	var b strings.Builder
+
+	for i := 0; i < len(query); i++ {
+		if end := skipLiteral(dialect, query, i); end > i {
+			b.WriteString(query[i:end])
+
+			i = end - 1
+
+			continue
+		}
+
+		c := query[i]
+
+		switch {
+		case c == ':' && i+1 < len(query) && query[i+1] == ':':
+			b.WriteString("::")
+
+			i++
+
+			continue
+		case c == ':' && i+1 < len(query) && isNameStart(query[i+1]):
+			end := i + 1
+			for end < len(query) && isNameChar(query[end]) {
+				end++
+			}
+
+			names = append(names, query[i+1:end])
+			b.WriteString(bindVar(dialect, len(names)))
+
+			i = end - 1
+
+			continue
+		}
+
+		b.WriteByte(c)
+	}
+
+	return b.String(), names
+}
+
+// skipLiteral returns the end of the quoted string or identifier, the comment or, for the dialects using dollar bind
+// vars, the dollar-quoted string starting at i, or i if none starts there.
+func skipLiteral(dialect, query string, i int) int {
+	switch {
+	case query[i] == '\'' || query[i] == '"' || query[i] == '`':
+		return closing(query, i+1, query[i:i+1])
+	case strings.HasPrefix(query[i:], "--"):
+		return closing(query, i+2, "\n")
+	case strings.HasPrefix(query[i:], "/*"):
+		return closing(query, i+2, "*/")
+	case query[i] == '$' && bindType(dialect) == DOLLAR:
+		if tag, ok := dollarTag(query[i:]); ok {
+			return closing(query, i+len(tag), tag)
+		}
+	}
+
+	return i
+}
+
+// closing returns the index following the first delim found in the query from start, or the length of the query if
+// it is not closed.
+func closing(query string, start int, delim string) int {
+	if j := strings.Index(query[start:], delim); j >= 0 {
+		return start + j + len(delim)
+	}
+
+	return len(query)
+}
+
+// dollarTag returns the $tag$ opening the dollar-quoted string at the start of s, whose tag may be empty, e.g. $$.
+// The bind vars, e.g. $1, are not dollar tags as a tag cannot start with a digit.
+func dollarTag(s string) (string, bool) {
+	for j := 1; j < len(s); j++ {
+		switch {
+		case s[j] == '$':
+			return s[:j+1], true
+		case !isNameChar(s[j]) || (j == 1 && !isNameStart(s[j])):
+			return "", false
+		}
+	}
+
+	return "", false
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || (c >= '0' && c <= '9')
+}
+
+// namedValues returns the function looking up the value of a named parameter in arg, a struct or a map with string
+// keys, or a pointer to one.
+func namedValues(arg any) (func(name string) (any, bool), error) {
+	v := reflect.ValueOf(arg)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch {
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String:
+		return func(name string) (any, bool) {
+			value := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+			if !value.IsValid() {
+				return nil, false
+			}
+
+			return value.Interface(), true
+		}, nil
+	case v.Kind() == reflect.Struct:
+		fields := namedFields(v.Type())
+
+		return func(name string) (any, bool) {
+			index, ok := fields[name]
+			if !ok {
+				return nil, false
+			}
+
+			// a field of a struct embedded using a nil pointer has no value
+			field, err := v.FieldByIndexErr(index)
+			if err != nil {
+				return nil, false
+			}
+
+			return field.Interface(), true
+		}, nil
+	}
+
+	return nil, errNamedArgType
+}
+
+// namedFields returns the indexes of the fields of the struct type by their names, including the fields of the embedded
+// structs which are not named by a `db` tag. The embedded structs are walked breadth first, so that a field takes
+// precedence over the fields of the same name which are embedded deeper, as in Go.
+func namedFields(t reflect.Type) map[string][]int {
+	type embedded struct {
+		typ   reflect.Type
+		index []int
+	}
+
+	fields := make(map[string][]int)
+	queue := []embedded{{typ: t}}
+	seen := make(map[reflect.Type]bool)
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		// a struct embedding itself through a pointer is walked once
+		if seen[current.typ] {
+			continue
+		}
+
+		seen[current.typ] = true
+
+		for i := 0; i < current.typ.NumField(); i++ {
+			f := current.typ.Field(i)
+			index := append(append([]int{}, current.index...), i)
+
+			name := f.Tag.Get("db")
+			if name == "-" {
+				continue
+			}
+
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+
+			if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+				queue = append(queue, embedded{typ: ft, index: index})
+
+				continue
+			}
+
+			if !f.IsExported() {
+				continue
+			}
+
+			if name == "" {
+				name = ToSnakeCase(f.Name)
+			}
+
+			if _, ok := fields[name]; !ok {
+				fields[name] = index
+			}
+		}
+	}
+
+	return fields
+}
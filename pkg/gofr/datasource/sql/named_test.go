@@ -0,0 +1,176 @@
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/logging"
+)
+
+type namedUser struct {
+	ID        int
+	Name      string `db:"full_name"`
+	CreatedBy string
+	Password  string `db:"-"`
+	internal  string
+}
+
+func Test_compileNamed(t *testing.T) {
+	tests := []struct {
+		desc     string
+		dialect  string
+		query    string
+		expected string
+		names    []string
+	}{
+		{"mysql", "mysql", "INSERT INTO users (id, name) VALUES (:id, :name)",
+			"INSERT INTO users (id, name) VALUES (?, ?)", []string{"id", "name"}},
+		{"postgres", "postgres", "UPDATE users SET name = :name WHERE id = :id",
+			"UPDATE users SET name = $1 WHERE id = $2", []string{"name", "id"}},
+		{"repeated parameter", "postgres", "SELECT * FROM t WHERE a = :v OR b = :v",
+			"SELECT * FROM t WHERE a = $1 OR b = $2", []string{"v", "v"}},
+		{"cast", "postgres", "SELECT :created_at::date, id::text FROM t",
+			"SELECT $1::date, id::text FROM t", []string{"created_at"}},
+		{"quoted strings", "mysql", "SELECT ':skip', \"a:b\", `c:d` FROM t WHERE e = :e",
+			"SELECT ':skip', \"a:b\", `c:d` FROM t WHERE e = ?", []string{"e"}},
+		{"colon without a name", "mysql", "SELECT 'a' FROM t WHERE b = : AND c = :1",
+			"SELECT 'a' FROM t WHERE b = : AND c = :1", nil},
+		{"line comment", "mysql", "SELECT a -- filter by :skip\nFROM t WHERE b = :b -- :skip",
+			"SELECT a -- filter by :skip\nFROM t WHERE b = ? -- :skip", []string{"b"}},
+		{"block comment", "postgres", "SELECT /* :skip\n:skip */ a FROM t WHERE b = :b /* :skip",
+			"SELECT /* :skip\n:skip */ a FROM t WHERE b = $1 /* :skip", []string{"b"}},
+		{"dollar-quoted body", "postgres", "DO $$ BEGIN PERFORM :skip; END $$; SELECT :a, $body$ :skip $body$, :b",
+			"DO $$ BEGIN PERFORM :skip; END $$; SELECT $1, $body$ :skip $body$, $2", []string{"a", "b"}},
+		{"bind vars are not dollar tags", "postgres", "SELECT $1, :a, $2",
+			"SELECT $1, $1, $2", []string{"a"}},
+		{"dollar signs in mysql", "mysql", "SELECT $a$ FROM t WHERE b = :b",
+			"SELECT $a$ FROM t WHERE b = ?", []string{"b"}},
+	}
+
+	for i, tc := range tests {
+		query, names := compileNamed(tc.dialect, tc.query)
+
+		assert.Equal(t, tc.expected, query, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.names, names, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+type namedAudit struct {
+	CreatedBy string `db:"audit_by"`
+	UpdatedBy string
+}
+
+type namedBase struct {
+	ID     int
+	Tenant string
+}
+
+type namedOrder struct {
+	namedBase
+	*namedAudit
+
+	ID     int `db:"order_id"`
+	Tenant string
+	Total  float64
+}
+
+type namedNode struct {
+	*namedNode
+
+	Name string
+}
+
+func Test_bindNamed(t *testing.T) {
+	user := namedUser{ID: 1, Name: "Alice", CreatedBy: "admin", Password: "secret", internal: "x"}
+	order := namedOrder{namedBase: namedBase{ID: 7, Tenant: "base"}, namedAudit: &namedAudit{CreatedBy: "admin"},
+		ID: 8, Tenant: "acme", Total: 9.5}
+
+	tests := []struct {
+		desc  string
+		query string
+		arg   any
+		args  []any
+		err   error
+	}{
+		{"struct", "VALUES (:id, :full_name, :created_by)", user, []any{1, "Alice", "admin"}, nil},
+		{"pointer to struct", "VALUES (:id)", &user, []any{1}, nil},
+		{"map", "VALUES (:id, :name)", map[string]any{"id": 2, "name": "Bob"}, []any{2, "Bob"}, nil},
+		{"ignored field", "VALUES (:password)", user, nil, errNamedArgMissing},
+		{"missing key", "VALUES (:id)", map[string]any{}, nil, errNamedArgMissing},
+		{"unsupported argument", "VALUES (:id)", []int{1}, nil, errNamedArgType},
+		{"nil argument", "VALUES (:id)", nil, nil, errNamedArgType},
+		{"embedded structs", "VALUES (:order_id, :id, :tenant, :total, :audit_by, :updated_by)", order,
+			[]any{8, 7, "acme", 9.5, "admin", ""}, nil},
+		{"nil embedded struct", "VALUES (:audit_by)", namedOrder{}, nil, errNamedArgMissing},
+		{"struct embedding itself", "VALUES (:name)", namedNode{Name: "root"}, []any{"root"}, nil},
+	}
+
+	for i, tc := range tests {
+		_, args, err := bindNamed("mysql", tc.query, tc.arg)
+
+		require.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.args, args, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestDB_NamedExecContext(t *testing.T) {
+	db, mock := getDB(t, logging.INFO)
+	defer db.DB.Close()
+
+	db.config.Dialect = "postgres"
+
+	mockMetrics := NewMockMetrics(gomock.NewController(t))
+	db.metrics = mockMetrics
+
+	mockMetrics.EXPECT().RecordHistogram(gomock.Any(), "app_sql_stats",
+		gomock.Any(), "hostname", gomock.Any(), "database", gomock.Any(), "type", "INSERT")
+
+	mock.ExpectExec("INSERT INTO users (id, full_name) VALUES ($1, $2)").WithArgs(1, "Alice").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	res, err := db.NamedExecContext(context.Background(), "INSERT INTO users (id, full_name) VALUES (:id, :full_name)",
+		namedUser{ID: 1, Name: "Alice"})
+	require.NoError(t, err)
+
+	affected, err := res.RowsAffected()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), affected)
+
+	_, err = db.NamedExecContext(context.Background(), "DELETE FROM users WHERE id = :id", map[string]any{})
+	require.ErrorIs(t, err, errNamedArgMissing)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDB_NamedQueryContext(t *testing.T) {
+	db, mock := getDB(t, logging.INFO)
+	defer db.DB.Close()
+
+	db.config.Dialect = "mysql"
+
+	mockMetrics := NewMockMetrics(gomock.NewController(t))
+	db.metrics = mockMetrics
+
+	mockMetrics.EXPECT().RecordHistogram(gomock.Any(), "app_sql_stats",
+		gomock.Any(), "hostname", gomock.Any(), "database", gomock.Any(), "type", "SELECT")
+
+	mock.ExpectQuery("SELECT id FROM users WHERE city = ?").WithArgs("Berlin").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	rows, err := db.NamedQueryContext(context.Background(), "SELECT id FROM users WHERE city = :city",
+		map[string]any{"city": "Berlin"})
+	require.NoError(t, err)
+	require.NoError(t, rows.Err())
+
+	defer rows.Close()
+
+	_, err = db.NamedQueryContext(context.Background(), "SELECT id FROM users WHERE city = :city", 42)
+	require.ErrorIs(t, err, errNamedArgType)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -34,10 +34,12 @@ type DBConfig struct {
 	MaxIdleConn int
 	MaxOpenConn int
 	Charset     string
+	// StmtCacheSize is the maximum number of prepared statements cached by query text, 0 disables the cache.
+	StmtCacheSize int
 }
 
 func NewSQL(configs config.Config, logger datasource.Logger, metrics Metrics) *DB {
-	dbConfig := getDBConfig(configs)
+	dbConfig := getDBConfig(configs, logger)
 
 	if dbConfig.Dialect == "" {
 		return nil
@@ -84,11 +86,15 @@ func NewSQL(configs config.Config, logger datasource.Logger, metrics Metrics) *D
 	// it is closed automatically.
 	database.DB.SetMaxOpenConns(dbConfig.MaxOpenConn)
 
+	if dbConfig.StmtCacheSize > 0 {
+		database.stmts = newStmtCache(dbConfig.StmtCacheSize)
+	}
+
 	database = pingToTestConnection(database)
 
 	go retryConnection(database)
 
-	go pushDBMetrics(database.DB, metrics)
+	go pushDBMetrics(database.DB, dbConfig, metrics)
 
 	return database
 }
@@ -97,6 +103,8 @@ func pingToTestConnection(database *DB) *DB {
 	if err := database.DB.Ping(); err != nil {
 		printConnectionFailureLog("connect", database.config, database.logger, err)
 
+		database.unavailable.Store(true)
+
 		return database
 	}
 
@@ -105,32 +113,46 @@ func pingToTestConnection(database *DB) *DB {
 	return database
 }
 
+// retryConnection checks the connection to the database periodically and, while it cannot connect, retries with an
+// exponential backoff. If the database could not be connected to at startup, the operations fail fast with
+// datasource.ErrorDatasourceUnavailable until it connects. A connection lost later on does not make them fail fast, so
+// that a transient failure of the periodic check does not fail the operations which would have succeeded.
 func retryConnection(database *DB) {
-	const connRetryFrequencyInSeconds = 10
+	const (
+		connRetryFrequency  = 10 * time.Second
+		connRetryMinBackoff = time.Second
+	)
 
 	for {
 		if database.DB.Ping() != nil {
 			database.logger.Info("retrying SQL database connection")
 
-			for {
+			for backoff := connRetryMinBackoff; ; backoff = min(2*backoff, connRetryFrequency) {
 				err := database.DB.Ping()
 				if err == nil {
 					printConnectionSuccessLog("connected", database.config, database.logger)
 
+					// statements prepared before the database went down might not exist anymore
+					if database.stmts != nil {
+						database.stmts.purge()
+					}
+
+					database.unavailable.Store(false)
+
 					break
 				}
 
 				printConnectionFailureLog("connect", database.config, database.logger, err)
 
-				time.Sleep(connRetryFrequencyInSeconds * time.Second)
+				time.Sleep(backoff)
 			}
 		}
 
-		time.Sleep(connRetryFrequencyInSeconds * time.Second)
+		time.Sleep(connRetryFrequency)
 	}
 }
 
-func getDBConfig(configs config.Config) *DBConfig {
+func getDBConfig(configs config.Config, logger datasource.Logger) *DBConfig {
 	const (
 		defaultMaxIdleConn = 2
 		defaultMaxOpenConn = 0
@@ -151,6 +173,14 @@ func getDBConfig(configs config.Config) *DBConfig {
 		maxOpenConn = defaultMaxOpenConn
 	}
 
+	stmtCacheSize, err := strconv.Atoi(configs.GetOrDefault("DB_STMT_CACHE_SIZE", "0"))
+	if err != nil || stmtCacheSize < 0 {
+		logger.Errorf("invalid value %v for DB_STMT_CACHE_SIZE, the prepared statements are not cached",
+			configs.Get("DB_STMT_CACHE_SIZE"))
+
+		stmtCacheSize = 0
+	}
+
 	return &DBConfig{
 		Dialect:     configs.Get("DB_DIALECT"),
 		HostName:    configs.Get("DB_HOST"),
@@ -161,8 +191,9 @@ func getDBConfig(configs config.Config) *DBConfig {
 		MaxOpenConn: maxOpenConn,
 		MaxIdleConn: maxIdleConn,
 		// only for postgres
-		SSLMode: configs.GetOrDefault("DB_SSL_MODE", "disable"),
-		Charset: configs.Get("DB_CHARSET"),
+		SSLMode:       configs.GetOrDefault("DB_SSL_MODE", "disable"),
+		Charset:       configs.Get("DB_CHARSET"),
+		StmtCacheSize: stmtCacheSize,
 	}
 }
 
@@ -193,21 +224,30 @@ func getDBConnectionString(dbConfig *DBConfig) (string, error) {
 	}
 }
 
-func pushDBMetrics(db *sql.DB, metrics Metrics) {
+func pushDBMetrics(db *sql.DB, dbConfig *DBConfig, metrics Metrics) {
 	const frequency = 10
 
 	for {
 		if db != nil {
-			stats := db.Stats()
-
-			metrics.SetGauge("app_sql_open_connections", float64(stats.OpenConnections))
-			metrics.SetGauge("app_sql_inUse_connections", float64(stats.InUse))
-
-			time.Sleep(frequency * time.Second)
+			recordDBStats(db.Stats(), dbConfig, metrics)
 		}
+
+		time.Sleep(frequency * time.Second)
 	}
 }
 
+// recordDBStats publishes the connection pool stats, labeled by host and database so that multiple
+// databases can be told apart.
+func recordDBStats(stats sql.DBStats, dbConfig *DBConfig, metrics Metrics) {
+	labels := []string{"hostname", dbConfig.HostName, "database", dbConfig.Database}
+
+	metrics.SetGauge("app_sql_open_connections", float64(stats.OpenConnections), labels...)
+	metrics.SetGauge("app_sql_inUse_connections", float64(stats.InUse), labels...)
+	metrics.SetGauge("app_sql_idle_connections", float64(stats.Idle), labels...)
+	metrics.SetGauge("app_sql_wait_count", float64(stats.WaitCount), labels...)
+	metrics.SetGauge("app_sql_wait_duration", stats.WaitDuration.Seconds(), labels...)
+}
+
 func printConnectionSuccessLog(status string, dbconfig *DBConfig, logger datasource.Logger) {
 	logFunc := logger.Infof
 	if status != "connected" {
@@ -1,6 +1,7 @@
 package sql
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"testing"
@@ -69,7 +70,7 @@ func TestNewSQL_GetDBDialect(t *testing.T) {
 	mockMetrics := NewMockMetrics(ctrl)
 
 	// using gomock.Any as we are not actually testing any feature related to metrics
-	mockMetrics.EXPECT().SetGauge(gomock.Any(), gomock.Any()).AnyTimes()
+	mockMetrics.EXPECT().SetGauge(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
 	db := NewSQL(mockConfig, mockLogger, mockMetrics)
 
@@ -122,7 +123,7 @@ func TestSQL_GetDBConfig(t *testing.T) {
 		Charset:     "utf8mb4",
 	}
 
-	configs := getDBConfig(mockConfig)
+	configs := getDBConfig(mockConfig, logging.NewMockLogger(logging.ERROR))
 
 	assert.Equal(t, expectedComfigs, configs)
 }
@@ -164,12 +165,27 @@ func TestSQL_ConfigCases(t *testing.T) {
 			SSLMode:     "disable",
 		}
 
-		configs := getDBConfig(mockConfig)
+		configs := getDBConfig(mockConfig, logging.NewMockLogger(logging.ERROR))
 
 		assert.Equal(t, expectedConfig, configs)
 	}
 }
 
+func TestSQL_InvalidStmtCacheSize(t *testing.T) {
+	for i, value := range []string{"many", "-1"} {
+		var configs *DBConfig
+
+		logs := testutil.StderrOutputForFunc(func() {
+			mockConfig := config.NewMockConfig(map[string]string{"DB_STMT_CACHE_SIZE": value})
+
+			configs = getDBConfig(mockConfig, logging.NewMockLogger(logging.ERROR))
+		})
+
+		assert.Equal(t, 0, configs.StmtCacheSize, "TEST[%d], Failed.\n%s", i, value)
+		assert.Contains(t, logs, "invalid value "+value+" for DB_STMT_CACHE_SIZE", "TEST[%d], Failed.\n%s", i, value)
+	}
+}
+
 func TestSQL_getDBConnectionString(t *testing.T) {
 	testCases := []struct {
 		desc    string
@@ -342,8 +358,13 @@ func Test_SQLRetryConnectionInfoLog(t *testing.T) {
 
 		mockLogger := logging.NewMockLogger(logging.DEBUG)
 
-		mockMetrics.EXPECT().SetGauge("app_sql_open_connections", float64(0))
-		mockMetrics.EXPECT().SetGauge("app_sql_inUse_connections", float64(0))
+		labels := []any{"hostname", "host", "database", "test"}
+
+		mockMetrics.EXPECT().SetGauge("app_sql_open_connections", float64(0), labels...)
+		mockMetrics.EXPECT().SetGauge("app_sql_inUse_connections", float64(0), labels...)
+		mockMetrics.EXPECT().SetGauge("app_sql_idle_connections", float64(0), labels...)
+		mockMetrics.EXPECT().SetGauge("app_sql_wait_count", float64(0), labels...)
+		mockMetrics.EXPECT().SetGauge("app_sql_wait_duration", float64(0), labels...)
 
 		_ = NewSQL(mockConfig, mockLogger, mockMetrics)
 
@@ -352,3 +373,19 @@ func Test_SQLRetryConnectionInfoLog(t *testing.T) {
 
 	assert.Contains(t, logs, "retrying SQL database connection")
 }
+
+func Test_recordDBStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMetrics := NewMockMetrics(ctrl)
+
+	labels := []any{"hostname", "localhost", "database", "test"}
+
+	mockMetrics.EXPECT().SetGauge("app_sql_open_connections", float64(3), labels...)
+	mockMetrics.EXPECT().SetGauge("app_sql_inUse_connections", float64(2), labels...)
+	mockMetrics.EXPECT().SetGauge("app_sql_idle_connections", float64(1), labels...)
+	mockMetrics.EXPECT().SetGauge("app_sql_wait_count", float64(5), labels...)
+	mockMetrics.EXPECT().SetGauge("app_sql_wait_duration", 1.5, labels...)
+
+	recordDBStats(sql.DBStats{OpenConnections: 3, InUse: 2, Idle: 1, WaitCount: 5, WaitDuration: 1500 * time.Millisecond},
+		&DBConfig{HostName: "localhost", Database: "test"}, mockMetrics)
+}
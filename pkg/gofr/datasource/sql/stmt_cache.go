@@ -0,0 +1,155 @@
+package sql
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"sync"
+)
+
+// invalidStmtErrors are the messages of the errors returned by the databases when a prepared statement cannot be
+// executed anymore, e.g. as the table it uses was altered.
+var invalidStmtErrors = []string{
+	"prepared statement needs to be re-prepared", // MySQL
+	"unknown prepared statement handler",         // MySQL
+	"cached plan must not change result type",    // PostgreSQL
+}
+
+// stmtCache is an LRU cache of prepared statements keyed by query text. A statement evicted from the
+// cache is closed only after all the callers using it have released it.
+type stmtCache struct {
+	mu      sync.Mutex
+	size    int
+	entries *list.List
+	queries map[string]*list.Element
+}
+
+type cachedStmt struct {
+	query   string
+	stmt    *sql.Stmt
+	refs    int
+	evicted bool
+}
+
+func newStmtCache(size int) *stmtCache {
+	return &stmtCache{
+		size:    size,
+		entries: list.New(),
+		queries: make(map[string]*list.Element),
+	}
+}
+
+// acquire returns the prepared statement for the query, preparing it if it is not cached.
+// The returned statement must be given back using release.
+func (c *stmtCache) acquire(ctx context.Context, db *sql.DB, query string) (*cachedStmt, error) {
+	c.mu.Lock()
+
+	if e, ok := c.queries[query]; ok {
+		c.entries.MoveToFront(e)
+
+		cs, _ := e.Value.(*cachedStmt)
+		cs.refs++
+
+		c.mu.Unlock()
+
+		return cs, nil
+	}
+
+	c.mu.Unlock()
+
+	// the statement is prepared without holding the lock, so that a slow prepare does not block other queries
+	stmt, err := db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// another caller might have prepared the same query in the meantime
+	if e, ok := c.queries[query]; ok {
+		_ = stmt.Close()
+
+		c.entries.MoveToFront(e)
+
+		cs, _ := e.Value.(*cachedStmt)
+		cs.refs++
+
+		return cs, nil
+	}
+
+	cs := &cachedStmt{query: query, stmt: stmt, refs: 1}
+	c.queries[query] = c.entries.PushFront(cs)
+
+	for c.entries.Len() > c.size {
+		c.evict(c.entries.Back())
+	}
+
+	return cs, nil
+}
+
+// release gives back a statement returned by acquire, along with the error of its execution. If the error means the
+// statement cannot be used anymore, it is removed from the cache so that the next call prepares it again, e.g. after the
+// database has restarted. The statement is kept for the other errors, like a violated constraint.
+func (c *stmtCache) release(cs *cachedStmt, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cs.refs--
+
+	if isInvalidStmt(err) && !cs.evicted {
+		if e, ok := c.queries[cs.query]; ok && e.Value == cs {
+			c.evict(e)
+
+			return
+		}
+	}
+
+	if cs.evicted && cs.refs == 0 {
+		_ = cs.stmt.Close()
+	}
+}
+
+func isInvalidStmt(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+
+	for _, invalid := range invalidStmtErrors {
+		if strings.Contains(msg, invalid) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// purge removes all the statements from the cache.
+func (c *stmtCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for c.entries.Len() > 0 {
+		c.evict(c.entries.Back())
+	}
+}
+
+func (c *stmtCache) evict(e *list.Element) {
+	cs, _ := c.entries.Remove(e).(*cachedStmt)
+	delete(c.queries, cs.query)
+
+	cs.evicted = true
+
+	if cs.refs == 0 {
+		_ = cs.stmt.Close()
+	}
+}
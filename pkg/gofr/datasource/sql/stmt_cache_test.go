@@ -0,0 +1,136 @@
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/logging"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+func getDBWithStmtCache(t *testing.T, size int) (*DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	db, mock := getDB(t, logging.INFO)
+	db.stmts = newStmtCache(size)
+
+	mockMetrics := NewMockMetrics(gomock.NewController(t))
+	mockMetrics.EXPECT().RecordHistogram(gomock.Any(), "app_sql_stats", gomock.Any(),
+		"hostname", gomock.Any(), "database", gomock.Any(), "type", gomock.Any()).AnyTimes()
+
+	db.metrics = mockMetrics
+
+	return db, mock
+}
+
+func TestDB_ExecContextUsesCachedStatement(t *testing.T) {
+	db, mock := getDBWithStmtCache(t, 2)
+	defer db.DB.Close()
+
+	prep := mock.ExpectPrepare("UPDATE users SET name = ? WHERE id = ?")
+	prep.ExpectExec().WithArgs("a", 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	prep.ExpectExec().WithArgs("b", 2).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	_, err := db.ExecContext(context.Background(), "UPDATE users SET name = ? WHERE id = ?", "a", 1)
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(context.Background(), "UPDATE users SET name = ? WHERE id = ?", "b", 2)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDB_QueryRowContextUsesCachedStatement(t *testing.T) {
+	db, mock := getDBWithStmtCache(t, 2)
+	defer db.DB.Close()
+
+	prep := mock.ExpectPrepare("SELECT name FROM users WHERE id = ?")
+	prep.ExpectQuery().WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("a"))
+	prep.ExpectQuery().WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("b"))
+
+	var name string
+
+	require.NoError(t, db.QueryRowContext(context.Background(), "SELECT name FROM users WHERE id = ?", 1).Scan(&name))
+	assert.Equal(t, "a", name)
+
+	require.NoError(t, db.QueryRowContext(context.Background(), "SELECT name FROM users WHERE id = ?", 2).Scan(&name))
+	assert.Equal(t, "b", name)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDB_StmtCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	db, mock := getDBWithStmtCache(t, 1)
+	defer db.DB.Close()
+
+	mock.ExpectPrepare("DELETE FROM users WHERE id = ?").WillBeClosed().
+		ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectPrepare("DELETE FROM orders WHERE id = ?").
+		ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	_, err := db.ExecContext(context.Background(), "DELETE FROM users WHERE id = ?", 1)
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(context.Background(), "DELETE FROM orders WHERE id = ?", 1)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, 1, db.stmts.entries.Len())
+}
+
+func TestDB_StmtCachePreparesAgainAfterFailure(t *testing.T) {
+	db, mock := getDBWithStmtCache(t, 2)
+	defer db.DB.Close()
+
+	errReprepare := testutil.CustomError{ErrorMessage: "Error 1615 (HY000): Prepared statement needs to be re-prepared"}
+
+	mock.ExpectPrepare("DELETE FROM users WHERE id = ?").WillBeClosed().
+		ExpectExec().WithArgs(1).WillReturnError(errReprepare)
+	mock.ExpectPrepare("DELETE FROM users WHERE id = ?").
+		ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	_, err := db.ExecContext(context.Background(), "DELETE FROM users WHERE id = ?", 1)
+	require.ErrorIs(t, err, errReprepare)
+
+	_, err = db.ExecContext(context.Background(), "DELETE FROM users WHERE id = ?", 1)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDB_StmtCacheKeepsStatementAfterQueryError(t *testing.T) {
+	db, mock := getDBWithStmtCache(t, 2)
+	defer db.DB.Close()
+
+	prep := mock.ExpectPrepare("DELETE FROM users WHERE id = ?")
+	prep.ExpectExec().WithArgs(1).WillReturnError(errDB)
+	prep.ExpectExec().WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	_, err := db.ExecContext(context.Background(), "DELETE FROM users WHERE id = ?", 1)
+	require.ErrorIs(t, err, errDB)
+
+	_, err = db.ExecContext(context.Background(), "DELETE FROM users WHERE id = ?", 2)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, 1, db.stmts.entries.Len())
+}
+
+func TestDB_StmtCacheFallsBackWhenPrepareFails(t *testing.T) {
+	db, mock := getDBWithStmtCache(t, 2)
+	defer db.DB.Close()
+
+	mock.ExpectPrepare("DELETE FROM users WHERE id = ?").WillReturnError(errSyntax)
+	mock.ExpectExec("DELETE FROM users WHERE id = ?").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	_, err := db.ExecContext(context.Background(), "DELETE FROM users WHERE id = ?", 1)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, 0, db.stmts.entries.Len())
+}
@@ -0,0 +1,32 @@
+package gofr
+
+import (
+	"strings"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource"
+)
+
+// startupPolicyFailFast is the value of DATASOURCE_STARTUP_POLICY exiting the application when a datasource is
+// unavailable at startup. By default, the application starts degraded and reconnects to it in the background.
+const startupPolicyFailFast = "fail-fast"
+
+// unavailableDatasources returns the datasources which cannot be connected to, if the application must not start
+// without them.
+func (a *App) unavailableDatasources() []string {
+	if !strings.EqualFold(a.Config.Get("DATASOURCE_STARTUP_POLICY"), startupPolicyFailFast) {
+		return nil
+	}
+
+	var down []string
+
+	if !container.IsNil(a.container.SQL) && a.container.SQL.HealthCheck().Status == datasource.StatusDown {
+		down = append(down, "sql")
+	}
+
+	if !container.IsNil(a.container.Redis) && a.container.Redis.HealthCheck().Status == datasource.StatusDown {
+		down = append(down, "redis")
+	}
+
+	return down
+}
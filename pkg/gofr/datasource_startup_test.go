@@ -0,0 +1,40 @@
+package gofr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gofr.dev/pkg/gofr/config"
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource"
+)
+
+func TestApp_unavailableDatasources(t *testing.T) {
+	tests := []struct {
+		desc        string
+		policy      string
+		redisStatus string
+		expected    []string
+	}{
+		{"degraded by default", "", datasource.StatusDown, nil},
+		{"fail fast with datasources up", "fail-fast", datasource.StatusUp, nil},
+		{"fail fast with redis down", "FAIL-FAST", datasource.StatusDown, []string{"redis"}},
+	}
+
+	for i, tc := range tests {
+		c, mocks := container.NewMockContainer(t)
+		c.SQL = nil
+
+		if tc.policy != "" {
+			mocks.Redis.EXPECT().HealthCheck().Return(datasource.Health{Status: tc.redisStatus})
+		}
+
+		app := &App{
+			Config:    config.NewMockConfig(map[string]string{"DATASOURCE_STARTUP_POLICY": tc.policy}),
+			container: c,
+		}
+
+		assert.Equal(t, tc.expected, app.unavailableDatasources(), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
@@ -0,0 +1,55 @@
+package gofr
+
+import "errors"
+
+// errorStatuses holds the HTTP status codes registered for errors using App.RegisterErrorStatus.
+type errorStatuses struct {
+	mappings []errorStatus
+}
+
+type errorStatus struct {
+	err        error
+	statusCode int
+}
+
+// statusError is an error which responds with the status code registered for the error it wraps.
+type statusError struct {
+	error
+
+	statusCode int
+}
+
+func (e statusError) StatusCode() int {
+	return e.statusCode
+}
+
+func (e statusError) Unwrap() error {
+	return e.error
+}
+
+// RegisterErrorStatus maps an error to the HTTP status code which is sent when a handler returns the error,
+// or an error which wraps it. Errors are matched using errors.Is in the order they are registered. The
+// mapping is not applied to errors which already define their status code using a StatusCode method.
+// It should be called before the application is run.
+func (a *App) RegisterErrorStatus(err error, statusCode int) {
+	a.errorStatuses.mappings = append(a.errorStatuses.mappings, errorStatus{err: err, statusCode: statusCode})
+}
+
+// apply wraps err in a statusError if a status code is registered for it.
+func (s *errorStatuses) apply(err error) error {
+	if s == nil || err == nil {
+		return err
+	}
+
+	if _, ok := err.(interface{ StatusCode() int }); ok {
+		return err
+	}
+
+	for _, m := range s.mappings {
+		if errors.Is(err, m.err) {
+			return statusError{error: err, statusCode: m.statusCode}
+		}
+	}
+
+	return err
+}
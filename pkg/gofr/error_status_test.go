@@ -0,0 +1,70 @@
+package gofr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/logging"
+)
+
+var (
+	errUserNotFound = errors.New("user not found")
+	errConflict     = errors.New("user already exists")
+)
+
+func TestApp_RegisterErrorStatus(t *testing.T) {
+	app := &App{}
+
+	app.RegisterErrorStatus(errUserNotFound, http.StatusNotFound)
+	app.RegisterErrorStatus(errConflict, http.StatusConflict)
+
+	testCases := []struct {
+		desc       string
+		err        error
+		statusCode int
+		body       string
+	}{
+		{"registered error", errUserNotFound, http.StatusNotFound, `{"error":{"message":"user not found"}}`},
+		{"wrapped registered error", fmt.Errorf("fetching user 1: %w", errConflict), http.StatusConflict,
+			`{"error":{"message":"fetching user 1: user already exists"}}`},
+		{"unregistered error", errTest, http.StatusInternalServerError, `{"error":{"message":"some error"}}`},
+		{"error with status code", gofrHTTP.ErrorInvalidParam{Params: []string{"id"}}, http.StatusBadRequest,
+			`{"error":{"message":"'1' invalid parameter(s): id"}}`},
+	}
+
+	for i, tc := range testCases {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+
+		handler{
+			function: func(*Context) (any, error) {
+				return nil, tc.err
+			},
+			container:     &container.Container{Logger: logging.NewLogger(logging.FATAL)},
+			errorStatuses: &app.errorStatuses,
+		}.ServeHTTP(w, r)
+
+		assert.Equal(t, tc.statusCode, w.Code, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Contains(t, w.Body.String(), tc.body, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestErrorStatuses_Apply(t *testing.T) {
+	var s *errorStatuses
+
+	assert.Equal(t, errUserNotFound, s.apply(errUserNotFound), "nil registry should not change the error")
+
+	s = &errorStatuses{mappings: []errorStatus{{err: errUserNotFound, statusCode: http.StatusNotFound}}}
+
+	err := s.apply(errUserNotFound)
+
+	assert.ErrorIs(t, err, errUserNotFound)
+	assert.NoError(t, s.apply(nil))
+}
@@ -0,0 +1,176 @@
+package gofr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/version"
+)
+
+// Event is a domain event published on the EventBus.
+type Event struct {
+	Topic   string
+	Payload any
+}
+
+// EventHandler handles the events of a topic subscribed on the EventBus.
+type EventHandler func(ctx *Context, event Event) error
+
+// EventOption configures the delivery of the events of a subscription.
+type EventOption func(s *eventSubscription)
+
+// WithAsyncDelivery delivers the events of the subscription in a new goroutine, so that Publish does not wait for the
+// handler and does not return its error. The context of the handler is not cancelled along with the context passed
+// to Publish, and the shutdown of the application waits for the handlers running.
+func WithAsyncDelivery() EventOption {
+	return func(s *eventSubscription) {
+		s.async = true
+	}
+}
+
+// WithEventErrorHandler sets the function called with the errors of the handler of the subscription, including its
+// recovered panics, instead of returning them from Publish or logging them.
+func WithEventErrorHandler(onError func(ctx *Context, event Event, err error)) EventOption {
+	return func(s *eventSubscription) {
+		s.onError = onError
+	}
+}
+
+type eventSubscription struct {
+	handler EventHandler
+	async   bool
+	onError func(ctx *Context, event Event, err error)
+}
+
+// EventBus delivers the events published by the components of the application to the handlers subscribed to their
+// topic in the same process, without an external broker. Unlike the pubsub clients, the events are not persisted and
+// are lost if the application stops.
+type EventBus struct {
+	container *container.Container
+	inFlight  *inFlight
+
+	mu            sync.RWMutex
+	subscriptions map[string][]eventSubscription
+}
+
+func newEventBus(c *container.Container, f *inFlight) *EventBus {
+	return &EventBus{
+		container:     c,
+		inFlight:      f,
+		subscriptions: make(map[string][]eventSubscription),
+	}
+}
+
+// EventBus returns the in-process event bus of the application.
+func (a *App) EventBus() *EventBus {
+	a.eventBusOnce.Do(func() {
+		a.eventBus = newEventBus(a.container, a.inFlight)
+	})
+
+	return a.eventBus
+}
+
+// Subscribe adds the handler for the events of the topic. By default, the handler is called synchronously by Publish,
+// in the order of subscription, and its error is returned from Publish.
+func (b *EventBus) Subscribe(topic string, handler EventHandler, opts ...EventOption) {
+	s := eventSubscription{handler: handler}
+
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.subscriptions[topic] = append(b.subscriptions[topic], s)
+}
+
+// Publish delivers the payload to the handlers subscribed to the topic. It returns the errors of the synchronous
+// handlers, joined, once all of them are called. A panic of a handler is recovered and handled as its error.
+func (b *EventBus) Publish(ctx context.Context, topic string, payload any) error {
+	b.mu.RLock()
+	subscriptions := b.subscriptions[topic]
+	b.mu.RUnlock()
+
+	event := Event{Topic: topic, Payload: payload}
+
+	var err error
+
+	for _, s := range subscriptions {
+		if !s.async {
+			err = errors.Join(err, b.deliver(ctx, s, event))
+
+			continue
+		}
+
+		done := b.inFlight.start("event " + topic)
+		asyncCtx := context.WithoutCancel(ctx)
+
+		go func() {
+			defer done()
+
+			if err := b.deliver(asyncCtx, s, event); err != nil {
+				b.container.Logger.Errorf("error in async handler of event %s: %v", topic, err)
+			}
+		}()
+	}
+
+	return err
+}
+
+// deliver calls the handler of the subscription with the event in a span of its own, and returns its error unless the
+// subscription handles its errors.
+func (b *EventBus) deliver(ctx context.Context, s eventSubscription, event Event) (err error) {
+	spanCtx, span := otel.GetTracerProvider().Tracer("gofr-"+version.Framework).Start(ctx, "event "+event.Topic,
+		trace.WithAttributes(attribute.String("event.topic", event.Topic)))
+	defer span.End()
+
+	// the logs of the handler carry the request ID and log fields of the publisher, like the ones of its background tasks
+	c := &Context{
+		Context:   spanCtx,
+		Container: correlatedContainer(spanCtx, b.container),
+		Request:   noopRequest{},
+	}
+
+	defer func() {
+		if re := recover(); re != nil {
+			c.Logger.Error(panicLog{TraceID: c.TraceID(), RequestID: c.RequestID(), Topic: event.Topic, Error: fmt.Sprint(re),
+				StackTrace: string(debug.Stack())})
+			countPanic(c, b.container, "event")
+
+			err = fmt.Errorf("%w: %v", errHandlerPanicked, re)
+		}
+
+		if err != nil && s.onError != nil && b.handleError(c, s, event, err) {
+			err = nil
+		}
+	}()
+
+	return s.handler(c, event)
+}
+
+// handleError calls the error handler of the subscription with the error of its handler, and returns false if the
+// error handler panicked, so that the error is not lost.
+func (b *EventBus) handleError(c *Context, s eventSubscription, event Event, err error) (handled bool) {
+	defer func() {
+		if re := recover(); re != nil {
+			c.Logger.Error(panicLog{TraceID: c.TraceID(), RequestID: c.RequestID(), Topic: event.Topic, Error: fmt.Sprint(re),
+				StackTrace: string(debug.Stack())})
+			countPanic(c, b.container, "event")
+
+			handled = false
+		}
+	}()
+
+	s.onError(c, event, err)
+
+	return true
+}
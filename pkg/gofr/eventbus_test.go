@@ -0,0 +1,171 @@
+package gofr
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/http/requestid"
+	"gofr.dev/pkg/gofr/logging"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+var errEventHandler = errors.New("event handler failed")
+
+type orderPlaced struct {
+	ID int
+}
+
+func TestEventBus_PublishSync(t *testing.T) {
+	c, _ := container.NewMockContainer(t)
+	bus := newEventBus(c, nil)
+
+	var received []string
+
+	bus.Subscribe("order.placed", func(_ *Context, event Event) error {
+		order, ok := event.Payload.(orderPlaced)
+		require.True(t, ok)
+
+		received = append(received, "first", event.Topic)
+
+		assert.Equal(t, 1, order.ID)
+
+		return nil
+	})
+
+	bus.Subscribe("order.placed", func(*Context, Event) error {
+		received = append(received, "second")
+
+		return errEventHandler
+	})
+
+	bus.Subscribe("order.cancelled", func(*Context, Event) error {
+		received = append(received, "cancelled")
+
+		return nil
+	})
+
+	err := bus.Publish(context.Background(), "order.placed", orderPlaced{ID: 1})
+
+	require.ErrorIs(t, err, errEventHandler)
+	assert.Equal(t, []string{"first", "order.placed", "second"}, received)
+
+	require.NoError(t, bus.Publish(context.Background(), "user.created", nil), "events without subscriptions are dropped")
+}
+
+func TestEventBus_PublishAsync(t *testing.T) {
+	c, _ := container.NewMockContainer(t)
+	f := newInFlight()
+	bus := newEventBus(c, f)
+
+	events := make(chan Event, 1)
+
+	bus.Subscribe("order.placed", func(ctx *Context, event Event) error {
+		events <- event
+
+		return ctx.Err()
+	}, WithAsyncDelivery())
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	require.NoError(t, bus.Publish(ctx, "order.placed", orderPlaced{ID: 2}))
+
+	cancel()
+
+	assert.Equal(t, Event{Topic: "order.placed", Payload: orderPlaced{ID: 2}}, <-events)
+	require.NoError(t, f.wait(context.Background()))
+}
+
+func TestEventBus_ErrorHandler(t *testing.T) {
+	c, _ := container.NewMockContainer(t)
+	bus := newEventBus(c, nil)
+
+	var handled error
+
+	bus.Subscribe("order.placed", func(*Context, Event) error {
+		return errEventHandler
+	}, WithEventErrorHandler(func(_ *Context, _ Event, err error) {
+		handled = err
+	}))
+
+	require.NoError(t, bus.Publish(context.Background(), "order.placed", nil))
+	require.ErrorIs(t, handled, errEventHandler)
+}
+
+func TestEventBus_ErrorHandlerPanic(t *testing.T) {
+	c, mocks := container.NewMockContainer(t)
+	bus := newEventBus(c, nil)
+
+	mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_panics_total", "source", "event")
+
+	bus.Subscribe("order.placed", func(*Context, Event) error {
+		return errEventHandler
+	}, WithEventErrorHandler(func(*Context, Event, error) {
+		panic("error handler panic")
+	}))
+
+	var err error
+
+	logs := testutil.StderrOutputForFunc(func() {
+		c.Logger = logging.NewMockLogger(logging.ERROR)
+
+		err = bus.Publish(context.Background(), "order.placed", nil)
+	})
+
+	require.ErrorIs(t, err, errEventHandler, "the error should be returned when the error handler panics")
+	assert.Contains(t, logs, "error handler panic")
+}
+
+func TestEventBus_RequestIDLogged(t *testing.T) {
+	c := &container.Container{}
+	bus := newEventBus(c, nil)
+
+	bus.Subscribe("order.placed", func(ctx *Context, _ Event) error {
+		ctx.Logger.Info("sending confirmation")
+
+		return nil
+	})
+
+	logs := testutil.StdoutOutputForFunc(func() {
+		c.Logger = logging.NewLogger(logging.INFO)
+
+		err := bus.Publish(requestid.NewContext(context.Background(), "req-1"), "order.placed", nil)
+		require.NoError(t, err)
+	})
+
+	assert.Contains(t, logs, `"message":"sending confirmation","fields":{"request_id":"req-1"`)
+}
+
+func TestEventBus_Panic(t *testing.T) {
+	c, mocks := container.NewMockContainer(t)
+	bus := newEventBus(c, nil)
+
+	mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_panics_total", "source", "event")
+
+	bus.Subscribe("order.placed", func(*Context, Event) error {
+		panic("event panic")
+	})
+
+	var err error
+
+	logs := testutil.StderrOutputForFunc(func() {
+		c.Logger = logging.NewMockLogger(logging.ERROR)
+
+		err = bus.Publish(context.Background(), "order.placed", nil)
+	})
+
+	require.ErrorIs(t, err, errHandlerPanicked)
+	assert.Contains(t, logs, "event panic")
+	assert.Contains(t, logs, "order.placed")
+}
+
+func TestApp_EventBus(t *testing.T) {
+	app := &App{container: container.NewContainer(nil)}
+
+	assert.Same(t, app.EventBus(), app.EventBus())
+}
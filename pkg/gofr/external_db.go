@@ -5,11 +5,12 @@ import (
 
 	"gofr.dev/pkg/gofr/container"
 	"gofr.dev/pkg/gofr/datasource/file"
+	"gofr.dev/pkg/gofr/logging"
 )
 
 // AddMongo sets the Mongo datasource in the app's container.
 func (a *App) AddMongo(db container.MongoProvider) {
-	db.UseLogger(a.Logger())
+	db.UseLogger(logging.ForModule(a.Logger(), "mongo"))
 	db.UseMetrics(a.Metrics())
 
 	tracer := otel.GetTracerProvider().Tracer("gofr-mongo")
@@ -24,7 +25,7 @@ func (a *App) AddMongo(db container.MongoProvider) {
 // AddFTP sets the FTP datasource in the app's container.
 // Deprecated: Use the AddFile method instead.
 func (a *App) AddFTP(fs file.FileSystemProvider) {
-	fs.UseLogger(a.Logger())
+	fs.UseLogger(logging.ForModule(a.Logger(), "file"))
 	fs.UseMetrics(a.Metrics())
 
 	fs.Connect()
@@ -34,7 +35,7 @@ func (a *App) AddFTP(fs file.FileSystemProvider) {
 
 // AddPubSub sets the PubSub client in the app's container.
 func (a *App) AddPubSub(pubsub container.PubSubProvider) {
-	pubsub.UseLogger(a.Logger())
+	pubsub.UseLogger(logging.ForModule(a.Logger(), "pubsub"))
 	pubsub.UseMetrics(a.Metrics())
 
 	pubsub.Connect()
@@ -44,7 +45,7 @@ func (a *App) AddPubSub(pubsub container.PubSubProvider) {
 
 // AddFileStore sets the FTP,SFTP,S3 datasource in the app's container.
 func (a *App) AddFileStore(fs file.FileSystemProvider) {
-	fs.UseLogger(a.Logger())
+	fs.UseLogger(logging.ForModule(a.Logger(), "file"))
 	fs.UseMetrics(a.Metrics())
 
 	fs.Connect()
@@ -55,7 +56,7 @@ func (a *App) AddFileStore(fs file.FileSystemProvider) {
 // AddClickhouse initializes the clickhouse client.
 // Official implementation is available in the package : gofr.dev/pkg/gofr/datasource/clickhouse .
 func (a *App) AddClickhouse(db container.ClickhouseProvider) {
-	db.UseLogger(a.Logger())
+	db.UseLogger(logging.ForModule(a.Logger(), "clickhouse"))
 	db.UseMetrics(a.Metrics())
 
 	tracer := otel.GetTracerProvider().Tracer("gofr-clickhouse")
@@ -75,7 +76,7 @@ func (a *App) UseMongo(db container.Mongo) {
 
 // AddCassandra sets the Cassandra datasource in the app's container.
 func (a *App) AddCassandra(db container.CassandraProvider) {
-	db.UseLogger(a.Logger())
+	db.UseLogger(logging.ForModule(a.Logger(), "cassandra"))
 	db.UseMetrics(a.Metrics())
 
 	tracer := otel.GetTracerProvider().Tracer("gofr-cassandra")
@@ -89,7 +90,7 @@ func (a *App) AddCassandra(db container.CassandraProvider) {
 
 // AddKVStore sets the KV-Store datasource in the app's container.
 func (a *App) AddKVStore(db container.KVStoreProvider) {
-	db.UseLogger(a.Logger())
+	db.UseLogger(logging.ForModule(a.Logger(), "kvstore"))
 	db.UseMetrics(a.Metrics())
 
 	tracer := otel.GetTracerProvider().Tracer("gofr-badger")
@@ -103,7 +104,7 @@ func (a *App) AddKVStore(db container.KVStoreProvider) {
 
 // AddSolr sets the Solr datasource in the app's container.
 func (a *App) AddSolr(db container.SolrProvider) {
-	db.UseLogger(a.Logger())
+	db.UseLogger(logging.ForModule(a.Logger(), "solr"))
 	db.UseMetrics(a.Metrics())
 
 	tracer := otel.GetTracerProvider().Tracer("gofr-solr")
@@ -118,7 +119,7 @@ func (a *App) AddSolr(db container.SolrProvider) {
 // AddDgraph sets the Dgraph datasource in the app's container.
 func (a *App) AddDgraph(db container.DgraphProvider) {
 	// Create the Dgraph client with the provided configuration
-	db.UseLogger(a.Logger())
+	db.UseLogger(logging.ForModule(a.Logger(), "dgraph"))
 	db.UseMetrics(a.Metrics())
 
 	tracer := otel.GetTracerProvider().Tracer("gofr-dgraph")
@@ -133,7 +134,7 @@ func (a *App) AddDgraph(db container.DgraphProvider) {
 // AddOpenTSDB sets the OpenTSDB datasource in the app's container.
 func (a *App) AddOpenTSDB(db container.OpenTSDBProvider) {
 	// Create the Opentsdb client with the provided configuration
-	db.UseLogger(a.Logger())
+	db.UseLogger(logging.ForModule(a.Logger(), "opentsdb"))
 	db.UseMetrics(a.Metrics())
 
 	tracer := otel.GetTracerProvider().Tracer("gofr-opentsdb")
@@ -147,7 +148,7 @@ func (a *App) AddOpenTSDB(db container.OpenTSDBProvider) {
 
 func (a *App) AddScyllaDB(db container.ScyllaDBProvider) {
 	// Create the ScyllaDB client with the provided configuration
-	db.UseLogger(a.Logger())
+	db.UseLogger(logging.ForModule(a.Logger(), "scylladb"))
 	db.UseMetrics(a.Metrics())
 
 	tracer := otel.GetTracerProvider().Tracer("gofr-scylladb")
@@ -157,7 +158,7 @@ func (a *App) AddScyllaDB(db container.ScyllaDBProvider) {
 }
 
 func (a *App) AddSurrealDB(db container.SurrealBDProvider) {
-	db.UseLogger(a.Logger())
+	db.UseLogger(logging.ForModule(a.Logger(), "surrealdb"))
 	db.UseMetrics(a.Metrics())
 
 	tracer := otel.GetTracerProvider().Tracer("gofr-surrealdb")
@@ -9,6 +9,7 @@ import (
 
 	"gofr.dev/pkg/gofr/container"
 	"gofr.dev/pkg/gofr/datasource/file"
+	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/testutil"
 )
 
@@ -23,7 +24,7 @@ func TestApp_AddKVStore(t *testing.T) {
 
 		mock := container.NewMockKVStoreProvider(ctrl)
 
-		mock.EXPECT().UseLogger(app.Logger())
+		mock.EXPECT().UseLogger(logging.ForModule(app.Logger(), "kvstore"))
 		mock.EXPECT().UseMetrics(app.Metrics())
 		mock.EXPECT().UseTracer(otel.GetTracerProvider().Tracer("gofr-badger"))
 		mock.EXPECT().Connect()
@@ -45,7 +46,7 @@ func TestApp_AddMongo(t *testing.T) {
 
 		mock := container.NewMockMongoProvider(ctrl)
 
-		mock.EXPECT().UseLogger(app.Logger())
+		mock.EXPECT().UseLogger(logging.ForModule(app.Logger(), "mongo"))
 		mock.EXPECT().UseMetrics(app.Metrics())
 		mock.EXPECT().UseTracer(gomock.Any())
 		mock.EXPECT().Connect()
@@ -67,7 +68,7 @@ func TestApp_AddCassandra(t *testing.T) {
 
 		mock := container.NewMockCassandraProvider(ctrl)
 
-		mock.EXPECT().UseLogger(app.Logger())
+		mock.EXPECT().UseLogger(logging.ForModule(app.Logger(), "cassandra"))
 		mock.EXPECT().UseMetrics(app.Metrics())
 		mock.EXPECT().UseTracer(otel.GetTracerProvider().Tracer("gofr-cassandra"))
 		mock.EXPECT().Connect()
@@ -89,7 +90,7 @@ func TestApp_AddClickhouse(t *testing.T) {
 
 		mock := container.NewMockClickhouseProvider(ctrl)
 
-		mock.EXPECT().UseLogger(app.Logger())
+		mock.EXPECT().UseLogger(logging.ForModule(app.Logger(), "clickhouse"))
 		mock.EXPECT().UseMetrics(app.Metrics())
 		mock.EXPECT().UseTracer(otel.GetTracerProvider().Tracer("gofr-clickhouse"))
 		mock.EXPECT().Connect()
@@ -111,7 +112,7 @@ func TestApp_AddFTP(t *testing.T) {
 
 		mock := file.NewMockFileSystemProvider(ctrl)
 
-		mock.EXPECT().UseLogger(app.Logger())
+		mock.EXPECT().UseLogger(logging.ForModule(app.Logger(), "file"))
 		mock.EXPECT().UseMetrics(app.Metrics())
 		mock.EXPECT().Connect()
 
@@ -130,7 +131,7 @@ func TestApp_AddFTP(t *testing.T) {
 
 		mock := file.NewMockFileSystemProvider(ctrl)
 
-		mock.EXPECT().UseLogger(app.Logger())
+		mock.EXPECT().UseLogger(logging.ForModule(app.Logger(), "file"))
 		mock.EXPECT().UseMetrics(app.Metrics())
 		mock.EXPECT().Connect()
 
@@ -151,7 +152,7 @@ func TestApp_AddS3(t *testing.T) {
 
 		mock := file.NewMockFileSystemProvider(ctrl)
 
-		mock.EXPECT().UseLogger(app.Logger())
+		mock.EXPECT().UseLogger(logging.ForModule(app.Logger(), "file"))
 		mock.EXPECT().UseMetrics(app.Metrics())
 		mock.EXPECT().Connect()
 
@@ -172,7 +173,7 @@ func TestApp_AddOpenTSDB(t *testing.T) {
 
 		mock := container.NewMockOpenTSDBProvider(ctrl)
 
-		mock.EXPECT().UseLogger(app.Logger())
+		mock.EXPECT().UseLogger(logging.ForModule(app.Logger(), "opentsdb"))
 		mock.EXPECT().UseMetrics(app.Metrics())
 		mock.EXPECT().UseTracer(gomock.Any())
 		mock.EXPECT().Connect()
@@ -193,7 +194,7 @@ func TestApp_AddScyllaDB(t *testing.T) {
 
 		mock := container.NewMockScyllaDBProvider(ctrl)
 
-		mock.EXPECT().UseLogger(app.Logger())
+		mock.EXPECT().UseLogger(logging.ForModule(app.Logger(), "scylladb"))
 		mock.EXPECT().UseMetrics(app.Metrics())
 		mock.EXPECT().UseTracer(gomock.Any())
 		mock.EXPECT().Connect()
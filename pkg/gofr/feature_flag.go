@@ -0,0 +1,49 @@
+package gofr
+
+import "gofr.dev/pkg/gofr/featureflag"
+
+// userAttribute is the attribute holding the authenticated user against which the feature flags are evaluated.
+const userAttribute = "user"
+
+// AddFeatureFlagProvider sets the provider used to evaluate the feature flags, replacing the file provider
+// configured using FEATURE_FLAGS_FILE, which is closed. The provider is closed when the application shuts down if it is
+// an io.Closer.
+func (a *App) AddFeatureFlagProvider(provider featureflag.Provider) {
+	if err := a.container.FeatureFlags.Close(); err != nil {
+		a.container.Logger.Errorf("failed to close the feature flag provider, Err: %v", err)
+	}
+
+	a.container.FeatureFlags = featureflag.New(provider)
+}
+
+// Feature returns true if the flag is enabled for the request. The authenticated user, i.e. the username of basic
+// auth or the subject of the JWT, is added to the attributes the flag is evaluated against.
+func (c *Context) Feature(flag string) bool {
+	if c.Container == nil {
+		return false
+	}
+
+	ctx := c.Context
+
+	if user := c.user(); user != "" {
+		ctx = featureflag.WithAttributes(ctx, map[string]string{userAttribute: user})
+	}
+
+	return c.FeatureFlags.IsEnabled(ctx, flag)
+}
+
+func (c *Context) user() string {
+	if c.Request == nil {
+		return ""
+	}
+
+	info := c.GetAuthInfo()
+
+	if username := info.GetUsername(); username != "" {
+		return username
+	}
+
+	subject, _ := info.GetClaims()["sub"].(string)
+
+	return subject
+}
@@ -0,0 +1,64 @@
+package gofr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/featureflag"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/middleware"
+)
+
+// betaUsers enables every flag for the users alice and 1234567890.
+type betaUsers struct{}
+
+func (betaUsers) Variant(_ context.Context, _ string, attributes map[string]string) (string, bool) {
+	switch attributes["user"] {
+	case "alice", "1234567890":
+		return "on", true
+	default:
+		return featureflag.VariantOff, true
+	}
+}
+
+func TestContext_Feature(t *testing.T) {
+	tests := []struct {
+		desc    string
+		key     any
+		value   any
+		enabled bool
+	}{
+		{"basic auth user targeted", middleware.Username, "alice", true},
+		{"basic auth user not targeted", middleware.Username, "bob", false},
+		{"JWT subject targeted", middleware.JWTClaim, jwt.MapClaims{"sub": "1234567890"}, true},
+		{"no authenticated user", middleware.APIKey, "key", false},
+	}
+
+	mockContainer, _ := container.NewMockContainer(t)
+
+	app := &App{container: mockContainer}
+	app.AddFeatureFlagProvider(betaUsers{})
+
+	for i, tc := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		req = req.WithContext(context.WithValue(req.Context(), tc.key, tc.value))
+
+		c := newContext(nil, gofrHTTP.NewRequest(req), app.container)
+
+		assert.Equal(t, tc.enabled, c.Feature("new-checkout"), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestContext_FeatureWithoutProvider(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+
+	c := &Context{Context: context.Background(), Request: noopRequest{}, Container: mockContainer}
+
+	assert.False(t, c.Feature("new-checkout"))
+}
@@ -0,0 +1,83 @@
+// Package featureflag provides feature flags evaluated against the attributes of a request, like the user, using a
+// pluggable Provider. A file based provider which reloads the flags when the file changes is included.
+package featureflag
+
+import (
+	"context"
+	"io"
+	"maps"
+)
+
+// VariantOff is the variant of a disabled flag.
+const VariantOff = "off"
+
+// Provider evaluates the flags, e.g. from a file, Redis or an external feature flag service.
+type Provider interface {
+	// Variant returns the variant of the flag for the given attributes, and false if the flag is not defined.
+	Variant(ctx context.Context, flag string, attributes map[string]string) (string, bool)
+}
+
+// FeatureFlags evaluates flags using its provider. A nil *FeatureFlags reports all flags as disabled.
+type FeatureFlags struct {
+	provider Provider
+}
+
+func New(provider Provider) *FeatureFlags {
+	return &FeatureFlags{provider: provider}
+}
+
+// Close closes the provider if it is an io.Closer, e.g. to stop the FileProvider checking its file for changes.
+func (f *FeatureFlags) Close() error {
+	if f == nil {
+		return nil
+	}
+
+	if closer, ok := f.provider.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+// IsEnabled returns true if the flag is defined and its variant for the attributes of ctx is not "off".
+func (f *FeatureFlags) IsEnabled(ctx context.Context, flag string) bool {
+	variant := f.Variant(ctx, flag)
+
+	return variant != "" && variant != VariantOff
+}
+
+// Variant returns the variant of the flag for the attributes of ctx, or an empty string if the flag is not defined.
+func (f *FeatureFlags) Variant(ctx context.Context, flag string) string {
+	if f == nil || f.provider == nil {
+		return ""
+	}
+
+	variant, ok := f.provider.Variant(ctx, flag, Attributes(ctx))
+	if !ok {
+		return ""
+	}
+
+	return variant
+}
+
+type attributesKey struct{}
+
+// WithAttributes returns a copy of ctx carrying the attributes the flags are evaluated against, merged with
+// the attributes already set on ctx.
+func WithAttributes(ctx context.Context, attributes map[string]string) context.Context {
+	merged := maps.Clone(Attributes(ctx))
+	if merged == nil {
+		merged = make(map[string]string, len(attributes))
+	}
+
+	maps.Copy(merged, attributes)
+
+	return context.WithValue(ctx, attributesKey{}, merged)
+}
+
+// Attributes returns the attributes set on ctx using WithAttributes.
+func Attributes(ctx context.Context) map[string]string {
+	attributes, _ := ctx.Value(attributesKey{}).(map[string]string)
+
+	return attributes
+}
@@ -0,0 +1,60 @@
+package featureflag
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type staticProvider map[string]string
+
+func (p staticProvider) Variant(_ context.Context, flag string, attributes map[string]string) (string, bool) {
+	if attributes["user"] == "blocked" {
+		return VariantOff, true
+	}
+
+	variant, ok := p[flag]
+
+	return variant, ok
+}
+
+func TestFeatureFlags_IsEnabled(t *testing.T) {
+	flags := New(staticProvider{"on-flag": "on", "off-flag": VariantOff, "blue-flag": "blue"})
+
+	tests := []struct {
+		desc    string
+		ctx     context.Context
+		flag    string
+		enabled bool
+		variant string
+	}{
+		{"enabled flag", context.Background(), "on-flag", true, "on"},
+		{"disabled flag", context.Background(), "off-flag", false, VariantOff},
+		{"flag with a custom variant", context.Background(), "blue-flag", true, "blue"},
+		{"undefined flag", context.Background(), "unknown", false, ""},
+		{"flag disabled for the attributes",
+			WithAttributes(context.Background(), map[string]string{"user": "blocked"}), "on-flag", false, VariantOff},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.enabled, flags.IsEnabled(tc.ctx, tc.flag), "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.variant, flags.Variant(tc.ctx, tc.flag), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestFeatureFlags_Nil(t *testing.T) {
+	var flags *FeatureFlags
+
+	assert.False(t, flags.IsEnabled(context.Background(), "on-flag"))
+	assert.Empty(t, flags.Variant(context.Background(), "on-flag"))
+}
+
+func TestWithAttributes(t *testing.T) {
+	ctx := WithAttributes(context.Background(), map[string]string{"user": "alice", "region": "eu"})
+	child := WithAttributes(ctx, map[string]string{"region": "us"})
+
+	assert.Equal(t, map[string]string{"user": "alice", "region": "eu"}, Attributes(ctx))
+	assert.Equal(t, map[string]string{"user": "alice", "region": "us"}, Attributes(child))
+	assert.Nil(t, Attributes(context.Background()))
+}
@@ -0,0 +1,148 @@
+package featureflag
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Logger is the logger used by the file provider to report the errors in reading the flags file.
+type Logger interface {
+	Infof(format string, args ...any)
+	Errorf(format string, args ...any)
+}
+
+// Flag is the definition of a flag in the flags file. The variant of the first rule that matches the attributes
+// of the request is used, otherwise the default variant.
+//
+//	{
+//	  "new-checkout": {
+//	    "variant": "off",
+//	    "rules": [{"attribute": "user", "values": ["alice", "bob"], "variant": "on"}]
+//	  }
+//	}
+type Flag struct {
+	Variant string `json:"variant"`
+	Rules   []Rule `json:"rules,omitempty"`
+}
+
+// Rule targets the requests whose attribute has one of the values.
+type Rule struct {
+	Attribute string   `json:"attribute"`
+	Values    []string `json:"values"`
+	Variant   string   `json:"variant"`
+}
+
+func (f Flag) evaluate(attributes map[string]string) string {
+	for _, r := range f.Rules {
+		value, ok := attributes[r.Attribute]
+		if ok && slices.Contains(r.Values, value) {
+			return r.Variant
+		}
+	}
+
+	return f.Variant
+}
+
+// FileProvider reads the flags from a JSON file, and reloads them whenever the file is modified.
+type FileProvider struct {
+	path   string
+	logger Logger
+
+	mu      sync.RWMutex
+	flags   map[string]Flag
+	modTime time.Time
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewFileProvider returns a provider which reads the flags from the JSON file at path, and checks the file
+// for changes every interval until it is closed. The flags are not reloaded when the interval is not positive.
+func NewFileProvider(path string, interval time.Duration, logger Logger) *FileProvider {
+	p := &FileProvider{path: path, logger: logger, stop: make(chan struct{})}
+
+	p.reload()
+
+	if interval > 0 {
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ticker.C:
+					p.reload()
+				case <-p.stop:
+					return
+				}
+			}
+		}()
+	}
+
+	return p
+}
+
+// Close stops checking the file for changes. The flags read last are still served.
+func (p *FileProvider) Close() error {
+	p.stopOnce.Do(func() { close(p.stop) })
+
+	return nil
+}
+
+func (p *FileProvider) Variant(_ context.Context, flag string, attributes map[string]string) (string, bool) {
+	p.mu.RLock()
+	f, ok := p.flags[flag]
+	p.mu.RUnlock()
+
+	if !ok {
+		return "", false
+	}
+
+	return f.evaluate(attributes), true
+}
+
+// reload reads the file again if it was modified since it was last loaded. The flags read earlier are kept
+// when the file cannot be read, so that an invalid edit does not disable all the flags, and the file is read
+// again on the next check until it is loaded.
+func (p *FileProvider) reload() {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		p.logger.Errorf("failed to read feature flags file %v, Err: %v", p.path, err)
+
+		return
+	}
+
+	p.mu.RLock()
+	modified := !info.ModTime().Equal(p.modTime)
+	p.mu.RUnlock()
+
+	if !modified {
+		return
+	}
+
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		p.logger.Errorf("failed to read feature flags file %v, Err: %v", p.path, err)
+
+		return
+	}
+
+	var flags map[string]Flag
+
+	if err := json.Unmarshal(data, &flags); err != nil {
+		p.logger.Errorf("failed to parse feature flags file %v, Err: %v", p.path, err)
+
+		return
+	}
+
+	p.mu.Lock()
+	p.flags = flags
+	p.modTime = info.ModTime()
+	p.mu.Unlock()
+
+	p.logger.Infof("loaded %d feature flags from %v", len(flags), p.path)
+}
@@ -0,0 +1,125 @@
+package featureflag
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr/logging"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+const flagsFile = `{
+	"new-checkout": {
+		"variant": "off",
+		"rules": [{"attribute": "user", "values": ["alice", "bob"], "variant": "on"}]
+	},
+	"theme": {"variant": "light"}
+}`
+
+func writeFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestFileProvider_Variant(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flags.json")
+	writeFile(t, path, flagsFile, time.Now())
+
+	p := NewFileProvider(path, 0, logging.NewMockLogger(logging.DEBUG))
+
+	tests := []struct {
+		desc       string
+		flag       string
+		attributes map[string]string
+		variant    string
+		found      bool
+	}{
+		{"targeted user", "new-checkout", map[string]string{"user": "bob"}, "on", true},
+		{"user not targeted", "new-checkout", map[string]string{"user": "carol"}, "off", true},
+		{"no attributes", "new-checkout", nil, "off", true},
+		{"flag without rules", "theme", map[string]string{"user": "bob"}, "light", true},
+		{"undefined flag", "unknown", nil, "", false},
+	}
+
+	for i, tc := range tests {
+		variant, found := p.Variant(context.Background(), tc.flag, tc.attributes)
+
+		assert.Equal(t, tc.variant, variant, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.found, found, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestFileProvider_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flags.json")
+	start := time.Now().Add(-time.Hour)
+	writeFile(t, path, flagsFile, start)
+
+	p := NewFileProvider(path, 0, logging.NewMockLogger(logging.DEBUG))
+
+	writeFile(t, path, `{"new-checkout": {"variant": "on"}}`, start.Add(time.Minute))
+	p.reload()
+
+	variant, _ := p.Variant(context.Background(), "new-checkout", nil)
+	assert.Equal(t, "on", variant)
+
+	_, found := p.Variant(context.Background(), "theme", nil)
+	assert.False(t, found, "flag removed from the file should not be found")
+
+	logs := testutil.StderrOutputForFunc(func() {
+		p.logger = logging.NewMockLogger(logging.DEBUG)
+
+		writeFile(t, path, `{"new-checkout": `, start.Add(2*time.Minute))
+		p.reload()
+	})
+
+	assert.Contains(t, logs, "failed to parse feature flags file")
+
+	variant, _ = p.Variant(context.Background(), "new-checkout", nil)
+	assert.Equal(t, "on", variant, "flags should be kept when the file is invalid")
+
+	// the file fixed without changing its modification time is loaded on the next check
+	writeFile(t, path, `{"new-checkout": {"variant": "off"}}`, start.Add(2*time.Minute))
+	p.reload()
+
+	variant, _ = p.Variant(context.Background(), "new-checkout", nil)
+	assert.Equal(t, "off", variant, "file should be read again after it failed to parse")
+}
+
+func TestFileProvider_Close(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flags.json")
+	start := time.Now().Add(-time.Hour)
+	writeFile(t, path, flagsFile, start)
+
+	p := NewFileProvider(path, 10*time.Millisecond, logging.NewMockLogger(logging.DEBUG))
+
+	require.NoError(t, New(p).Close())
+	require.NoError(t, p.Close(), "closing twice should not fail")
+
+	// wait for the polling goroutine to see the provider closed, as it may be reloading the file
+	time.Sleep(50 * time.Millisecond)
+
+	writeFile(t, path, `{"new-checkout": {"variant": "on"}}`, start.Add(time.Minute))
+	time.Sleep(50 * time.Millisecond)
+
+	variant, _ := p.Variant(context.Background(), "new-checkout", nil)
+	assert.Equal(t, "off", variant, "flags should not be reloaded after the provider is closed")
+}
+
+func TestFileProvider_MissingFile(t *testing.T) {
+	logs := testutil.StderrOutputForFunc(func() {
+		p := NewFileProvider(filepath.Join(t.TempDir(), "missing.json"), 0, logging.NewMockLogger(logging.DEBUG))
+
+		_, found := p.Variant(context.Background(), "new-checkout", nil)
+		assert.False(t, found)
+	})
+
+	assert.Contains(t, logs, "failed to read feature flags file")
+}
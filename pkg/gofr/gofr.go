@@ -2,6 +2,7 @@ package gofr
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -39,6 +40,7 @@ import (
 const (
 	defaultPublicStaticDir = "static"
 	shutDownTimeout        = 30 * time.Second
+	shutdownCleanupTimeout = 5 * time.Second
 	gofrTraceExporter      = "gofr"
 	gofrTracerURL          = "https://tracer.gofr.dev"
 	checkPortTimeout       = 2 * time.Second
@@ -53,8 +55,22 @@ type App struct {
 	httpServer   *httpServer
 	metricServer *metricServer
 
-	cmd  *cmd
-	cron *Crontab
+	cmd      *cmd
+	cron     *Crontab
+	cronOnce sync.Once
+
+	traceSampler *ratioSampler
+
+	errorStatuses errorStatuses
+	jsonConfig    gofrHTTP.JSONConfig
+
+	responseWrapper func(data any) any
+
+	requiredConfigs []string
+
+	shutdownHooks   []func(ctx *Context) error
+	shutdownTimeout time.Duration
+	inFlight        *inFlight
 
 	// container is unexported because this is an internal implementation and applications are provided access to it via Context
 	container *container.Container
@@ -63,6 +79,9 @@ type App struct {
 	httpRegistered bool
 
 	subscriptionManager SubscriptionManager
+
+	eventBus     *EventBus
+	eventBusOnce sync.Once
 }
 
 // New creates an HTTP Server Application and returns that App.
@@ -70,30 +89,65 @@ func New() *App {
 	app := &App{}
 	app.readConfig(false)
 	app.container = container.NewContainer(app.Config)
+	app.container.SetBuildInfo(BuildInfo{Version: buildVersion, Commit: buildCommit, BuildTime: buildTime})
 
 	app.initTracer()
+	app.watchConfig()
+
+	httpPort, err := strconv.Atoi(app.Config.Get("HTTP_PORT"))
+	if err != nil || httpPort <= 0 {
+		httpPort = defaultHTTPPort
+	}
 
 	// Metrics Server
-	port, err := strconv.Atoi(app.Config.Get("METRICS_PORT"))
-	if err != nil || port <= 0 {
-		port = defaultMetricPort
+	metricsPort, err := strconv.Atoi(app.Config.Get("METRICS_PORT"))
+	if err != nil || metricsPort <= 0 {
+		metricsPort = defaultMetricPort
 	}
 
-	if !isPortAvailable(port) {
-		app.container.Logger.Fatalf("metrics port %d is blocked or unreachable", port)
+	metricsPath := app.Config.GetOrDefault("METRICS_PATH", defaultMetricsPath)
+
+	// an invalid config would leave the metrics endpoint unprotected, so the application does not start
+	metricsAccess, err := newMetricsAccess(app.Config)
+	if err != nil {
+		app.container.Logger.Fatalf("invalid value of config of the metrics endpoint: %v", err)
 	}
 
-	app.metricServer = newMetricServer(port)
+	// the metrics are served by the HTTP server when they share the port
+	if metricsPort != httpPort {
+		if !isPortAvailable(metricsPort) {
+			app.container.Logger.Fatalf("metrics port %d is blocked or unreachable", metricsPort)
+		}
 
-	// HTTP Server
-	port, err = strconv.Atoi(app.Config.Get("HTTP_PORT"))
-	if err != nil || port <= 0 {
-		port = defaultHTTPPort
+		app.metricServer = newMetricServer(metricsPort, metricsPath, metricsAccess)
 	}
 
-	app.httpServer = newHTTPServer(app.container, port, middleware.GetConfigs(app.Config))
+	// HTTP Server
+	routeMetrics := strings.EqualFold(app.Config.Get("METRICS_ROUTE_LATENCY"), "true")
+
+	app.httpServer = newHTTPServer(app.container, httpPort, middleware.GetConfigs(app.Config), routeMetrics)
 	app.httpServer.certFile = app.Config.GetOrDefault("CERT_FILE", "")
 	app.httpServer.keyFile = app.Config.GetOrDefault("KEY_FILE", "")
+	app.httpServer.clientCAFile = app.Config.Get("CLIENT_CA_FILE")
+
+	if err = checkClientCA(app.httpServer.clientCAFile, app.httpServer.certFile, app.httpServer.keyFile); err != nil {
+		app.container.Logger.Fatalf("invalid TLS configuration of HTTP server: %v, set CERT_FILE and KEY_FILE", err)
+	}
+
+	if err = app.httpServer.trustedProxies.Set(strings.Split(app.Config.Get("TRUSTED_PROXIES"), ",")...); err != nil {
+		app.container.Errorf("invalid value of config TRUSTED_PROXIES: %v", err)
+	}
+
+	staticFilesMaxAge, err := parseTimeout(app.Config.Get("STATIC_FILES_MAX_AGE"))
+	if err != nil {
+		app.container.Errorf("invalid value of config STATIC_FILES_MAX_AGE: %v", err)
+	}
+
+	app.httpServer.router.StaticFilesMaxAge = staticFilesMaxAge
+
+	if app.metricServer == nil {
+		app.httpServer.router.Add(http.MethodGet, metricsPath, metricsAccess.protect(metrics.Handler(app.container.Metrics())))
+	}
 
 	// Add Default routes
 	app.add(http.MethodGet, "/.well-known/health", healthHandler)
@@ -107,14 +161,21 @@ func New() *App {
 	}
 
 	// gRPC Server
-	port, err = strconv.Atoi(app.Config.Get("GRPC_PORT"))
-	if err != nil || port <= 0 {
-		port = defaultGRPCPort
+	grpcPort, err := strconv.Atoi(app.Config.Get("GRPC_PORT"))
+	if err != nil || grpcPort <= 0 {
+		grpcPort = defaultGRPCPort
+	}
+
+	grpcOpts, err := grpcServerOptions(app.Config)
+	if err != nil {
+		app.container.Logger.Fatalf("invalid TLS configuration of gRPC server: %v", err)
 	}
 
-	app.grpcServer = newGRPCServer(app.container, port)
+	app.grpcServer = newGRPCServer(app.container, grpcPort, grpcOpts...)
 
+	app.inFlight = newInFlight()
 	app.subscriptionManager = newSubscriptionManager(app.container)
+	app.subscriptionManager.inFlight = app.inFlight
 
 	// static file server
 	currentWd, _ := os.Getwd()
@@ -148,6 +209,7 @@ func NewCMD() *App {
 	app.container.Logger = logging.NewFileLogger(app.Config.Get("CMD_LOGS_FILE"))
 	app.cmd = &cmd{
 		out: terminal.New(),
+		in:  terminal.NewInput(),
 	}
 	app.container.Create(app.Config)
 	app.initTracer()
@@ -157,6 +219,14 @@ func NewCMD() *App {
 
 // Run starts the application. If it is an HTTP server, it will start the server.
 func (a *App) Run() {
+	if missing := a.missingConfigs(); len(missing) > 0 {
+		a.container.Logger.Fatalf("missing required configs: %s", strings.Join(missing, ", "))
+	}
+
+	if down := a.unavailableDatasources(); len(down) > 0 {
+		a.container.Logger.Fatalf("datasources unavailable at startup: %s", strings.Join(down, ", "))
+	}
+
 	if a.cmd != nil {
 		a.cmd.Run(a.container)
 	}
@@ -170,7 +240,7 @@ func (a *App) Run() {
 		<-ctx.Done()
 
 		// Create a shutdown context with a timeout
-		shutdownCtx, done := context.WithTimeout(context.WithoutCancel(ctx), shutDownTimeout)
+		shutdownCtx, done := context.WithTimeout(context.WithoutCancel(ctx), a.getShutdownTimeout())
 		defer done()
 
 		_ = a.Shutdown(shutdownCtx)
@@ -226,18 +296,34 @@ func (a *App) Run() {
 
 // Shutdown stops the service(s) and close the application.
 // It shuts down the HTTP, gRPC, Metrics servers and closes the container's active connections to datasources.
+// The servers stop accepting new requests and the in-flight requests and pubsub messages are awaited, after which the
+// connections are closed and the requests still in flight are logged. The shutdown hooks are then run and the
+// datasources closed. All of it completes by the deadline of ctx: the in-flight work is awaited until the
+// SHUTDOWN_CLEANUP_TIMEOUT before it, which is left for the hooks and the datasources.
 func (a *App) Shutdown(ctx context.Context) error {
+	drainCtx, cancel := a.drainContext(ctx)
+	defer cancel()
+
 	var err error
 	if a.httpServer != nil {
-		err = errors.Join(err, a.httpServer.Shutdown(ctx))
+		err = errors.Join(err, a.httpServer.Shutdown(drainCtx))
 	}
 
 	if a.grpcServer != nil {
-		err = errors.Join(err, a.grpcServer.Shutdown(ctx))
+		err = errors.Join(err, a.grpcServer.Shutdown(drainCtx))
 	}
 
+	// wait for the messages being handled by the subscribers, the HTTP requests are awaited by the HTTP server
+	if waitErr := a.inFlight.wait(drainCtx); waitErr != nil {
+		a.container.Logger.Errorf("shutdown timed out, still in flight: %s", strings.Join(a.inFlight.pending(), ", "))
+	}
+
+	a.runShutdownHooks(ctx)
+
 	if a.container != nil {
-		err = errors.Join(err, a.container.Close())
+		err = errors.Join(err, ShutdownWithContext(ctx, func(context.Context) error {
+			return a.container.Close()
+		}, nil))
 	}
 
 	if a.metricServer != nil {
@@ -266,14 +352,8 @@ func isPortAvailable(port int) bool {
 }
 
 func (a *App) httpServerSetup() {
-	// TODO: find a way to read REQUEST_TIMEOUT config only once and log it there. currently doing it twice one for populating
-	// the value and other for logging
-	requestTimeout := a.Config.Get("REQUEST_TIMEOUT")
-	if requestTimeout != "" {
-		timeoutVal, err := strconv.Atoi(requestTimeout)
-		if err != nil || timeoutVal < 0 {
-			a.container.Error("invalid value of config REQUEST_TIMEOUT.")
-		}
+	if _, err := parseTimeout(a.Config.Get("REQUEST_TIMEOUT")); err != nil {
+		a.container.Error("invalid value of config REQUEST_TIMEOUT.")
 	}
 
 	a.httpServer.router.PathPrefix("/").Handler(handler{
@@ -345,47 +425,136 @@ func (a *App) AddHTTPService(serviceName, serviceAddress string, options ...serv
 }
 
 // GET adds a Handler for HTTP GET method for a route pattern.
-func (a *App) GET(pattern string, handler Handler) {
-	a.add("GET", pattern, handler)
+func (a *App) GET(pattern string, handler Handler, opts ...RouteOption) {
+	a.add("GET", pattern, handler, opts...)
 }
 
 // PUT adds a Handler for HTTP PUT method for a route pattern.
-func (a *App) PUT(pattern string, handler Handler) {
-	a.add("PUT", pattern, handler)
+func (a *App) PUT(pattern string, handler Handler, opts ...RouteOption) {
+	a.add("PUT", pattern, handler, opts...)
 }
 
 // POST adds a Handler for HTTP POST method for a route pattern.
-func (a *App) POST(pattern string, handler Handler) {
-	a.add("POST", pattern, handler)
+func (a *App) POST(pattern string, handler Handler, opts ...RouteOption) {
+	a.add("POST", pattern, handler, opts...)
 }
 
 // DELETE adds a Handler for HTTP DELETE method for a route pattern.
-func (a *App) DELETE(pattern string, handler Handler) {
-	a.add("DELETE", pattern, handler)
+func (a *App) DELETE(pattern string, handler Handler, opts ...RouteOption) {
+	a.add("DELETE", pattern, handler, opts...)
 }
 
 // PATCH adds a Handler for HTTP PATCH method for a route pattern.
-func (a *App) PATCH(pattern string, handler Handler) {
-	a.add("PATCH", pattern, handler)
+func (a *App) PATCH(pattern string, handler Handler, opts ...RouteOption) {
+	a.add("PATCH", pattern, handler, opts...)
 }
 
-func (a *App) add(method, pattern string, h Handler) {
+func (a *App) add(method, pattern string, h Handler, opts ...RouteOption) {
 	if !a.httpRegistered && !isPortAvailable(a.httpServer.port) {
 		a.container.Logger.Fatalf("http port %d is blocked or unreachable", a.httpServer.port)
 	}
 
 	a.httpRegistered = true
 
-	reqTimeout, err := strconv.Atoi(a.Config.Get("REQUEST_TIMEOUT"))
-	if (err != nil && a.Config.Get("REQUEST_TIMEOUT") != "") || reqTimeout < 0 {
-		reqTimeout = 0
+	// an invalid timeout is logged when the server starts, and the requests are not timed out
+	reqTimeout, _ := parseTimeout(a.Config.Get("REQUEST_TIMEOUT"))
+
+	hndlr := handler{
+		function:        h,
+		container:       a.container,
+		requestTimeout:  reqTimeout,
+		inFlight:        a.inFlight,
+		errorStatuses:   &a.errorStatuses,
+		jsonConfig:      &a.jsonConfig,
+		responseWrapper: &a.responseWrapper,
 	}
 
-	a.httpServer.router.Add(method, pattern, handler{
-		function:       h,
-		container:      a.container,
-		requestTimeout: time.Duration(reqTimeout) * time.Second,
-	})
+	for _, opt := range opts {
+		opt(&hndlr)
+	}
+
+	a.httpServer.router.Add(method, pattern, hndlr)
+}
+
+var errNegativeTimeout = errors.New("timeout cannot be negative")
+
+// parseTimeout parses a timeout config, e.g. REQUEST_TIMEOUT, which is either a number of seconds or a duration, e.g. 500ms.
+// An empty value returns 0.
+func parseTimeout(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		seconds, atoiErr := strconv.Atoi(value)
+		if atoiErr != nil {
+			return 0, err
+		}
+
+		timeout = time.Duration(seconds) * time.Second
+	}
+
+	if timeout < 0 {
+		return 0, errNegativeTimeout
+	}
+
+	return timeout, nil
+}
+
+// SetShutdownTimeout sets how long the graceful shutdown waits for the in-flight requests and pubsub messages to
+// complete and for the datasources to close, after which the connections are closed forcefully. The last
+// SHUTDOWN_CLEANUP_TIMEOUT of it, 5 seconds by default, is left for the shutdown hooks and closing the datasources.
+// It overrides the SHUTDOWN_TIMEOUT config, and defaults to 30 seconds.
+func (a *App) SetShutdownTimeout(timeout time.Duration) {
+	a.shutdownTimeout = timeout
+}
+
+// drainContext returns the context until which the in-flight work is awaited during the shutdown, which is done
+// before ctx, leaving the cleanup timeout, but at most half of the time left, to the hooks and the datasources.
+func (a *App) drainContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+
+	cleanup := min(a.getShutdownCleanupTimeout(), time.Until(deadline)/2)
+
+	return context.WithDeadline(ctx, deadline.Add(-cleanup))
+}
+
+func (a *App) getShutdownCleanupTimeout() time.Duration {
+	if a.Config == nil {
+		return shutdownCleanupTimeout
+	}
+
+	timeout, err := parseTimeout(a.Config.Get("SHUTDOWN_CLEANUP_TIMEOUT"))
+	if err != nil {
+		a.container.Errorf("invalid value of config SHUTDOWN_CLEANUP_TIMEOUT: %v", err)
+	}
+
+	if timeout > 0 {
+		return timeout
+	}
+
+	return shutdownCleanupTimeout
+}
+
+func (a *App) getShutdownTimeout() time.Duration {
+	if a.shutdownTimeout > 0 {
+		return a.shutdownTimeout
+	}
+
+	timeout, err := parseTimeout(a.Config.Get("SHUTDOWN_TIMEOUT"))
+	if err != nil {
+		a.container.Errorf("invalid value of config SHUTDOWN_TIMEOUT: %v", err)
+	}
+
+	if timeout > 0 {
+		return timeout
+	}
+
+	return shutDownTimeout
 }
 
 // Metrics returns the metrics manager associated with the App.
@@ -418,17 +587,19 @@ func (a *App) Migrate(migrationsMap map[int64]migration.Migrate) {
 }
 
 func (a *App) initTracer() {
-	traceRatio, err := strconv.ParseFloat(a.Config.GetOrDefault("TRACER_RATIO", "1"), 64)
+	traceRatio, err := parseTraceRatio(a.Config.GetOrDefault("TRACER_RATIO", "1"))
 	if err != nil {
-		a.container.Error(err)
+		a.container.Errorf("invalid value of config TRACER_RATIO, using %v instead, err: %v", traceRatio, err)
 	}
 
+	a.traceSampler = newRatioSampler(traceRatio)
+
 	tp := sdktrace.NewTracerProvider(
 		sdktrace.WithResource(resource.NewWithAttributes(
 			semconv.SchemaURL,
 			semconv.ServiceNameKey.String(a.container.GetAppName()),
 		)),
-		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(traceRatio))),
+		sdktrace.WithSampler(sdktrace.ParentBased(a.traceSampler)),
 	)
 	otel.SetTracerProvider(tp)
 	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
@@ -629,6 +800,9 @@ func (a *App) EnableAPIKeyAuthWithValidator(validateFunc func(c *container.Conta
 //
 // The JWKS endpoint is used to retrieve JSON Web Key Sets for verifying tokens.
 // The refresh interval specifies how often to refresh the token cache.
+//
+// The scopes granted by the tokens, checked using ctx.HasScope and RequireScope, are read from the claim set in
+// OAUTH_SCOPE_CLAIM, or from the scope or scp claim by default.
 func (a *App) EnableOAuth(jwksEndpoint string, refreshInterval int) {
 	a.AddHTTPService("gofr_oauth", jwksEndpoint)
 
@@ -637,7 +811,142 @@ func (a *App) EnableOAuth(jwksEndpoint string, refreshInterval int) {
 		RefreshInterval: time.Second * time.Duration(refreshInterval),
 	}
 
-	a.httpServer.router.Use(middleware.OAuth(middleware.NewOAuth(oauthOption)))
+	a.enableOAuth(middleware.NewOAuth(oauthOption))
+}
+
+// JWKS is a JSON Web Key Set, holding the public keys validating the tokens of EnableOAuthWithJWKS.
+type JWKS = middleware.JWKS
+
+// EnableOAuthWithPublicKey configures the OAuth middleware to validate the tokens using the PEM encoded RSA public key
+// or certificate, without fetching a JWKS endpoint, e.g. for the tokens issued by the application itself.
+func (a *App) EnableOAuthWithPublicKey(pemKey []byte) error {
+	provider, err := middleware.NewStaticPublicKey(pemKey)
+	if err != nil {
+		return err
+	}
+
+	a.enableOAuth(provider)
+
+	return nil
+}
+
+// EnableOAuthWithJWKS configures the OAuth middleware to validate the tokens using the RSA keys of the JWKS, without
+// fetching a JWKS endpoint.
+func (a *App) EnableOAuthWithJWKS(jwks JWKS) error {
+	provider, err := middleware.NewStaticJWKS(jwks)
+	if err != nil {
+		return err
+	}
+
+	a.enableOAuth(provider)
+
+	return nil
+}
+
+// EnableOAuthWithJWKSFile configures the OAuth middleware to validate the tokens using the RSA keys of the JWKS read
+// from the JSON file at path, e.g. in air-gapped environments. The file is read once.
+func (a *App) EnableOAuthWithJWKSFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var jwks JWKS
+
+	if err = json.Unmarshal(data, &jwks); err != nil {
+		return fmt.Errorf("invalid JWKS file %s: %w", path, err)
+	}
+
+	return a.EnableOAuthWithJWKS(jwks)
+}
+
+func (a *App) enableOAuth(provider middleware.PublicKeyProvider) {
+	a.httpServer.router.Use(middleware.OAuth(provider), middleware.OAuthScopes(a.Config.Get("OAUTH_SCOPE_CLAIM")))
+}
+
+// SessionConfig configures the session authentication enabled using App.EnableSessionAuth.
+type SessionConfig = middleware.SessionConfig
+
+// SessionStore persists the sessions of the session authentication.
+type SessionStore = middleware.SessionStore
+
+// Session is the session of a request authenticated using the session authentication.
+type Session = middleware.Session
+
+// EnableSessionAuth enables the authentication of the requests by a signed, HttpOnly session cookie, issued by
+// ctx.Login and removed by ctx.Logout. The requests not using a safe method must also carry the CSRF token of the
+// session, unless they are to config.CSRFExemptPaths, and the requests to config.PublicPaths, e.g. the login route, need
+// no session.
+//
+// The sessions are kept in store, or in the Redis datasource when it is nil. Without Redis, they are kept in memory.
+func (a *App) EnableSessionAuth(store SessionStore, config SessionConfig) {
+	if len(config.Secret) == 0 {
+		a.container.Error("No secret provided for EnableSessionAuth. Proceeding without Authentication")
+		return
+	}
+
+	switch {
+	case store != nil:
+	case !container.IsNil(a.container.Redis):
+		store = middleware.NewRedisSessionStore(a.container.Redis)
+	default:
+		a.container.Warn("Redis is not configured, sessions are kept in memory and lost on restart")
+
+		store = middleware.NewMemorySessionStore()
+	}
+
+	a.httpServer.router.Use(middleware.SessionAuth(store, config, a.container.Logger))
+}
+
+// SetTrustedProxies sets the CIDRs, or single IPs, of the proxies in front of the application, overriding the
+// TRUSTED_PROXIES config. The X-Forwarded-For and X-Real-IP headers are only used to resolve ctx.ClientIP when the
+// request is received from one of them, as they can be set by any client.
+func (a *App) SetTrustedProxies(cidrs ...string) error {
+	return a.httpServer.trustedProxies.Set(cidrs...)
+}
+
+// JSONConfig configures the encoding of the JSON responses set using App.SetJSONConfig.
+type JSONConfig = gofrHTTP.JSONConfig
+
+// NamingStrategy is the naming of the JSON keys of the struct fields which are not named by a json tag.
+type NamingStrategy = gofrHTTP.NamingStrategy
+
+const (
+	// DefaultNaming names the JSON keys by the names of the fields.
+	DefaultNaming = gofrHTTP.DefaultNaming
+	// SnakeCase names the JSON keys in snake case, e.g. user_id.
+	SnakeCase = gofrHTTP.SnakeCase
+	// CamelCase names the JSON keys in camel case, e.g. userID.
+	CamelCase = gofrHTTP.CamelCase
+)
+
+// SetJSONConfig configures the encoding of the JSON responses of the HTTP handlers, e.g. to name the keys of the
+// fields in snake case without tagging them. The fields named by a json tag keep the name and the omitempty option
+// of their tag. It should be called before the application is run.
+func (a *App) SetJSONConfig(config JSONConfig) {
+	a.jsonConfig = config
+}
+
+// SetResponseWrapper sets the function wrapping the values returned by the HTTP handlers on success, replacing the
+// default envelope, {"data": ...}, e.g. to match the shape expected by the clients. The errors, and the values of
+// type response.Response and response.Raw, are not wrapped by it. It should be called before the application is run.
+func (a *App) SetResponseWrapper(wrapper func(data any) any) {
+	a.responseWrapper = wrapper
+}
+
+// ExposeTraceHeader writes the trace ID of every HTTP request into the given response header,
+// e.g. app.ExposeTraceHeader("X-Trace-Id"). The same ID is available in handlers via ctx.TraceID().
+func (a *App) ExposeTraceHeader(header string) {
+	a.httpServer.router.Use(middleware.TraceHeader(header))
+}
+
+// AccessLogConfig configures the access logs enabled using App.EnableAccessLog.
+type AccessLogConfig = middleware.AccessLogConfig
+
+// EnableAccessLog logs one entry per HTTP request with its headers and, if configured, the request and response
+// bodies truncated to MaxBodyBytes. Authorization, cookies, API keys and the RedactHeaders are not logged.
+func (a *App) EnableAccessLog(config AccessLogConfig) {
+	a.httpServer.router.Use(middleware.AccessLog(config, a.container.Logger))
 }
 
 // Subscribe registers a handler for the given topic.
@@ -695,16 +1004,23 @@ func (a *App) UseMiddlewareWithContainer(middlewareHandler func(c *container.Con
 // AddCronJob registers a cron job to the cron table.
 // The cron expression can be either a 5-part or 6-part format. The 6-part format includes an
 // optional second field (in beginning) and others being minute, hour, day, month and day of week respectively.
-func (a *App) AddCronJob(schedule, jobName string, job CronFunc) {
-	if a.cron == nil {
-		a.cron = NewCron(a.container)
-	}
-
-	if err := a.cron.AddJob(schedule, jobName, job); err != nil {
+// Options like WithCronLeaderLock can be passed to control how the job is executed across instances.
+func (a *App) AddCronJob(schedule, jobName string, job CronFunc, opts ...CronOption) {
+	if err := a.CronScheduler().AddJob(schedule, jobName, job, opts...); err != nil {
 		a.Logger().Errorf("error adding cron job, err: %v", err)
 	}
 }
 
+// CronScheduler returns the cron tab of the application, which can be used to add, remove and list
+// cron jobs while the application is running.
+func (a *App) CronScheduler() *Crontab {
+	a.cronOnce.Do(func() {
+		a.cron = NewCron(a.container)
+	})
+
+	return a.cron
+}
+
 // contains is a helper function checking for duplicate entry in a slice.
 func contains(elems []string, v string) bool {
 	for _, s := range elems {
@@ -723,6 +1039,40 @@ func contains(elems []string, v string) bool {
 // If `filePath` starts with "./", it will be interpreted as a relative path
 // to the current working directory.
 func (a *App) AddStaticFiles(endpoint, filePath string) {
+	endpoint, filePath, ok := a.staticFilesPath(endpoint, filePath)
+	if !ok {
+		return
+	}
+
+	a.container.Logger.Infof("registered static files at endpoint '%s' from directory '%s'", endpoint, filePath)
+
+	a.httpServer.router.AddStaticFiles(endpoint, filePath)
+}
+
+// AddStaticFilesWithSPA registers a static file endpoint for a single page application. In addition to the static
+// files, the `indexFile` in `filePath` is served with status 200 for the requests to paths which do not exist and
+// do not look like assets, e.g. /dashboard/settings, so that they are handled by the client side router. Missing
+// files with an extension, e.g. /app.js, are still not found.
+func (a *App) AddStaticFilesWithSPA(endpoint, filePath, indexFile string) {
+	endpoint, filePath, ok := a.staticFilesPath(endpoint, filePath)
+	if !ok {
+		return
+	}
+
+	if _, err := os.Stat(filepath.Join(filePath, indexFile)); err != nil {
+		a.container.Logger.Errorf("error in registering '%s' static endpoint, error: %v", endpoint, err)
+		return
+	}
+
+	a.container.Logger.Infof("registered static files at endpoint '%s' from directory '%s' with SPA index file '%s'",
+		endpoint, filePath, indexFile)
+
+	a.httpServer.router.AddStaticFilesWithSPA(endpoint, filePath, indexFile)
+}
+
+// staticFilesPath resolves the endpoint and the directory of static files, and returns false if the directory
+// does not exist.
+func (a *App) staticFilesPath(endpoint, filePath string) (resolvedEndpoint, resolvedPath string, ok bool) {
 	if !a.httpRegistered && !isPortAvailable(a.httpServer.port) {
 		a.container.Logger.Fatalf("http port %d is blocked or unreachable", a.httpServer.port)
 	}
@@ -743,10 +1093,8 @@ func (a *App) AddStaticFiles(endpoint, filePath string) {
 
 	if _, err := os.Stat(filePath); err != nil {
 		a.container.Logger.Errorf("error in registering '%s' static endpoint, error: %v", endpoint, err)
-		return
+		return "", "", false
 	}
 
-	a.container.Logger.Infof("registered static files at endpoint '%s' from directory '%s'", endpoint, filePath)
-
-	a.httpServer.router.AddStaticFiles(endpoint, filePath)
+	return endpoint, filePath, true
 }
@@ -2,18 +2,24 @@ package gofr
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
+	"net/http/cookiejar"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -174,6 +180,28 @@ func TestGofr_ServerRun(t *testing.T) {
 	resp.Body.Close()
 }
 
+func Test_parseTimeout(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		value   string
+		timeout time.Duration
+		err     bool
+	}{
+		{desc: "not set", value: "", timeout: 0},
+		{desc: "seconds", value: "5", timeout: 5 * time.Second},
+		{desc: "duration", value: "500ms", timeout: 500 * time.Millisecond},
+		{desc: "invalid value", value: "five", err: true},
+		{desc: "negative value", value: "-5", err: true},
+	}
+
+	for i, tc := range testCases {
+		timeout, err := parseTimeout(tc.value)
+
+		assert.Equal(t, tc.err, err != nil, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.timeout, timeout, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
 func Test_AddHTTPService(t *testing.T) {
 	_ = testutil.NewServerConfigs(t)
 
@@ -302,7 +330,8 @@ func TestEnableBasicAuthWithFunc(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 	}))
 
-	c := container.NewContainer(config.NewMockConfig(nil))
+	conf := config.NewMockConfig(nil)
+	c := container.NewContainer(conf)
 
 	// Initialize a new App instance
 	a := &App{
@@ -311,6 +340,7 @@ func TestEnableBasicAuthWithFunc(t *testing.T) {
 			port:   port,
 		},
 		container: c,
+		Config:    conf,
 	}
 
 	a.httpServer.router.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
@@ -357,6 +387,178 @@ func encodeBasicAuthorization(t *testing.T, arg string) string {
 	return s
 }
 
+func TestApp_EnableOAuthWithJWKSFile(t *testing.T) {
+	_ = testutil.NewServerConfigs(t)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks := fmt.Sprintf(`{"keys":[{"kid":"key-1","kty":"RSA","n":%q,"e":%q}]}`,
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()), base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()))
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "jwks.json")
+	require.NoError(t, os.WriteFile(path, []byte(jwks), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invalid.json"), []byte("invalid"), 0600))
+
+	app := New()
+
+	require.Error(t, app.EnableOAuthWithJWKSFile(filepath.Join(dir, "missing.json")))
+	require.Error(t, app.EnableOAuthWithJWKSFile(filepath.Join(dir, "invalid.json")))
+	require.Error(t, app.EnableOAuthWithPublicKey([]byte("invalid")))
+	require.NoError(t, app.EnableOAuthWithJWKSFile(path))
+
+	app.GET("/protected", func(c *Context) (any, error) {
+		return c.GetAuthInfo().GetClaims()["sub"], nil
+	})
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"sub": "user"})
+	token.Header["kid"] = "key-1"
+
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/protected", http.NoBody)
+	req.Header.Set("Authorization", "Bearer "+signed)
+
+	resp := httptest.NewRecorder()
+	app.httpServer.router.ServeHTTP(resp, req)
+
+	assert.JSONEq(t, `{"data":"user"}`, resp.Body.String())
+
+	resp = httptest.NewRecorder()
+	app.httpServer.router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/protected", http.NoBody))
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+}
+
+func Test_EnableSessionAuth(t *testing.T) {
+	_ = testutil.NewServerConfigs(t)
+
+	app := New()
+
+	app.EnableSessionAuth(nil, SessionConfig{Secret: []byte("secret"), Insecure: true, PublicPaths: []string{"/login"}})
+
+	app.POST("/login", func(ctx *Context) (any, error) {
+		if err := ctx.Login(map[string]string{"user": ctx.Param("user")}); err != nil {
+			return nil, err
+		}
+
+		return "logged in", nil
+	})
+
+	app.GET("/me", func(ctx *Context) (any, error) {
+		return ctx.Session().Get("user"), nil
+	})
+
+	server := httptest.NewServer(app.httpServer.router)
+	defer server.Close()
+
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+
+	client := server.Client()
+	client.Jar = jar
+
+	do := func(method, target string) *http.Response {
+		req, _ := http.NewRequestWithContext(context.Background(), method, server.URL+target, http.NoBody)
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	resp := do(http.MethodGet, "/me")
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp = do(http.MethodPost, "/login?user=alice")
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp = do(http.MethodGet, "/me")
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.JSONEq(t, `{"data":"alice"}`, string(body))
+}
+
+func Test_EnableSessionAuthWithoutSecret(t *testing.T) {
+	_ = testutil.NewServerConfigs(t)
+
+	app := New()
+
+	app.EnableSessionAuth(nil, SessionConfig{})
+
+	app.GET("/me", func(*Context) (any, error) {
+		return "ok", nil
+	})
+
+	resp := httptest.NewRecorder()
+	app.httpServer.router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/me", http.NoBody))
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestApp_ClientIP(t *testing.T) {
+	_ = testutil.NewServerConfigs(t)
+	t.Setenv("TRUSTED_PROXIES", "10.0.0.0/8")
+
+	app := New()
+
+	app.GET("/ip", func(ctx *Context) (any, error) {
+		return ctx.ClientIP(), nil
+	})
+
+	clientIP := func() string {
+		req := httptest.NewRequest(http.MethodGet, "/ip", http.NoBody)
+		req.RemoteAddr = "10.0.0.1:5000"
+		req.Header.Set("X-Forwarded-For", "198.51.100.1")
+
+		resp := httptest.NewRecorder()
+		app.httpServer.router.ServeHTTP(resp, req)
+
+		return resp.Body.String()
+	}
+
+	assert.JSONEq(t, `{"data":"198.51.100.1"}`, clientIP())
+
+	require.NoError(t, app.SetTrustedProxies("192.168.0.0/16"))
+
+	assert.JSONEq(t, `{"data":"10.0.0.1"}`, clientIP())
+
+	require.Error(t, app.SetTrustedProxies("invalid"))
+}
+
+func TestApp_RequestID(t *testing.T) {
+	_ = testutil.NewServerConfigs(t)
+
+	app := New()
+
+	app.GET("/id", func(ctx *Context) (any, error) {
+		return ctx.RequestID(), nil
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/id", http.NoBody)
+	req.Header.Set("X-Request-Id", "req-1")
+
+	resp := httptest.NewRecorder()
+	app.httpServer.router.ServeHTTP(resp, req)
+
+	assert.JSONEq(t, `{"data":"req-1"}`, resp.Body.String())
+	assert.Equal(t, "req-1", resp.Header().Get("X-Request-Id"))
+
+	resp = httptest.NewRecorder()
+	app.httpServer.router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/id", http.NoBody))
+
+	assert.NotEmpty(t, resp.Header().Get("X-Request-Id"))
+	assert.JSONEq(t, `{"data":"`+resp.Header().Get("X-Request-Id")+`"}`, resp.Body.String())
+}
+
 func Test_EnableBasicAuth(t *testing.T) {
 	port := testutil.GetFreePort(t)
 
@@ -861,6 +1063,30 @@ func Test_AddCronJob_Success(t *testing.T) {
 	assert.Truef(t, pass, "unable to add cron job to cron table")
 }
 
+func TestApp_CronSchedulerConcurrent(t *testing.T) {
+	a := &App{container: &container.Container{}}
+
+	schedulers := make([]*Crontab, 10)
+
+	var wg sync.WaitGroup
+
+	for i := range schedulers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			schedulers[i] = a.CronScheduler()
+		}()
+	}
+
+	wg.Wait()
+
+	for i, s := range schedulers {
+		assert.Same(t, schedulers[0], s, "TEST[%d], Failed.\n%s", i, "all the calls should return the same cron tab")
+	}
+}
+
 func setupTestEnvironment(t *testing.T) (host string, htmlContent []byte) {
 	t.Helper()
 	configs := testutil.NewServerConfigs(t)
@@ -983,6 +1209,19 @@ func TestStaticHandlerInvalidFilePath(t *testing.T) {
 	assert.Contains(t, logs, "error in registering '/gofrTest' static endpoint")
 }
 
+func TestAddStaticFilesWithSPA_MissingIndexFile(t *testing.T) {
+	logs := testutil.StderrOutputForFunc(func() {
+		testutil.NewServerConfigs(t)
+
+		app := New()
+
+		app.AddStaticFilesWithSPA("/", t.TempDir(), "index.html")
+	})
+
+	assert.Contains(t, logs, "no such file or directory")
+	assert.Contains(t, logs, "error in registering '/' static endpoint")
+}
+
 func createPublicDirectory(t *testing.T, defaultPublicStaticDir string, htmlContent []byte) {
 	t.Helper()
 
@@ -1030,6 +1269,56 @@ func Test_Shutdown(t *testing.T) {
 	assert.Contains(t, logs, "Application shutdown complete", "Test_Shutdown Failed!")
 }
 
+func Test_ShutdownTimeout(t *testing.T) {
+	logs := testutil.StderrOutputForFunc(func() {
+		app := &App{Config: config.NewMockConfig(map[string]string{"SHUTDOWN_CLEANUP_TIMEOUT": "50ms"}),
+			container: &container.Container{Logger: logging.NewLogger(logging.INFO)}, inFlight: newInFlight()}
+
+		done := app.inFlight.start("subscriber orders")
+		defer done()
+
+		app.OnShutdown(func(c *Context) error {
+			assert.NoError(t, c.Err(), "hook should be left the cleanup time of the shutdown")
+
+			return nil
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+
+		_ = app.Shutdown(ctx)
+
+		assert.Less(t, time.Since(start), 200*time.Millisecond, "shutdown should complete within its timeout")
+	})
+
+	assert.Contains(t, logs, "shutdown timed out, still in flight: subscriber orders (running for")
+	assert.NotContains(t, logs, "context deadline exceeded", "the datasources should be closed after the timeout")
+}
+
+func TestApp_getShutdownTimeout(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		config  map[string]string
+		set     time.Duration
+		timeout time.Duration
+	}{
+		{desc: "default", timeout: shutDownTimeout},
+		{desc: "from config", config: map[string]string{"SHUTDOWN_TIMEOUT": "10s"}, timeout: 10 * time.Second},
+		{desc: "invalid config", config: map[string]string{"SHUTDOWN_TIMEOUT": "ten"}, timeout: shutDownTimeout},
+		{desc: "set on app", config: map[string]string{"SHUTDOWN_TIMEOUT": "10s"}, set: time.Minute, timeout: time.Minute},
+	}
+
+	for i, tc := range testCases {
+		app := &App{Config: config.NewMockConfig(tc.config), container: &container.Container{Logger: logging.NewMockLogger(logging.FATAL)}}
+
+		app.SetShutdownTimeout(tc.set)
+
+		assert.Equal(t, tc.timeout, app.getShutdownTimeout(), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
 func TestApp_SubscriberInitialize(t *testing.T) {
 	t.Run("subscriber is initialized", func(t *testing.T) {
 		testutil.NewServerConfigs(t)
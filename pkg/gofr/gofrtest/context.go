@@ -0,0 +1,136 @@
+package gofrtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHTTP "gofr.dev/pkg/gofr/http"
+)
+
+// ContextOption is used to configure the request of the context created by NewContext.
+type ContextOption func(c *contextConfig)
+
+type contextConfig struct {
+	method     string
+	target     string
+	header     http.Header
+	query      url.Values
+	pathParams map[string]string
+	body       []byte
+	err        error
+	services   []string
+}
+
+// WithMethod sets the method of the request. Defaults to GET.
+func WithMethod(method string) ContextOption {
+	return func(c *contextConfig) {
+		c.method = method
+	}
+}
+
+// WithTarget sets the path of the request, e.g. "/books/1". Defaults to "/".
+func WithTarget(target string) ContextOption {
+	return func(c *contextConfig) {
+		c.target = target
+	}
+}
+
+// WithHeader adds a header to the request.
+func WithHeader(key, value string) ContextOption {
+	return func(c *contextConfig) {
+		c.header.Add(key, value)
+	}
+}
+
+// WithQueryParam adds a query parameter to the request, returned by ctx.Param.
+func WithQueryParam(key, value string) ContextOption {
+	return func(c *contextConfig) {
+		c.query.Add(key, value)
+	}
+}
+
+// WithPathParam sets a path parameter of the request, returned by ctx.PathParam.
+func WithPathParam(key, value string) ContextOption {
+	return func(c *contextConfig) {
+		c.pathParams[key] = value
+	}
+}
+
+// WithBody sets the body of the request, along with its content type.
+func WithBody(contentType string, body []byte) ContextOption {
+	return func(c *contextConfig) {
+		c.header.Set("Content-Type", contentType)
+		c.body = body
+	}
+}
+
+// WithJSONBody sets v as the JSON body of the request, bound by ctx.Bind.
+func WithJSONBody(v any) ContextOption {
+	return func(c *contextConfig) {
+		c.header.Set("Content-Type", "application/json")
+		c.body, c.err = json.Marshal(v)
+	}
+}
+
+// WithHTTPServices adds mocks of the HTTP services with the names, returned by ctx.GetHTTPService. The expectations of
+// the services are set on the HTTPService mock.
+func WithHTTPServices(names ...string) ContextOption {
+	return func(c *contextConfig) {
+		c.services = append(c.services, names...)
+	}
+}
+
+// NewContext creates a context to call a handler directly in a unit test, along with the mocks of the datasources of
+// its container, e.g. SQL, Redis, KVStore and HTTP services, on which the expectations of the test are set.
+func NewContext(t *testing.T, opts ...ContextOption) (*gofr.Context, *container.Mocks) {
+	t.Helper()
+
+	cfg := contextConfig{
+		method:     http.MethodGet,
+		target:     "/",
+		header:     make(http.Header),
+		query:      make(url.Values),
+		pathParams: make(map[string]string),
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.err != nil {
+		t.Fatalf("error creating request body: %v", cfg.err)
+	}
+
+	var body io.Reader = http.NoBody
+	if cfg.body != nil {
+		body = bytes.NewReader(cfg.body)
+	}
+
+	req := httptest.NewRequest(cfg.method, cfg.target, body)
+	req.Header = cfg.header
+
+	if len(cfg.query) > 0 {
+		req.URL.RawQuery = cfg.query.Encode()
+	}
+
+	req = mux.SetURLVars(req, cfg.pathParams)
+
+	c, mocks := container.NewMockContainer(t, container.WithMockHTTPService(cfg.services...))
+
+	ctx := &gofr.Context{
+		Context:   req.Context(),
+		Request:   gofrHTTP.NewRequest(req),
+		Container: c,
+	}
+
+	return ctx, mocks
+}
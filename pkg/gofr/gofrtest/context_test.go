@@ -0,0 +1,83 @@
+package gofrtest
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr"
+)
+
+type book struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+func updateBook(ctx *gofr.Context) (any, error) {
+	var b book
+
+	if err := ctx.Bind(&b); err != nil {
+		return nil, err
+	}
+
+	b.ID = ctx.PathParam("id")
+
+	if _, err := ctx.SQL.ExecContext(ctx, "UPDATE books SET title = ? WHERE id = ?", b.Title, b.ID); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.KVStore.Set(ctx, "book:"+b.ID, b.Title); err != nil {
+		return nil, err
+	}
+
+	resp, err := ctx.GetHTTPService("notifier").Post(ctx, "books", nil, []byte(b.ID))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return map[string]any{"book": b, "notify": ctx.Param("notify"), "user": ctx.Header("X-User")}, nil
+}
+
+func TestNewContext(t *testing.T) {
+	ctx, mocks := NewContext(t,
+		WithMethod(http.MethodPut),
+		WithTarget("/books/1"),
+		WithPathParam("id", "1"),
+		WithQueryParam("notify", "true"),
+		WithHeader("X-User", "gofr"),
+		WithJSONBody(book{Title: "The Go Programming Language"}),
+		WithHTTPServices("notifier"),
+	)
+
+	mocks.SQL.ExpectExec("UPDATE books SET title = ? WHERE id = ?").
+		WithArgs("The Go Programming Language", "1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mocks.KVStore.EXPECT().Set(gomock.Any(), "book:1", "The Go Programming Language").Return(nil)
+	mocks.HTTPService.EXPECT().Post(gomock.Any(), "books", nil, []byte("1")).
+		Return(&http.Response{StatusCode: http.StatusCreated, Body: io.NopCloser(&bytes.Buffer{})}, nil)
+
+	result, err := updateBook(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"book":   book{ID: "1", Title: "The Go Programming Language"},
+		"notify": "true",
+		"user":   "gofr",
+	}, result)
+}
+
+func TestNewContext_Body(t *testing.T) {
+	ctx, _ := NewContext(t, WithMethod(http.MethodPost), WithBody("application/json", []byte(`{"title":"GoFr"}`)))
+
+	var b book
+
+	require.NoError(t, ctx.Bind(&b))
+	assert.Equal(t, "GoFr", b.Title)
+}
@@ -0,0 +1,85 @@
+// Package gofrtest provides helpers to test the handlers of GoFr applications, either by calling them directly with a
+// context backed by mocks, or through the servers of a running application.
+package gofrtest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+const (
+	startTimeout    = 5 * time.Second
+	shutdownTimeout = 5 * time.Second
+)
+
+// NewApp returns a new application whose HTTP, metrics and gRPC servers listen on free ports, so that the tests using
+// it do not conflict with the other servers running on the machine. The ports are set using t.Setenv, so the tests
+// using it cannot call t.Parallel.
+func NewApp(t *testing.T) *gofr.App {
+	t.Helper()
+
+	testutil.NewServerConfigs(t)
+
+	return gofr.New()
+}
+
+// Server is an application running in the background for a test.
+type Server struct {
+	// URL is the base URL of the HTTP server of the application, e.g. http://localhost:8000.
+	URL string
+}
+
+// NewServer runs app in the background, and waits for its HTTP server to serve requests. The application must be
+// created using NewApp, and is shut down when the test completes.
+func NewServer(t *testing.T, app *gofr.App) *Server {
+	t.Helper()
+
+	s := &Server{URL: "http://localhost:" + app.Config.Get("HTTP_PORT")}
+
+	go app.Run()
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		_ = app.Shutdown(ctx)
+	})
+
+	if !s.waitAlive() {
+		t.Fatalf("application not serving requests at %s after %s", s.URL, startTimeout)
+	}
+
+	return s
+}
+
+func (s *Server) waitAlive() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), startTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, s.URL+"/.well-known/alive", http.NoBody)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err == nil {
+			resp.Body.Close()
+
+			if resp.StatusCode == http.StatusOK {
+				return true
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package gofrtest
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// defaultWSTimeout is the time the reads and writes of a WSClient wait for, so that a missing message fails the test
+// instead of blocking it.
+const defaultWSTimeout = 5 * time.Second
+
+// WSClient is a client connected to a WebSocket route of a Server.
+type WSClient struct {
+	conn    *websocket.Conn
+	timeout time.Duration
+}
+
+// DialWebSocket connects a client to the WebSocket route at path, e.g. "/ws", sending the headers in the handshake
+// request. The test fails when the connection cannot be established, and the client is closed when the test
+// completes. Several clients can be connected to the same route, e.g. to test broadcasts.
+func (s *Server) DialWebSocket(t *testing.T, path string, header http.Header) *WSClient {
+	t.Helper()
+
+	url := "ws" + strings.TrimPrefix(s.URL, "http") + path
+
+	conn, resp, err := websocket.DefaultDialer.Dial(url, header)
+	if resp != nil && resp.Body != nil {
+		resp.Body.Close()
+	}
+
+	if err != nil {
+		t.Fatalf("error connecting to websocket %s: %v", url, err)
+	}
+
+	c := &WSClient{conn: conn, timeout: defaultWSTimeout}
+
+	t.Cleanup(func() {
+		_ = c.conn.Close()
+	})
+
+	return c
+}
+
+// SetTimeout sets the time the reads and writes wait for before failing. Defaults to 5 seconds.
+func (c *WSClient) SetTimeout(timeout time.Duration) {
+	c.timeout = timeout
+}
+
+// WriteJSON sends v as a JSON message.
+func (c *WSClient) WriteJSON(v any) error {
+	if err := c.conn.SetWriteDeadline(time.Now().Add(c.timeout)); err != nil {
+		return err
+	}
+
+	return c.conn.WriteJSON(v)
+}
+
+// ReadJSON reads the next message into v as JSON.
+func (c *WSClient) ReadJSON(v any) error {
+	if err := c.conn.SetReadDeadline(time.Now().Add(c.timeout)); err != nil {
+		return err
+	}
+
+	return c.conn.ReadJSON(v)
+}
+
+// WriteText sends the message as a text message.
+func (c *WSClient) WriteText(message string) error {
+	if err := c.conn.SetWriteDeadline(time.Now().Add(c.timeout)); err != nil {
+		return err
+	}
+
+	return c.conn.WriteMessage(websocket.TextMessage, []byte(message))
+}
+
+// ReadText reads the next message as text.
+func (c *WSClient) ReadText() (string, error) {
+	if err := c.conn.SetReadDeadline(time.Now().Add(c.timeout)); err != nil {
+		return "", err
+	}
+
+	_, message, err := c.conn.ReadMessage()
+
+	return string(message), err
+}
+
+// Close closes the connection normally, sending the close message to the server.
+func (c *WSClient) Close() error {
+	_ = c.conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(c.timeout))
+
+	return c.conn.Close()
+}
@@ -0,0 +1,123 @@
+package gofrtest
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr"
+)
+
+type chatMessage struct {
+	User string `json:"user"`
+	Text string `json:"text"`
+}
+
+func TestWSClient_Echo(t *testing.T) {
+	app := NewApp(t)
+
+	app.WebSocket("/ws", func(ctx *gofr.Context) (any, error) {
+		var message chatMessage
+
+		err := ctx.Bind(&message)
+		if err != nil {
+			return nil, err
+		}
+
+		message.Text = "echo: " + message.Text + " from " + ctx.Header("X-User")
+
+		return message, nil
+	})
+
+	server := NewServer(t, app)
+	client := server.DialWebSocket(t, "/ws", http.Header{"X-User": []string{"gofr"}})
+
+	require.NoError(t, client.WriteJSON(chatMessage{User: "gofr", Text: "hello"}))
+
+	var reply chatMessage
+
+	require.NoError(t, client.ReadJSON(&reply))
+	assert.Equal(t, chatMessage{User: "gofr", Text: "echo: hello from gofr"}, reply)
+
+	require.NoError(t, client.Close())
+}
+
+func TestWSClient_Broadcast(t *testing.T) {
+	app := NewApp(t)
+
+	var (
+		mu      sync.Mutex
+		clients = make(map[*gofr.Context]struct{})
+	)
+
+	app.WebSocket("/chat", func(ctx *gofr.Context) (any, error) {
+		mu.Lock()
+		clients[ctx] = struct{}{}
+		mu.Unlock()
+
+		var message string
+
+		err := ctx.Bind(&message)
+		if err != nil {
+			return nil, err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		for c := range clients {
+			_ = c.WriteMessageToSocket("broadcast: " + message)
+		}
+
+		return nil, nil
+	}, gofr.WithOnClose(func(ctx *gofr.Context, _ int, _ string) {
+		mu.Lock()
+		delete(clients, ctx)
+		mu.Unlock()
+	}))
+
+	server := NewServer(t, app)
+
+	sender := server.DialWebSocket(t, "/chat", nil)
+	receiver := server.DialWebSocket(t, "/chat", nil)
+
+	// wait for both connections to be registered by the handler
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return len(clients) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, sender.WriteText("hi all"))
+
+	for i, client := range []*WSClient{sender, receiver} {
+		message, err := client.ReadText()
+
+		require.NoError(t, err, "TEST[%d], Failed.\n", i)
+		assert.Equal(t, "broadcast: hi all", message, "TEST[%d], Failed.\n", i)
+	}
+}
+
+func TestWSClient_ReadTimeout(t *testing.T) {
+	app := NewApp(t)
+
+	app.WebSocket("/ws", func(ctx *gofr.Context) (any, error) {
+		var message string
+
+		return nil, ctx.Bind(&message)
+	})
+
+	server := NewServer(t, app)
+	client := server.DialWebSocket(t, "/ws", nil)
+
+	client.SetTimeout(50 * time.Millisecond)
+
+	_, err := client.ReadText()
+
+	require.Error(t, err, "reading a message which is never sent should time out")
+}
@@ -10,27 +10,56 @@ import (
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	"gofr.dev/pkg/gofr/config"
 	"gofr.dev/pkg/gofr/container"
 	gofr_grpc "gofr.dev/pkg/gofr/grpc"
 )
 
 type grpcServer struct {
 	server *grpc.Server
+	health *health.Server
 	port   int
 }
 
-func newGRPCServer(c *container.Container, port int) *grpcServer {
+func newGRPCServer(c *container.Container, port int, opts ...grpc.ServerOption) *grpcServer {
+	opts = append([]grpc.ServerOption{
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			grpc_recovery.UnaryServerInterceptor(),
+			gofr_grpc.LoggingInterceptor(c.Logger),
+		)),
+	}, opts...)
+
 	return &grpcServer{
-		server: grpc.NewServer(
-			grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-				grpc_recovery.UnaryServerInterceptor(),
-				gofr_grpc.LoggingInterceptor(c.Logger),
-			))),
-		port: port,
+		server: grpc.NewServer(opts...),
+		health: health.NewServer(),
+		port:   port,
 	}
 }
 
+// grpcServerOptions returns the options of the gRPC server to terminate TLS when GRPC_CERT_FILE and GRPC_KEY_FILE
+// are set, requiring the clients to present a certificate signed by GRPC_CLIENT_CA_FILE when it is set.
+func grpcServerOptions(cfg config.Config) ([]grpc.ServerOption, error) {
+	certFile, keyFile, clientCAFile := cfg.Get("GRPC_CERT_FILE"), cfg.Get("GRPC_KEY_FILE"), cfg.Get("GRPC_CLIENT_CA_FILE")
+
+	if err := checkClientCA(clientCAFile, certFile, keyFile); err != nil {
+		return nil, err
+	}
+
+	if certFile == "" && keyFile == "" {
+		return nil, nil
+	}
+
+	creds, err := gofr_grpc.ServerCredentials(certFile, keyFile, clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return []grpc.ServerOption{grpc.Creds(creds)}, nil
+}
+
 func (g *grpcServer) Run(c *container.Container) {
 	addr := ":" + strconv.Itoa(g.port)
 
@@ -42,14 +71,34 @@ func (g *grpcServer) Run(c *container.Container) {
 		return
 	}
 
+	g.registerHealth()
+
 	if err := g.server.Serve(listener); err != nil {
 		c.Logger.Errorf("error in starting gRPC server at %s: %s", addr, err)
 		return
 	}
 }
 
+// registerHealth registers the standard gRPC health service, unless the application registered its own.
+func (g *grpcServer) registerHealth() {
+	if g.health == nil {
+		return
+	}
+
+	if _, ok := g.server.GetServiceInfo()[healthpb.Health_ServiceDesc.ServiceName]; ok {
+		return
+	}
+
+	healthpb.RegisterHealthServer(g.server, g.health)
+}
+
 func (g *grpcServer) Shutdown(ctx context.Context) error {
 	return ShutdownWithContext(ctx, func(_ context.Context) error {
+		// the clients watching the health are notified that the services are not serving anymore
+		if g.health != nil {
+			g.health.Shutdown()
+		}
+
 		g.server.GracefulStop()
 
 		return nil
@@ -73,6 +122,10 @@ func (a *App) RegisterService(desc *grpc.ServiceDesc, impl any) {
 	a.container.Logger.Infof("registering gRPC Server: %s", desc.ServiceName)
 	a.grpcServer.server.RegisterService(desc, impl)
 
+	if a.grpcServer.health != nil {
+		a.grpcServer.health.SetServingStatus(desc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	}
+
 	err := injectContainer(impl, a.container)
 	if err != nil {
 		return
@@ -81,6 +134,17 @@ func (a *App) RegisterService(desc *grpc.ServiceDesc, impl any) {
 	a.grpcRegistered = true
 }
 
+// SetGRPCServingStatus sets the status of the gRPC service reported by the standard grpc.health.v1.Health service,
+// e.g. to NOT_SERVING during maintenance. The clients watching the health of the service are notified of the change.
+// An empty service sets the overall status of the server.
+func (a *App) SetGRPCServingStatus(service string, status healthpb.HealthCheckResponse_ServingStatus) {
+	if a.grpcServer == nil || a.grpcServer.health == nil {
+		return
+	}
+
+	a.grpcServer.health.SetServingStatus(service, status)
+}
+
 func injectContainer(impl any, c *container.Container) error {
 	val := reflect.ValueOf(impl)
 
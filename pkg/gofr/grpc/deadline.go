@@ -0,0 +1,29 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// DeadlineInterceptor returns a client interceptor which makes sure that every call has a deadline. The deadline of the
+// context of the call, e.g. the one of the incoming HTTP request, is carried to the server as it is, so that cancelling
+// the request cancels the call. The calls made with a context having no deadline time out after defaultTimeout, unless
+// it is zero.
+//
+// It is added to the dial options of a client using grpc.WithChainUnaryInterceptor, either the ones passed to the
+// constructor generated by the gofr CLI or to grpc.NewClient, with the timeout suitable for the service.
+func DeadlineInterceptor(defaultTimeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok && defaultTimeout > 0 {
+			var cancel context.CancelFunc
+
+			ctx, cancel = context.WithTimeout(ctx, defaultTimeout)
+			defer cancel()
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
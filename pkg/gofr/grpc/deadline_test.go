@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestDeadlineInterceptor(t *testing.T) {
+	requestDeadline := time.Now().Add(time.Minute)
+
+	testCases := []struct {
+		desc           string
+		ctxDeadline    time.Time
+		defaultTimeout time.Duration
+		hasDeadline    bool
+		expDeadline    func() time.Time
+	}{
+		{"request deadline is kept", requestDeadline, time.Second, true, func() time.Time { return requestDeadline }},
+		{"default timeout without a deadline", time.Time{}, time.Second, true, func() time.Time { return time.Now().Add(time.Second) }},
+		{"no deadline without a default timeout", time.Time{}, 0, false, nil},
+	}
+
+	for i, tc := range testCases {
+		ctx := context.Background()
+
+		if !tc.ctxDeadline.IsZero() {
+			var cancel context.CancelFunc
+
+			ctx, cancel = context.WithDeadline(ctx, tc.ctxDeadline)
+			defer cancel()
+		}
+
+		invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			deadline, ok := ctx.Deadline()
+
+			assert.Equal(t, tc.hasDeadline, ok, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+			if ok {
+				assert.WithinDuration(t, tc.expDeadline(), deadline, 100*time.Millisecond, "TEST[%d], Failed.\n%s", i, tc.desc)
+			}
+
+			return nil
+		}
+
+		err := DeadlineInterceptor(tc.defaultTimeout)(ctx, "/test.Hello/SayHello", nil, nil, nil, invoker)
+
+		assert.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestDeadlineInterceptor_Cancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		cancel()
+
+		<-ctx.Done()
+
+		return ctx.Err()
+	}
+
+	err := DeadlineInterceptor(time.Second)(ctx, "/test.Hello/SayHello", nil, nil, nil, invoker)
+
+	assert.ErrorIs(t, err, context.Canceled, "cancelling the request should cancel the call")
+}
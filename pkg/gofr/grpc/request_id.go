@@ -0,0 +1,26 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"gofr.dev/pkg/gofr/http/requestid"
+)
+
+// RequestIDInterceptor returns a client interceptor which sends the ID of the HTTP request of the context of the call,
+// if any, in the x-request-id metadata, so that the logs of the call can be correlated with the ones of the request.
+func RequestIDInterceptor() grpc.UnaryClientInterceptor {
+	key := strings.ToLower(requestid.Header)
+
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if id := requestid.FromContext(ctx); id != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, key, id)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
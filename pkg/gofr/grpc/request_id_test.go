@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"gofr.dev/pkg/gofr/http/requestid"
+)
+
+func TestRequestIDInterceptor(t *testing.T) {
+	testCases := []struct {
+		desc string
+		ctx  context.Context
+		ids  []string
+	}{
+		{"request ID is sent", requestid.NewContext(context.Background(), "req-1"), []string{"req-1"}},
+		{"no request ID", context.Background(), nil},
+	}
+
+	for i, tc := range testCases {
+		invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+
+			assert.Equal(t, tc.ids, md.Get("x-request-id"), "TEST[%d], Failed.\n%s", i, tc.desc)
+
+			return nil
+		}
+
+		err := RequestIDInterceptor()(tc.ctx, "/test.Hello/SayHello", nil, nil, nil, invoker)
+
+		assert.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
@@ -0,0 +1,195 @@
+package grpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testCerts struct {
+	caFile, serverCert, serverKey, clientCert, clientKey string
+}
+
+// newTestCerts writes a CA, along with a server and a client certificate signed by it, to a temporary directory.
+func newTestCerts(t *testing.T) testCerts {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "gofr-test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	certs := testCerts{caFile: filepath.Join(dir, "ca.pem")}
+	writePEM(t, certs.caFile, "CERTIFICATE", caDER)
+
+	issue := func(name string, serial int64, usage x509.ExtKeyUsage) (certFile, keyFile string) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			DNSNames:     []string{"localhost"},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}
+
+		der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+		require.NoError(t, err)
+
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+
+		certFile, keyFile = filepath.Join(dir, name+".pem"), filepath.Join(dir, name+"-key.pem")
+		writePEM(t, certFile, "CERTIFICATE", der)
+		writePEM(t, keyFile, "EC PRIVATE KEY", keyDER)
+
+		return certFile, keyFile
+	}
+
+	certs.serverCert, certs.serverKey = issue("server", 2, x509.ExtKeyUsageServerAuth)
+	certs.clientCert, certs.clientKey = issue("client", 3, x509.ExtKeyUsageClientAuth)
+
+	return certs
+}
+
+func writePEM(t *testing.T, file, blockType string, der []byte) {
+	t.Helper()
+
+	err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600)
+	require.NoError(t, err)
+}
+
+// startTLSServer starts a gRPC server serving the health service with creds, and returns its address.
+func startTLSServer(t *testing.T, creds credentials.TransportCredentials) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(grpc.Creds(creds))
+	healthpb.RegisterHealthServer(server, health.NewServer())
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func checkHealth(t *testing.T, addr string, creds credentials.TransportCredentials) error {
+	t.Helper()
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+
+	return err
+}
+
+func TestTLSCredentials(t *testing.T) {
+	certs := newTestCerts(t)
+
+	serverTLS, err := ServerCredentials(certs.serverCert, certs.serverKey, "")
+	require.NoError(t, err)
+
+	serverMTLS, err := ServerCredentials(certs.serverCert, certs.serverKey, certs.caFile)
+	require.NoError(t, err)
+
+	clientTLS, err := ClientCredentials(certs.caFile, "", "")
+	require.NoError(t, err)
+
+	clientMTLS, err := ClientCredentials(certs.caFile, certs.clientCert, certs.clientKey)
+	require.NoError(t, err)
+
+	clientSystemCAs, err := ClientCredentials("", "", "")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc    string
+		server  credentials.TransportCredentials
+		client  credentials.TransportCredentials
+		success bool
+	}{
+		{"TLS", serverTLS, clientTLS, true},
+		{"mutual TLS", serverMTLS, clientMTLS, true},
+		{"mutual TLS without client certificate", serverMTLS, clientTLS, false},
+		{"server certificate not signed by the system CAs", serverTLS, clientSystemCAs, false},
+	}
+
+	for i, tc := range testCases {
+		addr := startTLSServer(t, tc.server)
+
+		err := checkHealth(t, addr, tc.client)
+
+		if tc.success {
+			require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+		} else {
+			require.Error(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+		}
+	}
+}
+
+func TestTLSCredentials_Errors(t *testing.T) {
+	certs := newTestCerts(t)
+
+	_, err := ServerCredentials("missing.pem", certs.serverKey, "")
+	require.ErrorContains(t, err, "error loading gRPC server certificate")
+
+	_, err = ServerCredentials(certs.serverCert, certs.serverKey, "missing.pem")
+	require.ErrorContains(t, err, "error reading CA file")
+
+	// a key is not a certificate
+	_, err = ServerCredentials(certs.serverCert, certs.serverKey, certs.serverKey)
+	require.ErrorIs(t, err, errInvalidCA)
+
+	_, err = ClientCredentials("missing.pem", "", "")
+	require.ErrorContains(t, err, "error reading CA file")
+
+	_, err = ClientCredentials(certs.caFile, certs.clientCert, "")
+	assert.ErrorContains(t, err, "error loading gRPC client certificate")
+}
@@ -2,13 +2,17 @@ package gofr
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	"gofr.dev/pkg/gofr/config"
 	"gofr.dev/pkg/gofr/container"
 	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/testutil"
@@ -163,3 +167,82 @@ func Test_injectContainer(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, srv3.C)
 }
+
+func TestGRPC_HealthService(t *testing.T) {
+	c, _ := container.NewMockContainer(t)
+	port := testutil.GetFreePort(t)
+
+	app := &App{container: c, grpcServer: newGRPCServer(c, port)}
+	app.RegisterService(&grpc.ServiceDesc{ServiceName: "test.Hello", HandlerType: (*any)(nil)}, &struct{}{})
+
+	go app.grpcServer.Run(c)
+
+	defer func() {
+		_ = app.grpcServer.Shutdown(context.Background())
+	}()
+
+	conn, err := grpc.NewClient(fmt.Sprintf("localhost:%d", port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	client := healthpb.NewHealthClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var res *healthpb.HealthCheckResponse
+
+	// wait for the server to start
+	require.Eventually(t, func() bool {
+		res, err = client.Check(ctx, &healthpb.HealthCheckRequest{Service: "test.Hello"})
+
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, res.GetStatus())
+
+	stream, err := client.Watch(ctx, &healthpb.HealthCheckRequest{Service: "test.Hello"})
+	require.NoError(t, err)
+
+	res, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, res.GetStatus())
+
+	app.SetGRPCServingStatus("test.Hello", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	res, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, res.GetStatus(), "watch should notify the status change")
+
+	res, err = client.Check(ctx, &healthpb.HealthCheckRequest{Service: "test.Hello"})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, res.GetStatus())
+}
+
+func Test_grpcServerOptions(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		configs map[string]string
+		expOpts int
+		expErr  string
+	}{
+		{"TLS not configured", map[string]string{}, 0, ""},
+		{"invalid certificate", map[string]string{"GRPC_CERT_FILE": "missing.pem", "GRPC_KEY_FILE": "missing-key.pem"}, 0,
+			"error loading gRPC server certificate"},
+		{"client CA without certificate", map[string]string{"GRPC_CLIENT_CA_FILE": "ca.pem"}, 0,
+			"client CA file is set without a certificate and key file"},
+	}
+
+	for i, tc := range testCases {
+		opts, err := grpcServerOptions(config.NewMockConfig(tc.configs))
+
+		if tc.expErr != "" {
+			require.ErrorContains(t, err, tc.expErr, "TEST[%d], Failed.\n%s", i, tc.desc)
+		} else {
+			require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+		}
+
+		assert.Len(t, opts, tc.expOpts, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
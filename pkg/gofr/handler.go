@@ -10,11 +10,13 @@ import (
 	"runtime/debug"
 	"time"
 
+	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 	"go.opentelemetry.io/otel/trace"
 
 	"gofr.dev/pkg/gofr/container"
 	gofrHTTP "gofr.dev/pkg/gofr/http"
+	"gofr.dev/pkg/gofr/http/requestid"
 	"gofr.dev/pkg/gofr/http/response"
 	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/static"
@@ -41,11 +43,54 @@ type handler struct {
 	function       Handler
 	container      *container.Container
 	requestTimeout time.Duration
+	errorStatuses  *errorStatuses
+	jsonConfig     *gofrHTTP.JSONConfig
+	inFlight       *inFlight
+
+	responseWrapper *func(data any) any
+	rawResponse     bool
+}
+
+// RouteOption configures a route registered using the methods of App, e.g. App.GET.
+type RouteOption func(h *handler)
+
+// WithRawResponse responds with the values returned by the handler of the route as they are, without the envelope
+// or the wrapper set using App.SetResponseWrapper, e.g. to match the contract of an external API. The errors are
+// still responded in the error envelope.
+func WithRawResponse() RouteOption {
+	return func(h *handler) {
+		h.rawResponse = true
+	}
+}
+
+// RequireScope returns a handler responding 403 Forbidden to the requests whose token, validated by the OAuth
+// authentication, does not grant the scope, and calling handler for the other ones.
+func RequireScope(scope string, handler Handler) Handler {
+	return func(c *Context) (any, error) {
+		if !c.HasScope(scope) {
+			return nil, gofrHTTP.ErrorInsufficientScope{Scope: scope}
+		}
+
+		return handler(c)
+	}
+}
+
+// wrapper returns the function wrapping the values returned by the handler on success, or nil for the default envelope.
+func (h handler) wrapper() func(data any) any {
+	switch {
+	case h.rawResponse:
+		return func(data any) any { return data }
+	case h.responseWrapper != nil:
+		return *h.responseWrapper
+	default:
+		return nil
+	}
 }
 
 type ErrorLogEntry struct {
-	TraceID string `json:"trace_id,omitempty"`
-	Error   string `json:"error,omitempty"`
+	TraceID   string `json:"trace_id,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+	Error     string `json:"error,omitempty"`
 }
 
 func (el *ErrorLogEntry) PrettyPrint(writer io.Writer) {
@@ -53,7 +98,11 @@ func (el *ErrorLogEntry) PrettyPrint(writer io.Writer) {
 }
 
 func (h handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	c := newContext(gofrHTTP.NewResponder(w, r.Method), gofrHTTP.NewRequest(r), h.container)
+	defer h.inFlight.start(r.Method + " " + r.URL.Path)()
+
+	responder := gofrHTTP.NewResponder(w, r.Method).WithJSONConfig(h.jsonConfig).WithResponseWrapper(h.wrapper())
+
+	c := newContext(responder, gofrHTTP.NewRequest(r), h.container)
 	traceID := trace.SpanFromContext(r.Context()).SpanContext().TraceID().String()
 
 	if websocket.IsWebSocketUpgrade(r) {
@@ -76,19 +125,23 @@ func (h handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	go func() {
 		defer func() {
-			panicRecoveryHandler(recover(), h.container, panicked)
+			h.recoverPanic(recover(), r, traceID, panicked)
 		}()
 		// Execute the handler function
 		result, err = h.function(c)
-		h.logError(traceID, err)
+		h.logError(traceID, c.RequestID(), err)
 		close(done)
 	}()
 
 	select {
 	case <-c.Context.Done():
-		// If the context's deadline has been exceeded, return a timeout error response
-		if errors.Is(c.Err(), context.DeadlineExceeded) {
+		// If the context's deadline has been exceeded, return a timeout error response. The context is cancelled
+		// when the client disconnects, which also cancels the datasource and HTTP service calls made with it.
+		switch {
+		case errors.Is(c.Err(), context.DeadlineExceeded):
 			err = gofrHTTP.ErrorRequestTimeout{}
+		case errors.Is(c.Err(), context.Canceled):
+			err = gofrHTTP.ErrorClientClosedRequest{}
 		}
 	case <-done:
 		handleWebSocketUpgrade(r)
@@ -102,7 +155,7 @@ func (h handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Handler function completed
-	c.responder.Respond(result, err)
+	c.responder.Respond(result, h.errorStatuses.apply(err))
 }
 
 func healthHandler(c *Context) (any, error) {
@@ -131,22 +184,39 @@ func catchAllHandler(*Context) (any, error) {
 	return nil, gofrHTTP.ErrorInvalidRoute{}
 }
 
-func panicRecoveryHandler(re any, log logging.Logger, panicked chan struct{}) {
+// recoverPanic logs the stack trace of a panic in the handler along with the trace ID and route of the request,
+// and counts it in the app_http_panics_total and app_panics_total metrics.
+func (h handler) recoverPanic(re any, r *http.Request, traceID string, panicked chan struct{}) {
 	if re == nil {
 		return
 	}
 
 	close(panicked)
-	log.Error(panicLog{
+
+	var route string
+	if cr := mux.CurrentRoute(r); cr != nil {
+		route, _ = cr.GetPathTemplate()
+	}
+
+	h.container.Logger.Error(panicLog{
+		TraceID:    traceID,
+		RequestID:  requestid.FromContext(r.Context()),
+		Route:      route,
 		Error:      fmt.Sprint(re),
 		StackTrace: string(debug.Stack()),
 	})
+
+	if m := h.container.Metrics(); m != nil {
+		m.IncrementCounter(r.Context(), "app_http_panics_total", "route", route, "method", r.Method)
+	}
+
+	countPanic(r.Context(), h.container, "http")
 }
 
-// Log the error(if any) with traceID and errorMessage.
-func (h handler) logError(traceID string, err error) {
+// Log the error(if any) with traceID, requestID and errorMessage.
+func (h handler) logError(traceID, requestID string, err error) {
 	if err != nil {
-		errorLog := &ErrorLogEntry{TraceID: traceID, Error: err.Error()}
+		errorLog := &ErrorLogEntry{TraceID: traceID, RequestID: requestID, Error: err.Error()}
 
 		// define the default log level for error
 		loggerHelper := h.container.Logger.Error
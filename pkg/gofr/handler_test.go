@@ -10,8 +10,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
 	"gofr.dev/pkg/gofr/container"
 	gofrHTTP "gofr.dev/pkg/gofr/http"
@@ -84,6 +86,113 @@ func TestHandler_ServeHTTP_Timeout(t *testing.T) {
 	assert.Contains(t, w.Body.String(), "request timed out", "TestHandler_ServeHTTP_Timeout Failed")
 }
 
+func TestHandler_ServeHTTP_JSONConfig(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+
+	type user struct {
+		UserID   int
+		Nickname string
+	}
+
+	h := handler{jsonConfig: &JSONConfig{NamingStrategy: SnakeCase, OmitEmpty: true}}
+
+	h.container = &container.Container{Logger: logging.NewLogger(logging.FATAL)}
+	h.function = func(*Context) (any, error) {
+		return user{UserID: 1}, nil
+	}
+
+	h.ServeHTTP(w, r)
+
+	assert.JSONEq(t, `{"data":{"user_id":1}}`, w.Body.String())
+}
+
+func TestHandler_ServeHTTP_ResponseWrapper(t *testing.T) {
+	wrapper := func(data any) any {
+		return map[string]any{"result": data, "ok": true}
+	}
+
+	testCases := []struct {
+		desc    string
+		wrapper func(data any) any
+		opts    []RouteOption
+		err     error
+		body    string
+	}{
+		{"default envelope", nil, nil, nil, `{"data":"hello"}`},
+		{"response wrapper", wrapper, nil, nil, `{"result":"hello","ok":true}`},
+		{"raw response", wrapper, []RouteOption{WithRawResponse()}, nil, `"hello"`},
+		{"error is not wrapped", wrapper, nil, errTest, `{"error":{"message":"some error"},"data":"hello"}`},
+	}
+
+	for i, tc := range testCases {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+
+		h := handler{
+			container:       &container.Container{Logger: logging.NewLogger(logging.FATAL)},
+			responseWrapper: &tc.wrapper,
+			function: func(*Context) (any, error) {
+				return "hello", tc.err
+			},
+		}
+
+		for _, opt := range tc.opts {
+			opt(&h)
+		}
+
+		h.ServeHTTP(w, r)
+
+		assert.JSONEq(t, tc.body, w.Body.String(), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestHandler_ServeHTTP_Deadline(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+
+	h := handler{requestTimeout: time.Minute}
+
+	h.container = &container.Container{Logger: logging.NewLogger(logging.FATAL)}
+	h.function = func(c *Context) (any, error) {
+		deadline, ok := c.Deadline()
+
+		assert.True(t, ok, "TestHandler_ServeHTTP_Deadline Failed")
+		assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second, "TestHandler_ServeHTTP_Deadline Failed")
+
+		return "hey", nil
+	}
+
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code, "TestHandler_ServeHTTP_Deadline Failed")
+}
+
+func TestHandler_ServeHTTP_ClientClosedRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", http.NoBody).WithContext(ctx)
+
+	release := make(chan struct{})
+	defer close(release)
+
+	h := handler{requestTimeout: time.Minute}
+
+	h.container = &container.Container{Logger: logging.NewLogger(logging.FATAL)}
+	h.function = func(*Context) (any, error) {
+		cancel()
+
+		<-release
+
+		return "hey", nil
+	}
+
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, gofrHTTP.StatusClientClosedRequest, w.Code, "TestHandler_ServeHTTP_ClientClosedRequest Failed")
+}
+
 func TestHandler_ServeHTTP_Panic(t *testing.T) {
 	w := httptest.NewRecorder()
 	r := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
@@ -102,6 +211,36 @@ func TestHandler_ServeHTTP_Panic(t *testing.T) {
 	assert.Contains(t, w.Body.String(), http.StatusText(http.StatusInternalServerError), "TestHandler_ServeHTTP_Panic Failed")
 }
 
+func TestHandler_ServeHTTP_PanicLogsAndMetrics(t *testing.T) {
+	c, mocks := container.NewMockContainer(t)
+
+	router := mux.NewRouter()
+	router.Handle("/users/{id}", handler{
+		container: c,
+		function: func(*Context) (any, error) {
+			panic("runtime panic")
+		},
+	})
+
+	mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_http_panics_total", "route", "/users/{id}",
+		"method", http.MethodGet)
+	mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_panics_total", "source", "http")
+
+	w := httptest.NewRecorder()
+
+	logs := testutil.StderrOutputForFunc(func() {
+		c.Logger = logging.NewMockLogger(logging.ERROR)
+
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users/1", http.NoBody))
+	})
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.JSONEq(t, `{"error":{"message":"Internal Server Error"}}`, w.Body.String())
+	assert.Contains(t, logs, "runtime panic")
+	assert.Contains(t, logs, "/users/{id}")
+	assert.Contains(t, logs, "handler.go")
+}
+
 func TestHandler_ServeHTTP_WithHeaders(t *testing.T) {
 	testCases := []struct {
 		desc       string
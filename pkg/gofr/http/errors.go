@@ -79,6 +79,40 @@ func (ErrorMissingParam) StatusCode() int {
 	return http.StatusBadRequest
 }
 
+// ErrorValidation represents an error for a request body whose fields failed the validation of their struct tags.
+type ErrorValidation struct {
+	Fields []FieldError `json:"fields,omitempty"` // Fields contains every field which failed the validation.
+}
+
+// FieldError describes the validation failure of a field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+func (e ErrorValidation) Error() string {
+	names := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		names[i] = field.Field
+	}
+
+	return fmt.Sprintf("'%d' invalid field(s): %s", len(e.Fields), strings.Join(names, ", "))
+}
+
+func (ErrorValidation) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+func (ErrorValidation) LogLevel() logging.Level {
+	return logging.INFO
+}
+
+// Details returns the failures of the fields, which are added to the error of the response.
+func (e ErrorValidation) Details() any {
+	return e.Fields
+}
+
 // ErrorInvalidRoute represents an error for invalid route in a request.
 type ErrorInvalidRoute struct{}
 
@@ -109,6 +143,58 @@ func (ErrorRequestTimeout) LogLevel() logging.Level {
 	return logging.INFO
 }
 
+// StatusClientClosedRequest is the non-standard status code used for requests cancelled by the client.
+const StatusClientClosedRequest = 499
+
+// ErrorClientClosedRequest represents an error for request which was cancelled by the client before it completed.
+type ErrorClientClosedRequest struct{}
+
+func (ErrorClientClosedRequest) Error() string {
+	return "client closed request"
+}
+
+func (ErrorClientClosedRequest) StatusCode() int {
+	return StatusClientClosedRequest
+}
+
+func (ErrorClientClosedRequest) LogLevel() logging.Level {
+	return logging.INFO
+}
+
+// ErrorUploadLimitExceeded represents an error for upload which exceeded the size or the number of files allowed.
+type ErrorUploadLimitExceeded struct {
+	Limit string // Limit describes the exceeded limit, e.g. "10 files".
+}
+
+func (e ErrorUploadLimitExceeded) Error() string {
+	return "upload exceeds the limit of " + e.Limit
+}
+
+func (ErrorUploadLimitExceeded) StatusCode() int {
+	return http.StatusRequestEntityTooLarge
+}
+
+func (ErrorUploadLimitExceeded) LogLevel() logging.Level {
+	return logging.INFO
+}
+
+// ErrorInsufficientScope represents an error for request whose token does not grant the scope required by the route.
+type ErrorInsufficientScope struct {
+	Scope string
+}
+
+func (e ErrorInsufficientScope) Error() string {
+	return fmt.Sprintf("token does not grant the scope %s", e.Scope)
+}
+
+func (ErrorInsufficientScope) StatusCode() int {
+	return http.StatusForbidden
+}
+
+func (ErrorInsufficientScope) LogLevel() logging.Level {
+	return logging.INFO
+}
+
 // ErrorPanicRecovery represents an error for request which panicked.
 type ErrorPanicRecovery struct{}
 
@@ -132,6 +218,9 @@ var (
 	_ statusCodeResponder = ErrorMissingParam{}
 	_ statusCodeResponder = ErrorInvalidRoute{}
 	_ statusCodeResponder = ErrorRequestTimeout{}
+	_ statusCodeResponder = ErrorClientClosedRequest{}
+	_ statusCodeResponder = ErrorUploadLimitExceeded{}
+	_ statusCodeResponder = ErrorInsufficientScope{}
 	_ statusCodeResponder = ErrorPanicRecovery{}
 
 	_ logging.LogLevelResponder = ErrorEntityNotFound{}
@@ -140,5 +229,8 @@ var (
 	_ logging.LogLevelResponder = ErrorMissingParam{}
 	_ logging.LogLevelResponder = ErrorInvalidRoute{}
 	_ logging.LogLevelResponder = ErrorRequestTimeout{}
+	_ logging.LogLevelResponder = ErrorClientClosedRequest{}
+	_ logging.LogLevelResponder = ErrorUploadLimitExceeded{}
+	_ logging.LogLevelResponder = ErrorInsufficientScope{}
 	_ logging.LogLevelResponder = ErrorPanicRecovery{}
 )
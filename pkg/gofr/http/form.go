@@ -0,0 +1,52 @@
+package http
+
+import (
+	"mime/multipart"
+	"net/url"
+	"strings"
+)
+
+// parseForm parses the form fields of the body of a multipart/form-data or application/x-www-form-urlencoded
+// request, buffering up to 32 MB of the multipart files in memory and the rest on disk. The form is parsed once, and
+// is shared with Bind.
+func (r *Request) parseForm() error {
+	if strings.HasPrefix(r.req.Header.Get("Content-Type"), "multipart/form-data") {
+		return r.req.ParseMultipartForm(defaultMaxMemory)
+	}
+
+	return r.req.ParseForm()
+}
+
+// Form returns all the form fields submitted in the body of the request, e.g. to handle forms whose fields are not
+// known in advance. It returns nil if the request carries no form or it cannot be parsed.
+func (r *Request) Form() url.Values {
+	if err := r.parseForm(); err != nil {
+		return nil
+	}
+
+	return r.req.PostForm
+}
+
+// FormValues returns all the values of the form field submitted in the body of the request.
+func (r *Request) FormValues(key string) []string {
+	return r.Form()[key]
+}
+
+// FormValue returns the first value of the form field submitted in the body of the request, or an empty string if
+// it is not set.
+func (r *Request) FormValue(key string) string {
+	if values := r.FormValues(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// FormFiles returns the headers of the files uploaded in the multipart form field, which are opened using Open.
+func (r *Request) FormFiles(key string) []*multipart.FileHeader {
+	if err := r.parseForm(); err != nil || r.req.MultipartForm == nil {
+		return nil
+	}
+
+	return r.req.MultipartForm.File[key]
+}
@@ -0,0 +1,81 @@
+package http
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequest_FormMultipart(t *testing.T) {
+	var body bytes.Buffer
+
+	writer := multipart.NewWriter(&body)
+
+	require.NoError(t, writer.WriteField("name", "gofr"))
+	require.NoError(t, writer.WriteField("tag", "go"))
+	require.NoError(t, writer.WriteField("tag", "framework"))
+
+	for _, name := range []string{"a.txt", "b.txt"} {
+		part, err := writer.CreateFormFile("attachments", name)
+		require.NoError(t, err)
+
+		_, err = part.Write([]byte("content of " + name))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/upload?name=query", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	r := NewRequest(req)
+
+	assert.Equal(t, url.Values{"name": {"gofr"}, "tag": {"go", "framework"}}, r.Form())
+	assert.Equal(t, "gofr", r.FormValue("name"), "the query parameters should not be form fields")
+	assert.Equal(t, []string{"go", "framework"}, r.FormValues("tag"))
+	assert.Empty(t, r.FormValue("missing"))
+	assert.Nil(t, r.FormValues("missing"))
+
+	files := r.FormFiles("attachments")
+	require.Len(t, files, 2)
+	assert.Equal(t, "b.txt", files[1].Filename)
+
+	file, err := files[1].Open()
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	require.NoError(t, err)
+	assert.Equal(t, "content of b.txt", string(content))
+}
+
+func TestRequest_FormURLEncoded(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/submit", strings.NewReader("name=gofr&tag=go&tag=framework"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	r := NewRequest(req)
+
+	assert.Equal(t, "gofr", r.FormValue("name"))
+	assert.Equal(t, []string{"go", "framework"}, r.FormValues("tag"))
+	assert.Nil(t, r.FormFiles("tag"))
+}
+
+func TestRequest_FormInvalid(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader("invalid"))
+	req.Header.Set("Content-Type", "multipart/form-data")
+
+	r := NewRequest(req)
+
+	assert.Nil(t, r.Form())
+	assert.Empty(t, r.FormValue("name"))
+	assert.Nil(t, r.FormFiles("file"))
+}
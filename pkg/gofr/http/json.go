@@ -0,0 +1,441 @@
+package http
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// NamingStrategy is the naming of the JSON keys of the struct fields which are not named by a json tag.
+type NamingStrategy int
+
+const (
+	// DefaultNaming names the keys by the names of the fields, as encoding/json does.
+	DefaultNaming NamingStrategy = iota
+	// SnakeCase names the keys in snake case, e.g. the field UserID is encoded as user_id.
+	SnakeCase
+	// CamelCase names the keys in camel case, e.g. the field UserID is encoded as userID.
+	CamelCase
+)
+
+// JSONConfig configures the encoding of the JSON responses. The fields named by a json tag keep the name and the
+// omitempty and string options of their tag, and the keys of the maps are not renamed.
+type JSONConfig struct {
+	// NamingStrategy names the keys of the fields which are not named by a json tag.
+	NamingStrategy NamingStrategy
+	// OmitEmpty omits the fields which are not named by a json tag when they are empty, i.e. false, 0, nil or an
+	// empty string, slice or map, as if they were tagged with omitempty.
+	OmitEmpty bool
+}
+
+var (
+	marshalerType     = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// isDefault returns true if the config encodes the values as encoding/json does, so that they need no conversion.
+func (c *JSONConfig) isDefault() bool {
+	return c == nil || (c.NamingStrategy == DefaultNaming && !c.OmitEmpty)
+}
+
+// visit is a pointer, map or slice being converted, which must not be reached again while converting its elements.
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+// cycle replaces a value referencing itself, whose encoding fails as it does with encoding/json instead of
+// recursing forever.
+type cycle struct {
+	value reflect.Value
+}
+
+func (c cycle) MarshalJSON() ([]byte, error) {
+	return nil, &json.UnsupportedValueError{Value: c.value, Str: fmt.Sprintf("encountered a cycle via %s", c.value.Type())}
+}
+
+// convert returns a value which is encoded by encoding/json following the config, where the structs are replaced by
+// the ordered list of their fields.
+func (c *JSONConfig) convert(v reflect.Value) any {
+	return c.convertValue(v, make(map[visit]struct{}))
+}
+
+func (c *JSONConfig) convertValue(v reflect.Value, visited map[visit]struct{}) any {
+	if !v.IsValid() {
+		return nil
+	}
+
+	if v.Type().Implements(marshalerType) || v.Type().Implements(textMarshalerType) {
+		return interfaceOf(v)
+	}
+
+	switch v.Kind() { //nolint:exhaustive // only these kinds can reference themselves
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+
+		key := visit{ptr: v.Pointer(), typ: v.Type()}
+		if v.Kind() == reflect.Slice {
+			key.len = v.Len()
+		}
+
+		if _, ok := visited[key]; ok {
+			return cycle{value: v}
+		}
+
+		visited[key] = struct{}{}
+		defer delete(visited, key)
+	}
+
+	switch v.Kind() { //nolint:exhaustive // the other kinds are encoded as they are
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		return c.convertValue(v.Elem(), visited)
+	case reflect.Struct:
+		return c.object(v, visited)
+	case reflect.Map:
+
+		m := reflect.MakeMapWithSize(reflect.MapOf(v.Type().Key(), reflect.TypeOf((*any)(nil)).Elem()), v.Len())
+
+		iter := v.MapRange()
+		for iter.Next() {
+			value := reflect.ValueOf(c.convertValue(iter.Value(), visited))
+			if !value.IsValid() {
+				value = reflect.Zero(m.Type().Elem())
+			}
+
+			// the keys of the maps of unexported embedded structs cannot be set as they are
+			m.SetMapIndex(reflect.ValueOf(interfaceOf(iter.Key())).Convert(m.Type().Key()), value)
+		}
+
+		return m.Interface()
+	case reflect.Slice:
+		// byte slices are encoded as base64 strings
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return interfaceOf(v)
+		}
+
+		fallthrough
+	case reflect.Array:
+		values := make([]any, v.Len())
+		for i := range values {
+			values[i] = c.convertValue(v.Index(i), visited)
+		}
+
+		return values
+	default:
+		return interfaceOf(v)
+	}
+}
+
+// jsonField is a field of a struct, at the depth of the embedded struct it belongs to.
+type jsonField struct {
+	name     string
+	value    reflect.Value
+	depth    int
+	tagged   bool
+	quoted   bool
+	position int
+}
+
+// jsonObject is a struct whose fields are encoded in the order they are declared.
+type jsonObject struct {
+	names  []string
+	values []any
+}
+
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+
+	for i, name := range o.names {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(o.values[i])
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func (c *JSONConfig) object(v reflect.Value, visited map[visit]struct{}) jsonObject {
+	fields := c.fields(v, 0, nil)
+
+	// like encoding/json, a name belongs to the shallowest field, preferring the tagged ones, and the fields whose
+	// name is ambiguous are dropped
+	byName := make(map[string][]jsonField, len(fields))
+	for _, f := range fields {
+		byName[f.name] = append(byName[f.name], f)
+	}
+
+	var obj jsonObject
+
+	for _, f := range fields {
+		if dominant, ok := dominantField(byName[f.name]); ok && dominant.position == f.position {
+			obj.names = append(obj.names, f.name)
+
+			if f.quoted {
+				obj.values = append(obj.values, c.quoted(f.value, visited))
+			} else {
+				obj.values = append(obj.values, c.convertValue(f.value, visited))
+			}
+		}
+	}
+
+	return obj
+}
+
+// fields returns the fields of the struct to encode, including the ones of the embedded structs, in the order they
+// are declared.
+func (c *JSONConfig) fields(v reflect.Value, depth int, fields []jsonField) []jsonField {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		value := v.Field(i)
+
+		if sf.Anonymous && name == "" {
+			if embedded, ok := embeddedStruct(sf, value); ok {
+				if embedded.IsValid() {
+					fields = c.fields(embedded, depth+1, fields)
+				}
+
+				continue
+			}
+		}
+
+		if !sf.IsExported() {
+			continue
+		}
+
+		omitEmpty := strings.Contains(","+opts+",", ",omitempty,")
+		quoted := strings.Contains(","+opts+",", ",string,")
+		tagged := name != ""
+
+		if !tagged {
+			name = c.NamingStrategy.name(sf.Name)
+			omitEmpty = omitEmpty || c.OmitEmpty
+		}
+
+		if omitEmpty && isEmptyValue(value) {
+			continue
+		}
+
+		fields = append(fields, jsonField{name: name, value: value, depth: depth, tagged: tagged, quoted: quoted,
+			position: len(fields)})
+	}
+
+	return fields
+}
+
+// quoted returns the value of a field tagged with the string option, which is encoded inside a JSON string as
+// encoding/json does for the booleans, numbers and strings. The values of the other types ignore the option.
+func (c *JSONConfig) quoted(v reflect.Value, visited map[visit]struct{}) any {
+	if v.Kind() == reflect.Pointer && v.Type().Name() == "" {
+		if v.IsNil() {
+			return nil
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Type().Implements(marshalerType) || v.Type().Implements(textMarshalerType) {
+		return c.convertValue(v, visited)
+	}
+
+	switch v.Kind() { //nolint:exhaustive // the option only applies to these kinds
+	case reflect.Bool, reflect.String, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		b, err := json.Marshal(interfaceOf(v))
+		if err != nil {
+			// the encoding of the response reports the error, e.g. of a NaN float
+			return interfaceOf(v)
+		}
+
+		return string(b)
+	default:
+		return c.convertValue(v, visited)
+	}
+}
+
+// embeddedStruct returns the struct embedded by the field, which is invalid if it is embedded by a nil pointer, and
+// false if the field does not embed a struct.
+func embeddedStruct(sf reflect.StructField, value reflect.Value) (reflect.Value, bool) {
+	t := sf.Type
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return reflect.Value{}, true
+		}
+
+		value = value.Elem()
+	}
+
+	return value, true
+}
+
+func dominantField(fields []jsonField) (jsonField, bool) {
+	var candidates []jsonField
+
+	for _, f := range fields {
+		switch {
+		case len(candidates) == 0 || f.depth < candidates[0].depth:
+			candidates = []jsonField{f}
+		case f.depth == candidates[0].depth:
+			candidates = append(candidates, f)
+		}
+	}
+
+	var tagged []jsonField
+
+	for _, f := range candidates {
+		if f.tagged {
+			tagged = append(tagged, f)
+		}
+	}
+
+	switch {
+	case len(tagged) == 1:
+		return tagged[0], true
+	case len(candidates) == 1:
+		return candidates[0], true
+	default:
+		return jsonField{}, false
+	}
+}
+
+func (n NamingStrategy) name(field string) string {
+	switch n {
+	case SnakeCase:
+		return snakeCase(field)
+	case CamelCase:
+		return camelCase(field)
+	default:
+		return field
+	}
+}
+
+// snakeCase splits the words of the name at the changes of case, keeping the initialisms together, e.g. HTTPServer
+// becomes http_server.
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				b.WriteByte('_')
+			}
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
+
+// camelCase lower cases the leading word of the name, e.g. HTTPServer becomes httpServer.
+func camelCase(name string) string {
+	runes := []rune(name)
+
+	for i, r := range runes {
+		if !unicode.IsUpper(r) {
+			break
+		}
+
+		// the last upper case letter of an initialism followed by a word starts the word
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+
+		runes[i] = unicode.ToLower(r)
+	}
+
+	return string(runes)
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() { //nolint:exhaustive // the other kinds are never empty, as in encoding/json
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// interfaceOf returns the value as an interface. The values of the fields of unexported embedded structs cannot be
+// accessed using Interface, so their basic values are copied instead.
+func interfaceOf(v reflect.Value) any {
+	if v.CanInterface() {
+		return v.Interface()
+	}
+
+	switch v.Kind() { //nolint:exhaustive // only the basic values can be copied
+	case reflect.Bool:
+		return reflect.ValueOf(v.Bool()).Convert(v.Type()).Interface()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.ValueOf(v.Int()).Convert(v.Type()).Interface()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflect.ValueOf(v.Uint()).Convert(v.Type()).Interface()
+	case reflect.Float32, reflect.Float64:
+		return reflect.ValueOf(v.Float()).Convert(v.Type()).Interface()
+	case reflect.String:
+		return reflect.ValueOf(v.String()).Convert(v.Type()).Interface()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,157 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type jsonAddress struct {
+	StreetName string
+	ZipCode    string `json:"zip"`
+}
+
+type jsonAudit struct {
+	CreatedAt time.Time
+	UpdatedBy string
+}
+
+type jsonUser struct {
+	UserID     int
+	HTTPServer string
+	Nickname   string `json:"nickname"`
+	Admin      bool   `json:",omitempty"`
+	Address    *jsonAddress
+	Tags       []string
+	Secret     string `json:"-"`
+	Labels     map[string]jsonAddress
+	internal   string
+	jsonAudit
+}
+
+func TestResponder_JSONConfig(t *testing.T) {
+	user := jsonUser{
+		UserID:     1,
+		HTTPServer: "gofr",
+		Address:    &jsonAddress{StreetName: "Main"},
+		Labels:     map[string]jsonAddress{"HomeAddress": {StreetName: "Home"}},
+		Secret:     "secret",
+		internal:   "internal",
+		jsonAudit:  jsonAudit{CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+	}
+
+	tests := []struct {
+		desc     string
+		config   *JSONConfig
+		expected string
+	}{
+		{"no config", nil, `{"data":{"UserID":1,"HTTPServer":"gofr","nickname":"","Address":{"StreetName":"Main","zip":""},` +
+			`"Tags":null,"Labels":{"HomeAddress":{"StreetName":"Home","zip":""}},"CreatedAt":"2024-01-02T03:04:05Z","UpdatedBy":""}}`},
+		{"snake case", &JSONConfig{NamingStrategy: SnakeCase}, `{"data":{"user_id":1,"http_server":"gofr","nickname":"",` +
+			`"address":{"street_name":"Main","zip":""},"tags":null,"labels":{"HomeAddress":{"street_name":"Home","zip":""}},` +
+			`"created_at":"2024-01-02T03:04:05Z","updated_by":""}}`},
+		{"camel case with omit empty", &JSONConfig{NamingStrategy: CamelCase, OmitEmpty: true}, `{"data":{"userID":1,` +
+			`"httpServer":"gofr","nickname":"","address":{"streetName":"Main","zip":""},"labels":{"HomeAddress":` +
+			`{"streetName":"Home","zip":""}},"createdAt":"2024-01-02T03:04:05Z"}}`},
+	}
+
+	for i, tc := range tests {
+		recorder := httptest.NewRecorder()
+
+		NewResponder(recorder, http.MethodGet).WithJSONConfig(tc.config).Respond(user, nil)
+
+		assert.JSONEq(t, tc.expected, recorder.Body.String(), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestResponder_JSONConfigError(t *testing.T) {
+	recorder := httptest.NewRecorder()
+
+	NewResponder(recorder, http.MethodGet).WithJSONConfig(&JSONConfig{NamingStrategy: SnakeCase}).
+		Respond(nil, ErrorEntityNotFound{Name: "id", Value: "1"})
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.JSONEq(t, `{"error":{"message":"No entity found with id: 1"}}`, recorder.Body.String())
+}
+
+func TestNamingStrategy(t *testing.T) {
+	tests := []struct {
+		field, snake, camel string
+	}{
+		{"ID", "id", "id"},
+		{"UserID", "user_id", "userID"},
+		{"HTTPServer", "http_server", "httpServer"},
+		{"Address2Line", "address2_line", "address2Line"},
+		{"name", "name", "name"},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.snake, SnakeCase.name(tc.field), "TEST[%d], Failed.\n%s", i, tc.field)
+		assert.Equal(t, tc.camel, CamelCase.name(tc.field), "TEST[%d], Failed.\n%s", i, tc.field)
+	}
+}
+
+type jsonQuoted struct {
+	ID      int64   `json:"id,string"`
+	Price   float64 `json:",string"`
+	Active  bool    `json:"active,string,omitempty"`
+	Name    string  `json:"name,string"`
+	Count   *int    `json:"count,string"`
+	Missing *int    `json:"missing,string"`
+	Tags    []int   `json:"tags,string"`
+}
+
+func TestResponder_JSONConfigStringOption(t *testing.T) {
+	count := 2
+	value := jsonQuoted{ID: 1, Price: 1.5, Active: true, Name: "gofr", Count: &count, Tags: []int{1}}
+
+	recorder := httptest.NewRecorder()
+
+	NewResponder(recorder, http.MethodGet).WithJSONConfig(&JSONConfig{OmitEmpty: true}).Respond(value, nil)
+
+	expected, err := json.Marshal(map[string]any{"data": value})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"data":{"id":"1","Price":"1.5","active":"true","name":"\"gofr\"","count":"2","missing":null,`+
+		`"tags":[1]}}`, recorder.Body.String())
+	assert.JSONEq(t, string(expected), recorder.Body.String())
+}
+
+type jsonNode struct {
+	Name string
+	Next *jsonNode
+}
+
+func TestResponder_JSONConfigCycle(t *testing.T) {
+	shared := &jsonAddress{StreetName: "Main"}
+
+	recorder := httptest.NewRecorder()
+
+	NewResponder(recorder, http.MethodGet).WithJSONConfig(&JSONConfig{NamingStrategy: SnakeCase}).
+		Respond([]*jsonAddress{shared, shared}, nil)
+
+	assert.JSONEq(t, `{"data":[{"street_name":"Main","zip":""},{"street_name":"Main","zip":""}]}`, recorder.Body.String(),
+		"a value referenced twice is not a cycle")
+
+	node := &jsonNode{Name: "first"}
+	node.Next = &jsonNode{Name: "second", Next: node}
+
+	_, err := json.Marshal((&JSONConfig{NamingStrategy: SnakeCase}).convert(reflect.ValueOf(node)))
+
+	var unsupported *json.UnsupportedValueError
+
+	require.ErrorAs(t, err, &unsupported)
+	assert.Contains(t, unsupported.Str, "encountered a cycle via *http.jsonNode")
+
+	recorder = httptest.NewRecorder()
+
+	NewResponder(recorder, http.MethodGet).WithJSONConfig(&JSONConfig{NamingStrategy: SnakeCase}).Respond(node, nil)
+
+	assert.Empty(t, recorder.Body.String())
+}
@@ -0,0 +1,194 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultMaxBodyBytes = 4096
+	redacted            = "[REDACTED]"
+)
+
+// defaultRedactedHeaders are the headers always redacted from the access logs, as they carry credentials.
+//
+//nolint:gochecknoglobals // the list is not modified
+var defaultRedactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+
+// AccessLogConfig configures the AccessLog middleware.
+type AccessLogConfig struct {
+	// IncludeRequestBody adds the request body to the log.
+	IncludeRequestBody bool
+	// IncludeResponseBody adds the response body to the log.
+	IncludeResponseBody bool
+	// MaxBodyBytes is the number of bytes of a body which are logged, longer bodies are truncated. Defaults to 4096.
+	MaxBodyBytes int
+	// RedactHeaders are the headers whose values are not logged, in addition to Authorization, Proxy-Authorization,
+	// Cookie, Set-Cookie and X-Api-Key.
+	RedactHeaders []string
+}
+
+// AccessLogEntry represents the log entry of a request written by the AccessLog middleware.
+type AccessLogEntry struct {
+	TraceID         string            `json:"trace_id,omitempty"`
+	Method          string            `json:"method,omitempty"`
+	URI             string            `json:"uri,omitempty"`
+	Response        int               `json:"response,omitempty"`
+	ResponseTime    int64             `json:"response_time,omitempty"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	RequestBody     string            `json:"request_body,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	ResponseBody    string            `json:"response_body,omitempty"`
+	Truncated       bool              `json:"truncated,omitempty"`
+}
+
+// AccessLog is a middleware which logs the headers and, if configured, the bodies of every request and its
+// response in one log entry, correlated with the trace of the request. The request body is read before the
+// handler is called and is still available to it.
+func AccessLog(config AccessLogConfig, logger logger) func(inner http.Handler) http.Handler {
+	maxBytes := config.MaxBodyBytes
+	if maxBytes <= 0 {
+		maxBytes = defaultMaxBodyBytes
+	}
+
+	redact := make(map[string]bool, len(defaultRedactedHeaders)+len(config.RedactHeaders))
+
+	for _, h := range slices.Concat(defaultRedactedHeaders, config.RedactHeaders) {
+		redact[http.CanonicalHeaderKey(h)] = true
+	}
+
+	return func(inner http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			l := &AccessLogEntry{
+				TraceID:        trace.SpanFromContext(r.Context()).SpanContext().TraceID().String(),
+				Method:         r.Method,
+				URI:            r.RequestURI,
+				RequestHeaders: headersToLog(r.Header, redact),
+			}
+
+			if config.IncludeRequestBody && r.Body != nil {
+				body, truncated := peekBody(r, maxBytes)
+
+				l.RequestBody = body
+				l.Truncated = truncated
+			}
+
+			bw := &bodyCaptureWriter{ResponseWriter: w, capture: config.IncludeResponseBody, limit: maxBytes}
+
+			defer func() {
+				l.Response = bw.status
+				l.ResponseTime = time.Since(start).Nanoseconds() / 1000
+				l.ResponseHeaders = headersToLog(w.Header(), redact)
+
+				if config.IncludeResponseBody {
+					l.ResponseBody = bw.body.String()
+					l.Truncated = l.Truncated || bw.truncated
+				}
+
+				logger.Log(l)
+			}()
+
+			inner.ServeHTTP(bw, r)
+		})
+	}
+}
+
+// peekBody reads up to limit bytes of the request body, and replaces the body with one which returns
+// the bytes read followed by the rest of the original body.
+func peekBody(r *http.Request, limit int) (body string, truncated bool) {
+	buf, err := io.ReadAll(io.LimitReader(r.Body, int64(limit)+1))
+
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(buf), errReader{err}, r.Body), r.Body}
+
+	if len(buf) > limit {
+		return string(buf[:limit]), true
+	}
+
+	return string(buf), false
+}
+
+// errReader returns the error which occurred while peeking the body, so that the handler gets it as well.
+type errReader struct {
+	err error
+}
+
+func (e errReader) Read([]byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+
+	return 0, io.EOF
+}
+
+func headersToLog(header http.Header, redact map[string]bool) map[string]string {
+	if len(header) == 0 {
+		return nil
+	}
+
+	headers := make(map[string]string, len(header))
+
+	for key, values := range header {
+		if redact[http.CanonicalHeaderKey(key)] {
+			headers[key] = redacted
+
+			continue
+		}
+
+		headers[key] = strings.Join(values, ",")
+	}
+
+	return headers
+}
+
+// bodyCaptureWriter records the status and up to limit bytes of the body written to the response.
+type bodyCaptureWriter struct {
+	http.ResponseWriter
+
+	status    int
+	capture   bool
+	limit     int
+	body      bytes.Buffer
+	truncated bool
+}
+
+func (w *bodyCaptureWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *bodyCaptureWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	if w.capture {
+		remaining := w.limit - w.body.Len()
+
+		if len(b) > remaining {
+			w.truncated = true
+		}
+
+		w.body.Write(b[:min(len(b), max(remaining, 0))])
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the original writer, so that http.ResponseController can flush or hijack the connection.
+func (w *bodyCaptureWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
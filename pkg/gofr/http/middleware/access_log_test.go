@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type entryLogger struct {
+	entries []any
+}
+
+func (l *entryLogger) Log(args ...any) {
+	l.entries = append(l.entries, args...)
+}
+
+func (l *entryLogger) Error(args ...any) {
+	l.entries = append(l.entries, args...)
+}
+
+func echoHandler(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	w.Header().Set("Set-Cookie", "session=secret")
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(body)
+}
+
+func TestAccessLog(t *testing.T) {
+	tests := []struct {
+		desc         string
+		config       AccessLogConfig
+		body         string
+		requestBody  string
+		responseBody string
+		truncated    bool
+	}{
+		{"bodies not included", AccessLogConfig{}, "hello", "", "", false},
+		{"bodies included", AccessLogConfig{IncludeRequestBody: true, IncludeResponseBody: true},
+			"hello", "hello", "hello", false},
+		{"bodies truncated", AccessLogConfig{IncludeRequestBody: true, IncludeResponseBody: true, MaxBodyBytes: 3},
+			"hello", "hel", "hel", true},
+		{"body of the limit size", AccessLogConfig{IncludeRequestBody: true, MaxBodyBytes: 5},
+			"hello", "hello", "", false},
+	}
+
+	for i, tc := range tests {
+		l := &entryLogger{}
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/echo", strings.NewReader(tc.body))
+
+		AccessLog(tc.config, l)(http.HandlerFunc(echoHandler)).ServeHTTP(rr, req)
+
+		assert.Equal(t, tc.body, rr.Body.String(), "TEST[%d], Failed.\n%s", i, tc.desc)
+		require.Len(t, l.entries, 1, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		entry := l.entries[0].(*AccessLogEntry)
+
+		assert.Equal(t, http.StatusCreated, entry.Response, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, "/echo", entry.URI, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.requestBody, entry.RequestBody, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.responseBody, entry.ResponseBody, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.truncated, entry.Truncated, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestAccessLog_RedactHeaders(t *testing.T) {
+	l := &entryLogger{}
+	req := httptest.NewRequest(http.MethodGet, "/echo", http.NoBody)
+
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set("X-Tenant-Secret", "tenant")
+	req.Header.Set("Accept", "application/json")
+
+	AccessLog(AccessLogConfig{RedactHeaders: []string{"x-tenant-secret"}}, l)(http.HandlerFunc(echoHandler)).
+		ServeHTTP(httptest.NewRecorder(), req)
+
+	entry := l.entries[0].(*AccessLogEntry)
+
+	assert.Equal(t, map[string]string{
+		"Authorization":   redacted,
+		"X-Tenant-Secret": redacted,
+		"Accept":          "application/json",
+	}, entry.RequestHeaders)
+	assert.Equal(t, redacted, entry.ResponseHeaders["Set-Cookie"])
+}
@@ -0,0 +1,154 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+var (
+	errInvalidTrustedProxy = errors.New("invalid trusted proxy")
+	errInvalidNetwork      = errors.New("invalid IP or CIDR")
+)
+
+type clientIPKey struct{}
+
+// TrustedProxies holds the networks of the proxies whose X-Forwarded-For and X-Real-IP headers are trusted by the
+// ClientIP middleware. It is safe to set the networks while requests are served.
+type TrustedProxies struct {
+	networks atomic.Pointer[[]*net.IPNet]
+}
+
+// Set replaces the trusted networks with the ones of the CIDRs, e.g. 10.0.0.0/8, or single IPs. The networks are
+// not changed when any of them is invalid.
+func (t *TrustedProxies) Set(cidrs ...string) error {
+	networks, err := ParseNetworks(cidrs...)
+	if err != nil {
+		return fmt.Errorf("%w, %w", errInvalidTrustedProxy, err)
+	}
+
+	t.networks.Store(&networks)
+
+	return nil
+}
+
+// ParseNetworks returns the networks of the CIDRs, e.g. 10.0.0.0/8, or single IPs, e.g. 10.0.0.1, skipping the
+// empty ones. It returns an error for the first value which is neither.
+func ParseNetworks(cidrs ...string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+
+		if !strings.Contains(cidr, "/") {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, fmt.Errorf("%w: %s", errInvalidNetwork, cidr)
+			}
+
+			bits := 8 * len(ip.To16())
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errInvalidNetwork, cidr)
+		}
+
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
+
+func (t *TrustedProxies) contains(ip net.IP) bool {
+	if t == nil || ip == nil {
+		return false
+	}
+
+	networks := t.networks.Load()
+	if networks == nil {
+		return false
+	}
+
+	for _, network := range *networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClientIP is a middleware which resolves the IP of the client of the request, which is stored in its context and
+// returned by ClientIPFromContext. The X-Forwarded-For and X-Real-IP headers are only used when the request is
+// received from one of the trusted proxies, as any client can set them, and the IP of the peer is used otherwise.
+func ClientIP(proxies *TrustedProxies) func(inner http.Handler) http.Handler {
+	return func(inner http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), clientIPKey{}, resolveClientIP(r, proxies))
+
+			inner.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// ClientIPFromContext returns the IP of the client resolved by the ClientIP middleware, or an empty string if ctx
+// does not belong to an HTTP request.
+func ClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+
+	return ip
+}
+
+func resolveClientIP(r *http.Request, proxies *TrustedProxies) string {
+	peer := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(peer); err == nil {
+		peer = host
+	}
+
+	if !proxies.contains(net.ParseIP(peer)) {
+		return peer
+	}
+
+	// every proxy appends the address it received the request from, so the addresses are walked from the closest one,
+	// and the first one which is not a trusted proxy is the client. The addresses before it may be spoofed.
+	if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		hops := strings.Split(strings.Join(forwarded, ","), ",")
+		client := peer
+
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				break
+			}
+
+			client = ip.String()
+
+			if !proxies.contains(ip) {
+				break
+			}
+		}
+
+		return client
+	}
+
+	if ip := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); ip != nil {
+		return ip.String()
+	}
+
+	return peer
+}
@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientIP(t *testing.T) {
+	proxies := &TrustedProxies{}
+	require.NoError(t, proxies.Set("10.0.0.0/8", " 192.168.1.1", "", "fd00::/8"))
+
+	tests := []struct {
+		desc       string
+		remoteAddr string
+		forwarded  []string
+		realIP     string
+		clientIP   string
+	}{
+		{"direct request", "203.0.113.7:5000", nil, "", "203.0.113.7"},
+		{"forwarded headers from untrusted peer", "203.0.113.7:5000", []string{"198.51.100.1"}, "198.51.100.2", "203.0.113.7"},
+		{"forwarded for from trusted proxy", "10.0.0.1:5000", []string{"198.51.100.1"}, "", "198.51.100.1"},
+		{"chain of trusted proxies", "10.0.0.1:5000", []string{"198.51.100.1, 192.168.1.1", "10.0.0.2"}, "", "198.51.100.1"},
+		{"spoofed entries before the client", "10.0.0.1:5000", []string{"1.2.3.4, 198.51.100.1, 10.0.0.2"}, "", "198.51.100.1"},
+		{"only trusted proxies", "10.0.0.1:5000", []string{"10.0.0.3, 10.0.0.2"}, "", "10.0.0.3"},
+		{"invalid entry", "10.0.0.1:5000", []string{"unknown, 10.0.0.2"}, "", "10.0.0.2"},
+		{"real ip from trusted proxy", "192.168.1.1:5000", nil, "198.51.100.2", "198.51.100.2"},
+		{"invalid real ip", "192.168.1.1:5000", nil, "unknown", "192.168.1.1"},
+		{"ipv6 trusted proxy", "[fd00::1]:5000", []string{"2001:db8::1"}, "", "2001:db8::1"},
+		{"remote address without port", "203.0.113.7", nil, "", "203.0.113.7"},
+	}
+
+	for i, tc := range tests {
+		var clientIP string
+
+		handler := ClientIP(proxies)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			clientIP = ClientIPFromContext(r.Context())
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		req.RemoteAddr = tc.remoteAddr
+
+		for _, value := range tc.forwarded {
+			req.Header.Add("X-Forwarded-For", value)
+		}
+
+		if tc.realIP != "" {
+			req.Header.Set("X-Real-IP", tc.realIP)
+		}
+
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Equal(t, tc.clientIP, clientIP, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestTrustedProxies_Set(t *testing.T) {
+	proxies := &TrustedProxies{}
+	require.NoError(t, proxies.Set("10.0.0.0/8"))
+
+	err := proxies.Set("192.168.0.0/16", "10.0.0.0/33")
+	require.ErrorIs(t, err, errInvalidTrustedProxy)
+
+	err = proxies.Set("proxy.local")
+	require.ErrorIs(t, err, errInvalidTrustedProxy)
+
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	req.RemoteAddr = "10.0.0.1:5000"
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+
+	assert.Equal(t, "198.51.100.1", resolveClientIP(req, proxies), "the networks should not change on error")
+	assert.Equal(t, "10.0.0.1", resolveClientIP(req, nil), "no proxy should be trusted without networks")
+}
@@ -1,15 +1,20 @@
 package middleware
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"runtime/debug"
 	"strings"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel/trace"
+
+	"gofr.dev/pkg/gofr/http/requestid"
 )
 
 // StatusResponseWriter Defines own Response Writer to be used for logging of status - as http.ResponseWriter does not let us read status.
@@ -23,10 +28,16 @@ func (w *StatusResponseWriter) WriteHeader(status int) {
 	w.ResponseWriter.WriteHeader(status)
 }
 
+// Unwrap returns the underlying http.ResponseWriter, so that http.ResponseController can flush streamed responses.
+func (w *StatusResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 // RequestLog represents a log entry for HTTP requests.
 type RequestLog struct {
 	TraceID      string `json:"trace_id,omitempty"`
 	SpanID       string `json:"span_id,omitempty"`
+	RequestID    string `json:"request_id,omitempty"`
 	StartTime    string `json:"start_time,omitempty"`
 	ResponseTime int64  `json:"response_time,omitempty"`
 	Method       string `json:"method,omitempty"`
@@ -34,6 +45,37 @@ type RequestLog struct {
 	IP           string `json:"ip,omitempty"`
 	URI          string `json:"uri,omitempty"`
 	Response     int    `json:"response,omitempty"`
+	// Fields are the fields added to the logs of the request using AddLogFields.
+	Fields map[string]any `json:"fields,omitempty"`
+}
+
+type logFieldsKey struct{}
+
+// logFields holds the fields added to the logs of a request, which are logged with the request log as well.
+type logFields struct {
+	mu     sync.Mutex
+	fields map[string]any
+}
+
+// AddLogFields adds the fields to the request log of the request of ctx, and returns all the fields added for
+// the request. The fields are returned as is if ctx does not belong to a request logged by the Logging middleware.
+func AddLogFields(ctx context.Context, fields map[string]any) map[string]any {
+	lf, ok := ctx.Value(logFieldsKey{}).(*logFields)
+	if !ok {
+		return fields
+	}
+
+	lf.mu.Lock()
+	defer lf.mu.Unlock()
+
+	merged := make(map[string]any, len(lf.fields)+len(fields))
+
+	maps.Copy(merged, lf.fields)
+	maps.Copy(merged, fields)
+
+	lf.fields = merged
+
+	return merged
 }
 
 func (rl *RequestLog) PrettyPrint(writer io.Writer) {
@@ -76,10 +118,14 @@ func Logging(logger logger) func(inner http.Handler) http.Handler {
 
 			srw.Header().Set("X-Correlation-ID", traceID)
 
+			fields := &logFields{}
+			r = r.WithContext(context.WithValue(r.Context(), logFieldsKey{}, fields))
+
 			defer func(res *StatusResponseWriter, req *http.Request) {
 				l := &RequestLog{
 					TraceID:      traceID,
 					SpanID:       spanID,
+					RequestID:    requestid.FromContext(req.Context()),
 					StartTime:    start.Format("2006-01-02T15:04:05.999999999-07:00"),
 					ResponseTime: time.Since(start).Nanoseconds() / 1000,
 					Method:       req.Method,
@@ -89,6 +135,10 @@ func Logging(logger logger) func(inner http.Handler) http.Handler {
 					Response:     res.status,
 				}
 
+				fields.mu.Lock()
+				l.Fields = fields.fields
+				fields.mu.Unlock()
+
 				if logger != nil {
 					if res.status >= http.StatusInternalServerError {
 						logger.Error(l)
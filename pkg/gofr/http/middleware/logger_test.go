@@ -178,3 +178,43 @@ func Test_ColorForStatusCode(t *testing.T) {
 		assert.Equal(t, tc.expOut, out)
 	}
 }
+
+func Test_LoggingMiddlewareFields(t *testing.T) {
+	l := &entryLogger{}
+	req := httptest.NewRequest(http.MethodGet, "/dummy", http.NoBody)
+
+	handler := Logging(l)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		AddLogFields(r.Context(), map[string]any{"user_id": 42})
+		fields := AddLogFields(r.Context(), map[string]any{"tenant": "acme"})
+
+		assert.Equal(t, map[string]any{"user_id": 42, "tenant": "acme"}, fields)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, l.entries, 1)
+	assert.Equal(t, map[string]any{"user_id": 42, "tenant": "acme"}, l.entries[0].(*RequestLog).Fields)
+}
+
+func Test_LoggingMiddlewareRequestID(t *testing.T) {
+	l := &entryLogger{}
+	req := httptest.NewRequest(http.MethodGet, "/dummy", http.NoBody)
+	req.Header.Set("X-Request-Id", "req-1")
+
+	handler := RequestID(Logging(l)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, l.entries, 1)
+	assert.Equal(t, "req-1", l.entries[0].(*RequestLog).RequestID)
+}
+
+func TestAddLogFields_WithoutRequestLog(t *testing.T) {
+	fields := map[string]any{"user_id": 42}
+
+	assert.Equal(t, fields, AddLogFields(context.Background(), fields))
+}
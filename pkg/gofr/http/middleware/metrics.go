@@ -26,25 +26,14 @@ func Metrics(metrics metrics) func(inner http.Handler) http.Handler {
 
 			srw := &StatusResponseWriter{ResponseWriter: w}
 
-			path, _ := mux.CurrentRoute(r).GetPathTemplate()
-
-			ext := strings.ToLower(filepath.Ext(r.URL.Path))
-			switch ext {
-			case ".css", ".js", ".png", ".jpg", ".jpeg", ".gif", ".ico", ".svg", ".txt", ".html", ".json", ".woff", ".woff2", ".ttf", ".eot", ".pdf":
-				path = r.URL.Path
-			}
-
-			if path == "/" || strings.HasPrefix(path, "/static") {
-				path = r.URL.Path
-			}
-
-			path = strings.TrimSuffix(path, "/")
+			path := metricsPath(r)
 
 			// this has to be called in the end so that status code is populated
 			defer func(res *StatusResponseWriter, req *http.Request) {
 				duration := time.Since(start)
 
-				metrics.RecordHistogram(context.Background(), "app_http_response", duration.Seconds(),
+				// the context of the request carries its span, which is recorded as the exemplar of the measurement
+				metrics.RecordHistogram(req.Context(), "app_http_response", duration.Seconds(),
 					"path", path, "method", req.Method, "status", fmt.Sprintf("%d", res.status))
 			}(srw, r)
 
@@ -52,3 +41,56 @@ func Metrics(metrics metrics) func(inner http.Handler) http.Handler {
 		})
 	}
 }
+
+// RouteMetrics is a middleware that records the request duration per registered route template, method and
+// status class (2xx, 4xx, ...). Using the route template instead of the raw path keeps the cardinality bounded.
+func RouteMetrics(metrics metrics) func(inner http.Handler) http.Handler {
+	return func(inner http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			srw := &StatusResponseWriter{ResponseWriter: w}
+
+			route := metricsPath(r)
+
+			defer func(res *StatusResponseWriter, req *http.Request) {
+				metrics.RecordHistogram(req.Context(), "app_http_route_duration", time.Since(start).Seconds(),
+					"route", route, "method", req.Method, "status_class", statusClass(res.status))
+			}(srw, r)
+
+			inner.ServeHTTP(srw, r)
+		})
+	}
+}
+
+// metricsPath returns the registered path template of the matched route. Static files
+// and the root path are reported with the request path instead.
+func metricsPath(r *http.Request) string {
+	var path string
+
+	if route := mux.CurrentRoute(r); route != nil {
+		path, _ = route.GetPathTemplate()
+	}
+
+	ext := strings.ToLower(filepath.Ext(r.URL.Path))
+	switch ext {
+	case ".css", ".js", ".png", ".jpg", ".jpeg", ".gif", ".ico", ".svg", ".txt", ".html", ".json", ".woff", ".woff2", ".ttf", ".eot", ".pdf":
+		path = r.URL.Path
+	}
+
+	if path == "/" || strings.HasPrefix(path, "/static") {
+		path = r.URL.Path
+	}
+
+	return strings.TrimSuffix(path, "/")
+}
+
+// statusClass groups a status code into its class, e.g. 404 into 4xx. A status of 0 means
+// the handler never called WriteHeader, which net/http treats as 200.
+func statusClass(status int) string {
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	return fmt.Sprintf("%dxx", status/100)
+}
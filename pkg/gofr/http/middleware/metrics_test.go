@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -121,3 +122,39 @@ func TestMetrics_StaticFileWithQueryParam(t *testing.T) {
 	mockMetrics.AssertCalled(t, "RecordHistogram", mock.Anything, "app_http_response", mock.Anything,
 		[]string{"path", "/static/example.js", "method", "GET", "status", "200"})
 }
+
+func TestRouteMetrics(t *testing.T) {
+	tests := []struct {
+		desc   string
+		target string
+		status int
+		labels []string
+	}{
+		{"path params are reported as template", "/users/42", http.StatusNotFound,
+			[]string{"route", "/users/{id}", "method", "GET", "status_class", "4xx"}},
+		{"implicit status is reported as 2xx", "/users/42", 0,
+			[]string{"route", "/users/{id}", "method", "GET", "status_class", "2xx"}},
+		{"server errors are reported as 5xx", "/users/7", http.StatusInternalServerError,
+			[]string{"route", "/users/{id}", "method", "GET", "status_class", "5xx"}},
+	}
+
+	for i, tc := range tests {
+		mockMetrics := &mockMetrics{}
+
+		mockMetrics.On("RecordHistogram", mock.Anything, "app_http_route_duration", mock.Anything, tc.labels).Return(nil)
+
+		router := mux.NewRouter()
+		router.HandleFunc("/users/{id}", func(w http.ResponseWriter, _ *http.Request) {
+			if tc.status != 0 {
+				w.WriteHeader(tc.status)
+			}
+		}).Methods(http.MethodGet)
+
+		router.Use(RouteMetrics(mockMetrics))
+
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tc.target, http.NoBody))
+
+		assert.Truef(t, mockMetrics.AssertCalled(t, "RecordHistogram", mock.Anything, "app_http_route_duration",
+			mock.Anything, tc.labels), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
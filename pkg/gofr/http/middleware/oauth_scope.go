@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWTScopes is the key used to store the scopes of the validated JWT within the request context.
+const JWTScopes authMethod = 4
+
+// defaultScopeClaims are the claims holding the scopes of the tokens, as named by RFC 8693 (scope) and by providers
+// such as Okta and Microsoft Entra ID (scp).
+var defaultScopeClaims = []string{"scope", "scp"} //nolint:gochecknoglobals // the default claims are never modified
+
+// OAuthScopes is a middleware storing the scopes of the token validated by the OAuth middleware within the request
+// context, read from the given claim, or from the scope or scp claims when it is empty. It must be used after the
+// OAuth middleware.
+func OAuthScopes(claim string) func(inner http.Handler) http.Handler {
+	claims := defaultScopeClaims
+	if claim != "" {
+		claims = []string{claim}
+	}
+
+	return func(inner http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokenClaims, ok := r.Context().Value(JWTClaim).(jwt.MapClaims)
+			if !ok {
+				inner.ServeHTTP(w, r)
+				return
+			}
+
+			for _, name := range claims {
+				if scopes, ok := parseScopes(tokenClaims[name]); ok {
+					*r = *r.Clone(context.WithValue(r.Context(), JWTScopes, scopes))
+					break
+				}
+			}
+
+			inner.ServeHTTP(w, r)
+		})
+	}
+}
+
+// parseScopes returns the scopes of a claim, which are either a space separated string or a list of strings.
+func parseScopes(claim any) ([]string, bool) {
+	switch value := claim.(type) {
+	case string:
+		return strings.Fields(value), true
+	case []string:
+		return value, true
+	case []any:
+		scopes := make([]string, 0, len(value))
+
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				scopes = append(scopes, s)
+			}
+		}
+
+		return scopes, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOAuthScopes(t *testing.T) {
+	tests := []struct {
+		desc     string
+		claim    string
+		claims   jwt.MapClaims
+		expected []string
+	}{
+		{"scope string", "", jwt.MapClaims{"scope": "users:read users:write"}, []string{"users:read", "users:write"}},
+		{"scp list", "", jwt.MapClaims{"scp": []any{"users:read", "users:write"}}, []string{"users:read", "users:write"}},
+		{"scope preferred to scp", "", jwt.MapClaims{"scope": "users:read", "scp": "users:write"}, []string{"users:read"}},
+		{"configured claim", "permissions", jwt.MapClaims{"permissions": "users:read", "scope": "users:write"},
+			[]string{"users:read"}},
+		{"configured claim missing", "permissions", jwt.MapClaims{"scope": "users:write"}, nil},
+		{"no scopes", "", jwt.MapClaims{"sub": "user"}, nil},
+		{"invalid claim", "", jwt.MapClaims{"scope": 1}, nil},
+	}
+
+	for i, tc := range tests {
+		var scopes []string
+
+		handler := OAuthScopes(tc.claim)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			scopes, _ = r.Context().Value(JWTScopes).([]string)
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		req = req.WithContext(context.WithValue(req.Context(), JWTClaim, tc.claims))
+
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Equal(t, tc.expected, scopes, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestOAuthScopes_WithoutToken(t *testing.T) {
+	called := false
+
+	handler := OAuthScopes("")(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		called = true
+
+		assert.Nil(t, r.Context().Value(JWTScopes))
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+
+	assert.True(t, called)
+}
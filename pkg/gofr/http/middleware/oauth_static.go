@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"crypto/rsa"
+	"errors"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var errNoPublicKeys = errors.New("no RSA public keys in the JWKS")
+
+// staticPublicKey validates all the tokens using a single public key, whatever their key ID.
+type staticPublicKey struct {
+	key *rsa.PublicKey
+}
+
+func (s staticPublicKey) Get(string) *rsa.PublicKey {
+	return s.key
+}
+
+// NewStaticPublicKey returns a PublicKeyProvider validating the tokens using the PEM encoded RSA public key or
+// certificate, without fetching a JWKS endpoint.
+func NewStaticPublicKey(pemKey []byte) (PublicKeyProvider, error) {
+	key, err := jwt.ParseRSAPublicKeyFromPEM(pemKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return staticPublicKey{key: key}, nil
+}
+
+// NewStaticJWKS returns a PublicKeyProvider validating the tokens using the RSA keys of the JWKS, identified by their
+// key ID, without fetching a JWKS endpoint.
+func NewStaticJWKS(jwks JWKS) (PublicKeyProvider, error) {
+	keys := make(map[string]*rsa.PublicKey, len(jwks.Keys))
+
+	for kid, key := range publicKeyFromJWKS(jwks) {
+		if key != nil {
+			keys[kid] = key
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, errNoPublicKeys
+	}
+
+	return &PublicKeys{keys: keys}, nil
+}
@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSignedToken(t *testing.T, key *rsa.PrivateKey, kid string) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"sub": "user"})
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func serveWithToken(provider PublicKeyProvider, token string) int {
+	handler := OAuth(provider)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestNewStaticPublicKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	provider, err := NewStaticPublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, serveWithToken(provider, newSignedToken(t, key, "")))
+	assert.Equal(t, http.StatusOK, serveWithToken(provider, newSignedToken(t, key, "any")))
+	assert.Equal(t, http.StatusUnauthorized, serveWithToken(provider, newSignedToken(t, otherKey, "")))
+
+	_, err = NewStaticPublicKey([]byte("invalid"))
+	require.Error(t, err)
+}
+
+func TestNewStaticJWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	provider, err := NewStaticJWKS(JWKS{Keys: []JSONWebKey{{
+		ID:             "key-1",
+		Type:           "RSA",
+		Modulus:        base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		PublicExponent: base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, serveWithToken(provider, newSignedToken(t, key, "key-1")))
+	assert.Equal(t, http.StatusUnauthorized, serveWithToken(provider, newSignedToken(t, key, "key-2")))
+
+	_, err = NewStaticJWKS(JWKS{})
+	require.ErrorIs(t, err, errNoPublicKeys)
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"gofr.dev/pkg/gofr/http/requestid"
+)
+
+const maxRequestIDLength = 128
+
+// RequestID is a middleware which identifies each request with the ID of its X-Request-Id header, or a generated
+// one if the header is missing or invalid. The ID is stored in the context of the request, where it is read using
+// requestid.FromContext, and is echoed in the X-Request-Id header of the response.
+func RequestID(inner http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestid.Header)
+		if !isValidRequestID(id) {
+			id = uuid.NewString()
+		}
+
+		w.Header().Set(requestid.Header, id)
+
+		inner.ServeHTTP(w, r.WithContext(requestid.NewContext(r.Context(), id)))
+	})
+}
+
+// isValidRequestID reports whether the ID received from a client can be used as is, i.e. it is not too long and only
+// has visible ASCII characters, so that it cannot break the logs it is written to.
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+
+	for i := 0; i < len(id); i++ {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"gofr.dev/pkg/gofr/http/requestid"
+)
+
+func TestRequestID(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		header    string
+		generated bool
+	}{
+		{"incoming ID is adopted", "abc-123", false},
+		{"missing ID is generated", "", true},
+		{"ID with spaces is replaced", "abc 123", true},
+		{"ID with a new line is replaced", "abc\n{\"level\":\"ERROR\"}", true},
+		{"too long ID is replaced", strings.Repeat("a", 129), true},
+	}
+
+	for i, tc := range testCases {
+		var id string
+
+		handler := RequestID(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			id = requestid.FromContext(r.Context())
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		if tc.header != "" {
+			req.Header.Set("X-Request-Id", tc.header)
+		}
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		if tc.generated {
+			assert.NoError(t, uuid.Validate(id), "TEST[%d], Failed.\n%s", i, tc.desc)
+		} else {
+			assert.Equal(t, tc.header, id, "TEST[%d], Failed.\n%s", i, tc.desc)
+		}
+
+		assert.Equal(t, id, rr.Header().Get("X-Request-Id"), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
@@ -0,0 +1,301 @@
+package middleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	defaultSessionCookie = "gofr_session"
+	defaultSessionTTL    = 24 * time.Hour
+	defaultCSRFHeader    = "X-CSRF-Token"
+	sessionIDBytes       = 32
+)
+
+// SessionKey is the key of the Session of the request in its context.
+const SessionKey authMethod = 3
+
+var (
+	// ErrSessionNotFound is returned by a SessionStore when there is no session with the ID, e.g. as it expired.
+	ErrSessionNotFound = errors.New("session not found")
+
+	errInvalidSessionCookie = errors.New("invalid session cookie")
+)
+
+// SessionStore persists the encoded sessions by their ID, until their TTL elapses.
+type SessionStore interface {
+	Get(ctx context.Context, id string) ([]byte, error)
+	Set(ctx context.Context, id string, data []byte, ttl time.Duration) error
+	Delete(ctx context.Context, id string) error
+}
+
+// SessionConfig configures the SessionAuth middleware.
+type SessionConfig struct {
+	// Secret signs the session cookies, so that they cannot be forged. It is required.
+	Secret []byte
+	// CookieName is the name of the session cookie. Defaults to gofr_session. The CSRF token is issued in the cookie
+	// with the same name suffixed with _csrf.
+	CookieName string
+	// TTL is the lifetime of a session after the login. Defaults to 24h.
+	TTL time.Duration
+	// Path and Domain scope the cookies. The path defaults to "/".
+	Path   string
+	Domain string
+	// Insecure allows the cookies to be sent over plain HTTP, e.g. during local development.
+	Insecure bool
+	// SameSite defaults to http.SameSiteLaxMode.
+	SameSite http.SameSite
+	// CSRFHeader is the header carrying the CSRF token of the session in the requests not using a safe method,
+	// i.e. GET, HEAD, OPTIONS and TRACE. Defaults to X-CSRF-Token.
+	CSRFHeader string
+	// PublicPaths are the paths which can be requested without a session, e.g. the login route. The requests to them
+	// carrying a session must still carry its CSRF token.
+	PublicPaths []string
+	// CSRFExemptPaths are the paths whose requests are not checked for the CSRF token of their session, e.g. the
+	// routes called by other services rather than by the browser.
+	CSRFExemptPaths []string
+}
+
+// sessionData is the encoding of a session in the SessionStore.
+type sessionData struct {
+	Values    map[string]string `json:"values"`
+	CSRFToken string            `json:"csrf_token"`
+}
+
+// Session is the session of a request authenticated by the SessionAuth middleware. A session is created on Login,
+// and is unauthenticated for the requests to the public paths without a valid session cookie.
+type Session struct {
+	id   string
+	data sessionData
+
+	store  SessionStore
+	config *SessionConfig
+	w      http.ResponseWriter
+}
+
+// ID returns the ID of the session, or an empty string if the request is not authenticated.
+func (s *Session) ID() string {
+	return s.id
+}
+
+// IsAuthenticated reports whether the request has a valid session.
+func (s *Session) IsAuthenticated() bool {
+	return s.id != ""
+}
+
+// Get returns the value stored in the session for the key.
+func (s *Session) Get(key string) string {
+	return s.data.Values[key]
+}
+
+// Values returns a copy of the values stored in the session.
+func (s *Session) Values() map[string]string {
+	return maps.Clone(s.data.Values)
+}
+
+// CSRFToken returns the token which has to be sent in the CSRF header of the requests not using a safe method.
+// It is also issued in a cookie readable by the browser scripts on login.
+func (s *Session) CSRFToken() string {
+	return s.data.CSRFToken
+}
+
+// Login creates a new session storing values, and issues its cookies. The previous session of the request, if any,
+// is deleted, so that a session ID known before the login cannot be used after it.
+func (s *Session) Login(ctx context.Context, values map[string]string) error {
+	id, err := randomToken()
+	if err != nil {
+		return err
+	}
+
+	csrfToken, err := randomToken()
+	if err != nil {
+		return err
+	}
+
+	data := sessionData{Values: maps.Clone(values), CSRFToken: csrfToken}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if err := s.store.Set(ctx, id, encoded, s.config.TTL); err != nil {
+		return fmt.Errorf("error storing session: %w", err)
+	}
+
+	if s.id != "" {
+		if err := s.store.Delete(ctx, s.id); err != nil {
+			return fmt.Errorf("error deleting session: %w", err)
+		}
+	}
+
+	s.id, s.data = id, data
+
+	maxAge := int(s.config.TTL / time.Second)
+
+	http.SetCookie(s.w, s.config.cookie(s.config.CookieName, id+"."+sign(s.config.Secret, id), maxAge, true))
+	http.SetCookie(s.w, s.config.cookie(s.config.CookieName+"_csrf", csrfToken, maxAge, false))
+
+	return nil
+}
+
+// Logout deletes the session and expires its cookies.
+func (s *Session) Logout(ctx context.Context) error {
+	if s.id != "" {
+		if err := s.store.Delete(ctx, s.id); err != nil {
+			return fmt.Errorf("error deleting session: %w", err)
+		}
+	}
+
+	s.id, s.data = "", sessionData{}
+
+	http.SetCookie(s.w, s.config.cookie(s.config.CookieName, "", -1, true))
+	http.SetCookie(s.w, s.config.cookie(s.config.CookieName+"_csrf", "", -1, false))
+
+	return nil
+}
+
+func (c *SessionConfig) cookie(name, value string, maxAge int, httpOnly bool) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     c.Path,
+		Domain:   c.Domain,
+		MaxAge:   maxAge,
+		Secure:   !c.Insecure,
+		HttpOnly: httpOnly,
+		SameSite: c.SameSite,
+	}
+}
+
+// SessionAuth is a middleware which authenticates the requests using the session cookie issued on Session.Login.
+// The requests not using a safe method must carry the CSRF token of the session in the CSRF header. The session
+// of the request is stored in its context with SessionKey.
+func SessionAuth(store SessionStore, config SessionConfig, logger logger) func(handler http.Handler) http.Handler {
+	config.setDefaults()
+
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isWellKnown(r.URL.Path) {
+				handler.ServeHTTP(w, r)
+				return
+			}
+
+			session := &Session{store: store, config: &config, w: w}
+
+			err := session.load(r)
+
+			switch {
+			case errors.Is(err, ErrSessionNotFound) || errors.Is(err, errInvalidSessionCookie):
+			case err != nil:
+				logger.Error("error loading session: ", err)
+				http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+
+				return
+			}
+
+			if !session.IsAuthenticated() && !slices.Contains(config.PublicPaths, r.URL.Path) {
+				http.Error(w, "Unauthorized: Session missing or expired", http.StatusUnauthorized)
+				return
+			}
+
+			// the requests without a session, e.g. to log in, have no CSRF token to carry
+			checkCSRF := session.IsAuthenticated() && !isSafeMethod(r.Method) &&
+				!slices.Contains(config.CSRFExemptPaths, r.URL.Path)
+
+			if checkCSRF && subtle.ConstantTimeCompare([]byte(r.Header.Get(config.CSRFHeader)), []byte(session.CSRFToken())) != 1 {
+				http.Error(w, "Forbidden: Invalid CSRF token", http.StatusForbidden)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), SessionKey, session)
+			handler.ServeHTTP(w, r.Clone(ctx))
+		})
+	}
+}
+
+// load reads the session of the signed session cookie of the request from the store.
+func (s *Session) load(r *http.Request) error {
+	cookie, err := r.Cookie(s.config.CookieName)
+	if err != nil {
+		return errInvalidSessionCookie
+	}
+
+	id, signature, ok := strings.Cut(cookie.Value, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(sign(s.config.Secret, id))) {
+		return errInvalidSessionCookie
+	}
+
+	encoded, err := s.store.Get(r.Context(), id)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(encoded, &s.data); err != nil {
+		return err
+	}
+
+	s.id = id
+
+	return nil
+}
+
+func (c *SessionConfig) setDefaults() {
+	if c.CookieName == "" {
+		c.CookieName = defaultSessionCookie
+	}
+
+	if c.TTL <= 0 {
+		c.TTL = defaultSessionTTL
+	}
+
+	if c.Path == "" {
+		c.Path = "/"
+	}
+
+	if c.SameSite == 0 {
+		c.SameSite = http.SameSiteLaxMode
+	}
+
+	if c.CSRFHeader == "" {
+		c.CSRFHeader = defaultCSRFHeader
+	}
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	default:
+		return false
+	}
+}
+
+func sign(secret []byte, id string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(id))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, sessionIDBytes)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
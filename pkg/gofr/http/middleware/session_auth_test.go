@@ -0,0 +1,235 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errStoreUnavailable = errors.New("store unavailable")
+
+type testLogger struct {
+	logs []string
+}
+
+func (*testLogger) Log(...any) {}
+
+func (l *testLogger) Error(args ...any) {
+	for _, arg := range args {
+		if err, ok := arg.(error); ok {
+			l.logs = append(l.logs, err.Error())
+		}
+	}
+}
+
+// newSessionHandler returns a handler using SessionAuth, where /login logs in as the user of the query, /logout
+// logs out and any other path responds with the user of the session, if any.
+func newSessionHandler(store SessionStore, logger logger) http.Handler {
+	config := SessionConfig{Secret: []byte("secret"), PublicPaths: []string{"/login"}, CSRFExemptPaths: []string{"/hook"}}
+
+	return SessionAuth(store, config, logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		session, ok := r.Context().Value(SessionKey).(*Session)
+		if !ok {
+			return
+		}
+
+		var err error
+
+		switch r.URL.Path {
+		case "/login":
+			err = session.Login(r.Context(), map[string]string{"user": r.URL.Query().Get("user")})
+		case "/logout":
+			err = session.Logout(r.Context())
+		}
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		_, _ = w.Write([]byte(session.Get("user")))
+	}))
+}
+
+func serveSession(handler http.Handler, method, target string, cookies []*http.Cookie, csrfToken string) *http.Response {
+	req := httptest.NewRequest(method, target, http.NoBody)
+
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+
+	if csrfToken != "" {
+		req.Header.Set("X-CSRF-Token", csrfToken)
+	}
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	return rr.Result()
+}
+
+func TestSessionAuth(t *testing.T) {
+	handler := newSessionHandler(NewMemorySessionStore(), &testLogger{})
+
+	resp := serveSession(handler, http.MethodPost, "/login?user=alice", nil, "")
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	cookies := resp.Cookies()
+	require.Len(t, cookies, 2)
+
+	session, csrf := cookies[0], cookies[1]
+
+	assert.Equal(t, "gofr_session", session.Name)
+	assert.True(t, session.HttpOnly)
+	assert.True(t, session.Secure)
+	assert.Equal(t, http.SameSiteLaxMode, session.SameSite)
+	assert.Equal(t, 86400, session.MaxAge)
+	assert.Equal(t, "gofr_session_csrf", csrf.Name)
+	assert.False(t, csrf.HttpOnly, "the CSRF token has to be readable by the browser scripts")
+
+	forged := &http.Cookie{Name: "gofr_session", Value: strings.Split(session.Value, ".")[0] + ".forged"}
+
+	testCases := []struct {
+		desc       string
+		method     string
+		cookies    []*http.Cookie
+		csrfToken  string
+		statusCode int
+		body       string
+	}{
+		{"session", http.MethodGet, []*http.Cookie{session}, "", http.StatusOK, "alice"},
+		{"no session", http.MethodGet, nil, "", http.StatusUnauthorized, "Unauthorized: Session missing or expired\n"},
+		{"forged cookie", http.MethodGet, []*http.Cookie{forged}, "", http.StatusUnauthorized,
+			"Unauthorized: Session missing or expired\n"},
+		{"unsafe method with CSRF token", http.MethodPost, []*http.Cookie{session}, csrf.Value, http.StatusOK, "alice"},
+		{"unsafe method without CSRF token", http.MethodPost, []*http.Cookie{session}, "", http.StatusForbidden,
+			"Forbidden: Invalid CSRF token\n"},
+		{"unsafe method with wrong CSRF token", http.MethodDelete, []*http.Cookie{session}, "wrong", http.StatusForbidden,
+			"Forbidden: Invalid CSRF token\n"},
+	}
+
+	for i, tc := range testCases {
+		resp := serveSession(handler, tc.method, "/profile", tc.cookies, tc.csrfToken)
+
+		body := readBody(t, resp)
+
+		assert.Equal(t, tc.statusCode, resp.StatusCode, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.body, body, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+
+	resp = serveSession(handler, http.MethodPost, "/logout", []*http.Cookie{session}, csrf.Value)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, -1, resp.Cookies()[0].MaxAge)
+
+	resp = serveSession(handler, http.MethodGet, "/profile", []*http.Cookie{session}, "")
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "the session should be deleted on logout")
+}
+
+func TestSessionAuth_CSRFPaths(t *testing.T) {
+	handler := newSessionHandler(NewMemorySessionStore(), &testLogger{})
+
+	resp := serveSession(handler, http.MethodPost, "/login?user=alice", nil, "")
+	defer resp.Body.Close()
+
+	session := resp.Cookies()[0]
+
+	testCases := []struct {
+		desc       string
+		path       string
+		statusCode int
+	}{
+		{"public path with a session", "/login?user=bob", http.StatusForbidden},
+		{"CSRF exempt path", "/hook", http.StatusOK},
+		{"other path", "/profile", http.StatusForbidden},
+	}
+
+	for i, tc := range testCases {
+		resp := serveSession(handler, http.MethodPost, tc.path, []*http.Cookie{session}, "")
+		resp.Body.Close()
+
+		assert.Equal(t, tc.statusCode, resp.StatusCode, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestSessionAuth_LoginRenewsSession(t *testing.T) {
+	handler := newSessionHandler(NewMemorySessionStore(), &testLogger{})
+
+	resp := serveSession(handler, http.MethodPost, "/login?user=alice", nil, "")
+	defer resp.Body.Close()
+
+	first, csrf := resp.Cookies()[0], resp.Cookies()[1]
+
+	resp = serveSession(handler, http.MethodPost, "/login?user=bob", []*http.Cookie{first}, csrf.Value)
+	defer resp.Body.Close()
+
+	second := resp.Cookies()[0]
+
+	assert.NotEqual(t, first.Value, second.Value)
+	assert.Equal(t, "bob", readBody(t, serveSession(handler, http.MethodGet, "/", []*http.Cookie{second}, "")))
+
+	resp = serveSession(handler, http.MethodGet, "/", []*http.Cookie{first}, "")
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "the previous session should be deleted on login")
+}
+
+func TestSessionAuth_StoreError(t *testing.T) {
+	logger := &testLogger{}
+	handler := newSessionHandler(&failingSessionStore{}, logger)
+
+	cookie := &http.Cookie{Name: "gofr_session", Value: "id." + sign([]byte("secret"), "id")}
+
+	resp := serveSession(handler, http.MethodGet, "/", []*http.Cookie{cookie}, "")
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, []string{errStoreUnavailable.Error()}, logger.logs)
+}
+
+func TestSessionAuth_WellKnown(t *testing.T) {
+	handler := newSessionHandler(NewMemorySessionStore(), &testLogger{})
+
+	resp := serveSession(handler, http.MethodGet, "/.well-known/alive", nil, "")
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+type failingSessionStore struct{}
+
+func (*failingSessionStore) Get(context.Context, string) ([]byte, error) {
+	return nil, errStoreUnavailable
+}
+
+func (*failingSessionStore) Set(context.Context, string, []byte, time.Duration) error {
+	return errStoreUnavailable
+}
+
+func (*failingSessionStore) Delete(context.Context, string) error {
+	return errStoreUnavailable
+}
+
+func readBody(t *testing.T, resp *http.Response) string {
+	t.Helper()
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return string(body)
+}
@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const redisSessionPrefix = "gofr_session:"
+
+// MemorySessionStore is a SessionStore keeping the sessions in the memory of the application. The sessions are lost
+// on restart and are not shared between the instances of the application, so it is meant for development and
+// single instance applications.
+type MemorySessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]memorySession
+}
+
+type memorySession struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+// NewMemorySessionStore creates an empty MemorySessionStore.
+func NewMemorySessionStore() *MemorySessionStore {
+	return &MemorySessionStore{sessions: make(map[string]memorySession)}
+}
+
+func (m *MemorySessionStore) Get(_ context.Context, id string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	session, ok := m.sessions[id]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+
+	if time.Now().After(session.expiresAt) {
+		delete(m.sessions, id)
+
+		return nil, ErrSessionNotFound
+	}
+
+	return session.data, nil
+}
+
+func (m *MemorySessionStore) Set(_ context.Context, id string, data []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// the expired sessions which are never requested again are removed while storing new ones
+	now := time.Now()
+
+	for key, session := range m.sessions {
+		if now.After(session.expiresAt) {
+			delete(m.sessions, key)
+		}
+	}
+
+	m.sessions[id] = memorySession{data: data, expiresAt: now.Add(ttl)}
+
+	return nil
+}
+
+func (m *MemorySessionStore) Delete(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.sessions, id)
+
+	return nil
+}
+
+// RedisSessionStore is a SessionStore keeping the sessions in Redis, with keys prefixed by gofr_session:, which
+// expire along with the sessions.
+type RedisSessionStore struct {
+	client redis.Cmdable
+}
+
+// NewRedisSessionStore creates a RedisSessionStore using the client, e.g. the Redis datasource of the container.
+func NewRedisSessionStore(client redis.Cmdable) *RedisSessionStore {
+	return &RedisSessionStore{client: client}
+}
+
+func (r *RedisSessionStore) Get(ctx context.Context, id string) ([]byte, error) {
+	data, err := r.client.Get(ctx, redisSessionPrefix+id).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrSessionNotFound
+	}
+
+	return data, err
+}
+
+func (r *RedisSessionStore) Set(ctx context.Context, id string, data []byte, ttl time.Duration) error {
+	return r.client.Set(ctx, redisSessionPrefix+id, data, ttl).Err()
+}
+
+func (r *RedisSessionStore) Delete(ctx context.Context, id string) error {
+	return r.client.Del(ctx, redisSessionPrefix+id).Err()
+}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemorySessionStore_Expiry(t *testing.T) {
+	store := NewMemorySessionStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Set(ctx, "expired", []byte("data"), -time.Second))
+	require.NoError(t, store.Set(ctx, "active", []byte("data"), time.Minute))
+
+	_, err := store.Get(ctx, "expired")
+	require.ErrorIs(t, err, ErrSessionNotFound)
+
+	data, err := store.Get(ctx, "active")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+
+	require.NoError(t, store.Delete(ctx, "active"))
+
+	_, err = store.Get(ctx, "active")
+	require.ErrorIs(t, err, ErrSessionNotFound)
+}
+
+func TestRedisSessionStore(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	store := NewRedisSessionStore(client)
+	ctx := context.Background()
+
+	mock.ExpectSet("gofr_session:id", []byte("data"), time.Minute).SetVal("OK")
+	mock.ExpectGet("gofr_session:id").SetVal("data")
+	mock.ExpectDel("gofr_session:id").SetVal(1)
+	mock.ExpectGet("gofr_session:id").RedisNil()
+	mock.ExpectGet("gofr_session:other").SetErr(errStoreUnavailable)
+
+	require.NoError(t, store.Set(ctx, "id", []byte("data"), time.Minute))
+
+	data, err := store.Get(ctx, "id")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+
+	require.NoError(t, store.Delete(ctx, "id"))
+
+	_, err = store.Get(ctx, "id")
+	require.ErrorIs(t, err, ErrSessionNotFound)
+
+	_, err = store.Get(ctx, "other")
+	require.ErrorIs(t, err, errStoreUnavailable)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -7,6 +7,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 
 	"gofr.dev/pkg/gofr/version"
 )
@@ -30,3 +31,17 @@ func Tracer(inner http.Handler) http.Handler {
 		inner.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// TraceHeader is a middleware that writes the trace ID of the current span into the given response header,
+// so that clients can correlate a failed request with its trace. It must be used after the Tracer middleware.
+func TraceHeader(header string) func(inner http.Handler) http.Handler {
+	return func(inner http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if traceID := trace.SpanFromContext(r.Context()).SpanContext().TraceID(); traceID.IsValid() {
+				w.Header().Set(header, traceID.String())
+			}
+
+			inner.ServeHTTP(w, r)
+		})
+	}
+}
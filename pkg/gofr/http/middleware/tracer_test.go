@@ -5,6 +5,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/sdk/trace"
 	otelTrace "go.opentelemetry.io/otel/trace"
@@ -29,3 +30,31 @@ func TestTrace(_ *testing.T) {
 
 	handler.ServeHTTP(recorder, req)
 }
+
+func TestTraceHeader(t *testing.T) {
+	tp := trace.NewTracerProvider()
+	otel.SetTracerProvider(tp)
+
+	handler := Tracer(TraceHeader("X-Trace-Id")(&MockHandlerForTracing{}))
+	req := httptest.NewRequest(http.MethodGet, "/dummy", http.NoBody)
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, req)
+
+	traceID := recorder.Header().Get("X-Trace-Id")
+
+	assert.Len(t, traceID, 32)
+	assert.Equal(t, recorder.Body.String(), traceID)
+}
+
+func TestTraceHeader_NoSpan(t *testing.T) {
+	handler := TraceHeader("X-Trace-Id")(&MockHandlerForTracing{})
+	req := httptest.NewRequest(http.MethodGet, "/dummy", http.NoBody)
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, req)
+
+	assert.Empty(t, recorder.Header().Get("X-Trace-Id"))
+}
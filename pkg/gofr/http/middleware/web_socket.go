@@ -24,7 +24,11 @@ func WSHandlerUpgrade(c *container.Container, wsManager *websocket.Manager) func
 				}
 
 				// Add the connection to the hub
-				wsManager.AddWebsocketConnection(r.Header.Get("Sec-WebSocket-Key"), &websocket.Connection{Conn: conn})
+				wsManager.AddWebsocketConnection(r.Header.Get("Sec-WebSocket-Key"), &websocket.Connection{
+					Conn:            conn,
+					HandshakeHeader: r.Header.Clone(),
+					HandshakeTLS:    r.TLS,
+				})
 
 				// Store the websocket connection key in the context
 				ctx := context.WithValue(r.Context(), websocket.WSConnectionKey, r.Header.Get("Sec-WebSocket-Key"))
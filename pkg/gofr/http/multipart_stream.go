@@ -0,0 +1,100 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// maxFieldSize is the max size of a form field read while streaming files, as the fields are read into memory.
+const maxFieldSize = 1 << 20 // 1 MB
+
+var errFieldTooLarge = errors.New("form field exceeds the size of 1 MB")
+
+// UploadLimits guards the uploads streamed using StreamFiles against abuse. A zero value means no limit.
+type UploadLimits struct {
+	// MaxSize is the max size of the request body in bytes.
+	MaxSize int64
+	// MaxFiles is the max number of files in the request.
+	MaxFiles int
+}
+
+// FilePart is a file of a multipart/form-data request. Reading it reads the file from the request body, without
+// buffering it in memory or on disk.
+type FilePart struct {
+	io.Reader
+
+	FieldName   string
+	FileName    string
+	ContentType string
+}
+
+// StreamFiles reads the multipart/form-data request body part by part, and calls handle with each file as it is
+// reached, e.g. to copy it to a file store. The unread data of a file is skipped once handle returns, and an error
+// returned by handle stops the streaming. The form fields of the request are returned once all the parts are read.
+func (r *Request) StreamFiles(limits UploadLimits, handle func(file *FilePart) error) (url.Values, error) {
+	if limits.MaxSize > 0 {
+		r.req.Body = http.MaxBytesReader(nil, r.req.Body, limits.MaxSize)
+	}
+
+	reader, err := r.req.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(url.Values)
+	files := 0
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return fields, nil
+		}
+
+		if err != nil {
+			return nil, uploadError(err, limits)
+		}
+
+		if part.FileName() == "" {
+			value, err := io.ReadAll(io.LimitReader(part, maxFieldSize+1))
+			if err != nil {
+				return nil, uploadError(err, limits)
+			}
+
+			if len(value) > maxFieldSize {
+				return nil, errFieldTooLarge
+			}
+
+			fields.Add(part.FormName(), string(value))
+
+			continue
+		}
+
+		files++
+		if limits.MaxFiles > 0 && files > limits.MaxFiles {
+			return nil, ErrorUploadLimitExceeded{Limit: fmt.Sprintf("%d files", limits.MaxFiles)}
+		}
+
+		err = handle(&FilePart{
+			Reader:      part,
+			FieldName:   part.FormName(),
+			FileName:    part.FileName(),
+			ContentType: part.Header.Get("Content-Type"),
+		})
+		if err != nil {
+			return nil, uploadError(err, limits)
+		}
+	}
+}
+
+// uploadError returns ErrorUploadLimitExceeded if the request body exceeded the max size of the upload.
+func uploadError(err error, limits UploadLimits) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return ErrorUploadLimitExceeded{Limit: fmt.Sprintf("%d bytes", limits.MaxSize)}
+	}
+
+	return err
+}
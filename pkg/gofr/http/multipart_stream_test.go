@@ -0,0 +1,103 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errStreamHandler = errors.New("handler error")
+
+func multipartStreamRequest(t *testing.T, files int) *Request {
+	t.Helper()
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	require.NoError(t, writer.WriteField("folder", "reports"))
+
+	for i := range files {
+		part, err := writer.CreateFormFile("file", "report"+string(rune('a'+i))+".csv")
+		require.NoError(t, err)
+
+		_, err = part.Write([]byte(strings.Repeat("x", 100)))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/upload", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return NewRequest(req)
+}
+
+func TestRequest_StreamFiles(t *testing.T) {
+	var names []string
+
+	var sizes []int
+
+	fields, err := multipartStreamRequest(t, 2).StreamFiles(UploadLimits{MaxFiles: 2}, func(file *FilePart) error {
+		content, err := io.ReadAll(file)
+
+		names = append(names, file.FieldName+"/"+file.FileName+"/"+file.ContentType)
+		sizes = append(sizes, len(content))
+
+		return err
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "reports", fields.Get("folder"))
+	assert.Equal(t, []string{"file/reporta.csv/application/octet-stream", "file/reportb.csv/application/octet-stream"}, names)
+	assert.Equal(t, []int{100, 100}, sizes)
+}
+
+func TestRequest_StreamFilesErrors(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		files  int
+		limits UploadLimits
+		handle func(*FilePart) error
+		err    error
+	}{
+		{desc: "too many files", files: 3, limits: UploadLimits{MaxFiles: 2},
+			err: ErrorUploadLimitExceeded{Limit: "2 files"}},
+		{desc: "request too large", files: 3, limits: UploadLimits{MaxSize: 300},
+			err: ErrorUploadLimitExceeded{Limit: "300 bytes"}},
+		{desc: "handler error", files: 1, handle: func(*FilePart) error { return errStreamHandler },
+			err: errStreamHandler},
+	}
+
+	for i, tc := range testCases {
+		handle := tc.handle
+		if handle == nil {
+			handle = func(file *FilePart) error {
+				_, err := io.Copy(io.Discard, file)
+
+				return err
+			}
+		}
+
+		fields, err := multipartStreamRequest(t, tc.files).StreamFiles(tc.limits, handle)
+
+		assert.Nil(t, fields, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.err, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestRequest_StreamFilesNotMultipart(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err := NewRequest(req).StreamFiles(UploadLimits{}, func(*FilePart) error { return nil })
+
+	require.ErrorIs(t, err, http.ErrNotMultipart)
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 
 const (
 	defaultMaxMemory = 32 << 20 // 32 MB
+	maxRawBodySize   = 32 << 20 // 32 MB
 )
 
 var (
@@ -49,6 +51,11 @@ func (r *Request) Context() context.Context {
 	return r.req.Context()
 }
 
+// TLS returns the TLS state of the connection of the request, or nil if the request was not received over TLS.
+func (r *Request) TLS() *tls.ConnectionState {
+	return r.req.TLS
+}
+
 // PathParam retrieves a path parameter from the request.
 func (r *Request) PathParam(key string) string {
 	return r.pathParams[key]
@@ -78,6 +85,16 @@ func (r *Request) Bind(i any) error {
 	return nil
 }
 
+// Header returns the value of the request header with the given key.
+func (r *Request) Header(key string) string {
+	return r.req.Header.Get(key)
+}
+
+// Headers returns a copy of the headers of the request.
+func (r *Request) Headers() http.Header {
+	return r.req.Header.Clone()
+}
+
 // HostName retrieves the hostname from the request.
 func (r *Request) HostName() string {
 	proto := r.req.Header.Get("X-Forwarded-Proto")
@@ -102,6 +119,33 @@ func (r *Request) Params(key string) []string {
 	return result
 }
 
+// RawBody returns the body of the request as it is, whatever its content type, e.g. to read text, protobuf or file
+// contents. The body is kept, so that it can still be bound using Bind. Bodies larger than 32 MB are not read, and
+// return an ErrorUploadLimitExceeded which is responded with status 413.
+func (r *Request) RawBody() ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r.req.Body, maxRawBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) > maxRawBodySize {
+		// the part read is put back, so that the body can still be streamed
+		r.req.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), r.req.Body), Closer: r.req.Body}
+
+		return nil, ErrorUploadLimitExceeded{Limit: fmt.Sprintf("%d bytes", maxRawBodySize)}
+	}
+
+	r.req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// readCloser closes the original body of a request whose body is read from another reader.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
 func (r *Request) body() ([]byte, error) {
 	bodyBytes, err := io.ReadAll(r.req.Body)
 	if err != nil {
@@ -43,6 +43,47 @@ func TestBind(t *testing.T) {
 	}
 }
 
+func TestRequest_RawBody(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader(`{"name":"gofr"}`))
+	r.Header.Set("Content-Type", "application/json")
+
+	req := NewRequest(r)
+
+	body, err := req.RawBody()
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"gofr"}`, string(body))
+
+	var data struct {
+		Name string `json:"name"`
+	}
+
+	require.NoError(t, req.Bind(&data), "the body should still be bound after it is read")
+	assert.Equal(t, "gofr", data.Name)
+
+	body, err = req.RawBody()
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"gofr"}`, string(body), "the body should be read again")
+}
+
+func TestRequest_RawBodyTooLarge(t *testing.T) {
+	content := strings.Repeat("a", maxRawBodySize+10)
+	r := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader(content))
+	r.Header.Set("Content-Type", "text/plain")
+
+	req := NewRequest(r)
+
+	_, err := req.RawBody()
+
+	var limitErr ErrorUploadLimitExceeded
+
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, limitErr.StatusCode())
+
+	remaining, err := io.ReadAll(r.Body)
+	require.NoError(t, err)
+	assert.Len(t, remaining, len(content), "the body should be kept whole")
+}
+
 func TestBind_FileSuccess(t *testing.T) {
 	r := NewRequest(generateMultipartRequestZip(t))
 	x := struct {
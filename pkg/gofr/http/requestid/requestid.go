@@ -0,0 +1,23 @@
+// Package requestid carries the ID identifying an HTTP request, which is used to correlate the logs of the request
+// and of the requests it makes to other services, even when tracing is disabled.
+package requestid
+
+import "context"
+
+// Header is the header carrying the ID of a request. The ID of an incoming request is adopted from it, and it is set
+// on the requests made to the HTTP services using the context of the request.
+const Header = "X-Request-Id"
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the request ID.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID carried by ctx, or an empty string if there is none.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+
+	return id
+}
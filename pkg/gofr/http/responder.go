@@ -8,6 +8,8 @@ import (
 	resTypes "gofr.dev/pkg/gofr/http/response"
 )
 
+const streamBufferSize = 32 * 1024
+
 // NewResponder creates a new Responder instance from the given http.ResponseWriter..
 func NewResponder(w http.ResponseWriter, method string) *Responder {
 	return &Responder{w: w, method: method}
@@ -15,8 +17,25 @@ func NewResponder(w http.ResponseWriter, method string) *Responder {
 
 // Responder encapsulates an http.ResponseWriter and is responsible for crafting structured responses.
 type Responder struct {
-	w      http.ResponseWriter
-	method string
+	w          http.ResponseWriter
+	method     string
+	jsonConfig *JSONConfig
+	wrapper    func(data any) any
+}
+
+// WithResponseWrapper returns the Responder wrapping the data of the successful responses using the wrapper instead
+// of the default envelope. The values of type response.Raw, response.Response and response.File are not wrapped.
+func (r *Responder) WithResponseWrapper(wrapper func(data any) any) *Responder {
+	r.wrapper = wrapper
+
+	return r
+}
+
+// WithJSONConfig returns the Responder encoding the JSON responses following the config.
+func (r *Responder) WithJSONConfig(config *JSONConfig) *Responder {
+	r.jsonConfig = config
+
+	return r
 }
 
 // Respond sends a response with the given data and handles potential errors, setting appropriate
@@ -36,6 +55,10 @@ func (r Responder) Respond(data any, err error) {
 
 		_, _ = r.w.Write(v.Content)
 
+		return
+	case resTypes.Stream:
+		r.stream(v)
+
 		return
 	default:
 		// handling where an interface contains a nullable type with a nil value.
@@ -43,6 +66,12 @@ func (r Responder) Respond(data any, err error) {
 			data = nil
 		}
 
+		if r.wrapper != nil && err == nil {
+			resp = r.wrapper(data)
+
+			break
+		}
+
 		resp = response{Data: data, Error: errorObj}
 	}
 
@@ -50,9 +79,55 @@ func (r Responder) Respond(data any, err error) {
 
 	r.w.WriteHeader(statusCode)
 
+	if !r.jsonConfig.isDefault() {
+		resp = r.jsonConfig.convert(reflect.ValueOf(resp))
+	}
+
 	_ = json.NewEncoder(r.w).Encode(resp)
 }
 
+// stream copies the body of the Stream to the response, flushing it after every read so that the client receives
+// the data as it becomes available.
+func (r Responder) stream(s resTypes.Stream) {
+	for key, value := range s.Headers {
+		r.w.Header().Set(key, value)
+	}
+
+	if s.ContentType != "" {
+		r.w.Header().Set("Content-Type", s.ContentType)
+	}
+
+	if s.StatusCode == 0 {
+		s.StatusCode = http.StatusOK
+	}
+
+	r.w.WriteHeader(s.StatusCode)
+
+	if s.Body == nil {
+		return
+	}
+
+	defer s.Body.Close()
+
+	controller := http.NewResponseController(r.w)
+	buf := make([]byte, streamBufferSize)
+
+	for {
+		n, err := s.Body.Read(buf)
+		if n > 0 {
+			if _, writeErr := r.w.Write(buf[:n]); writeErr != nil {
+				return
+			}
+
+			_ = controller.Flush()
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
 // getStatusCode returns corresponding HTTP status codes.
 func getStatusCode(method string, data any, err error) (statusCode int, errResp any) {
 	if err == nil {
@@ -86,9 +161,15 @@ func handleSuccess(method string, data any) (statusCode int, err any) {
 }
 
 func createErrorResponse(err error) map[string]any {
-	return map[string]any{
+	resp := map[string]any{
 		"message": err.Error(),
 	}
+
+	if e, ok := err.(detailedError); ok {
+		resp["details"] = e.Details()
+	}
+
+	return resp
 }
 
 // response represents an HTTP response.
@@ -102,6 +183,12 @@ type statusCodeResponder interface {
 	StatusCode() int
 }
 
+// detailedError is implemented by the errors which describe the failure in more detail than their message,
+// e.g. ErrorValidation.
+type detailedError interface {
+	Details() any
+}
+
 // isNil checks if the given any value is nil.
 // It returns true if the value is nil or if it is a pointer that points to nil.
 // This function is useful for determining whether a value, including interface or pointer types, is effectively nil.
@@ -2,8 +2,10 @@ package http
 
 import (
 	"bytes"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -80,6 +82,29 @@ func TestResponder(t *testing.T) {
 	}
 }
 
+func TestResponder_Stream(t *testing.T) {
+	upstream := &http.Response{
+		StatusCode: http.StatusPartialContent,
+		Header: http.Header{
+			"Content-Type":   {"application/x-ndjson"},
+			"Content-Length": {"18"},
+			"X-Internal":     {"secret"},
+		},
+		Body: io.NopCloser(strings.NewReader(`{"id":1}` + "\n" + `{"id":2}` + "\n")),
+	}
+
+	recorder := httptest.NewRecorder()
+
+	NewResponder(recorder, http.MethodGet).Respond(resTypes.NewStream(upstream), nil)
+
+	assert.Equal(t, http.StatusPartialContent, recorder.Code)
+	assert.Equal(t, "application/x-ndjson", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "18", recorder.Header().Get("Content-Length"))
+	assert.Empty(t, recorder.Header().Get("X-Internal"))
+	assert.Equal(t, `{"id":1}`+"\n"+`{"id":2}`+"\n", recorder.Body.String())
+	assert.True(t, recorder.Flushed)
+}
+
 func TestResponder_getStatusCode(t *testing.T) {
 	tests := []struct {
 		desc       string
@@ -0,0 +1,35 @@
+package response
+
+import (
+	"io"
+	"net/http"
+)
+
+// Stream is a response whose body is copied to the client as it is read, without buffering it in memory, e.g. to
+// proxy large or streaming responses of other services. The body is closed once it is copied.
+type Stream struct {
+	Body        io.ReadCloser
+	ContentType string
+	// StatusCode is the status code of the response, http.StatusOK if not set.
+	StatusCode int
+	Headers    map[string]string
+}
+
+// NewStream returns a Stream which passes the status code, the content headers and the body of resp, e.g. the
+// response of GetStream of an HTTP service, to the client.
+func NewStream(resp *http.Response) Stream {
+	stream := Stream{
+		Body:        resp.Body,
+		ContentType: resp.Header.Get("Content-Type"),
+		StatusCode:  resp.StatusCode,
+		Headers:     make(map[string]string),
+	}
+
+	for _, key := range []string{"Content-Length", "Content-Encoding", "Content-Disposition"} {
+		if value := resp.Header.Get(key); value != "" {
+			stream.Headers[key] = value
+		}
+	}
+
+	return stream
+}
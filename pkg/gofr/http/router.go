@@ -1,9 +1,12 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
+	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -15,6 +18,9 @@ const DefaultSwaggerFileName = "openapi.json"
 type Router struct {
 	mux.Router
 	RegisteredRoutes *[]string
+	// StaticFilesMaxAge is the max-age of the Cache-Control header of the static files added after it is set. If it
+	// is 0, the clients revalidate the files on every request using their ETag and Last-Modified headers.
+	StaticFilesMaxAge time.Duration
 }
 
 type Middleware func(handler http.Handler) http.Handler
@@ -51,11 +57,23 @@ func (rou *Router) UseMiddleware(mws ...Middleware) {
 
 type staticFileConfig struct {
 	directoryName string
+	maxAge        time.Duration
+	// spaIndexFile is served for the paths which are neither files nor assets, so that the routes of a single page
+	// application are handled by its client side router.
+	spaIndexFile string
 }
 
 func (rou *Router) AddStaticFiles(endpoint, dirName string) {
-	cfg := staticFileConfig{directoryName: dirName}
+	rou.addStaticFiles(endpoint, staticFileConfig{directoryName: dirName, maxAge: rou.StaticFilesMaxAge})
+}
+
+// AddStaticFilesWithSPA serves the static files of a single page application, serving indexFile for the GET requests
+// to paths which do not exist and have no file extension, e.g. /dashboard/settings.
+func (rou *Router) AddStaticFilesWithSPA(endpoint, dirName, indexFile string) {
+	rou.addStaticFiles(endpoint, staticFileConfig{directoryName: dirName, spaIndexFile: indexFile, maxAge: rou.StaticFilesMaxAge})
+}
 
+func (rou *Router) addStaticFiles(endpoint string, cfg staticFileConfig) {
 	fileServer := http.FileServer(http.Dir(cfg.directoryName))
 
 	if endpoint == "/" {
@@ -87,6 +105,54 @@ func (staticConfig staticFileConfig) staticHandler(fileServer http.Handler) http
 			return
 		}
 
+		if staticConfig.isSPARoute(r, absPath, fileName) {
+			indexPath := filepath.Join(staticConfig.directoryName, staticConfig.spaIndexFile)
+
+			staticConfig.setCacheHeaders(w, indexPath)
+			http.ServeFile(w, r, indexPath)
+
+			return
+		}
+
+		staticConfig.setCacheHeaders(w, absPath)
 		fileServer.ServeHTTP(w, r)
 	})
 }
+
+// isSPARoute returns true if the index file of the single page application is to be served for the request, i.e. the
+// requested path does not exist and does not look like an asset, so that missing assets are still not found.
+func (staticConfig staticFileConfig) isSPARoute(r *http.Request, absPath, fileName string) bool {
+	if staticConfig.spaIndexFile == "" || (r.Method != http.MethodGet && r.Method != http.MethodHead) {
+		return false
+	}
+
+	if filepath.Ext(fileName) != "" {
+		return false
+	}
+
+	_, err := os.Stat(absPath)
+
+	return err != nil
+}
+
+// setCacheHeaders sets the ETag, derived from the modification time and size of the file, and the Cache-Control
+// headers of the file at path. The file server responds with 304 Not Modified when the If-None-Match or
+// If-Modified-Since headers of the request match the file.
+func (staticConfig staticFileConfig) setCacheHeaders(w http.ResponseWriter, path string) {
+	info, err := os.Stat(path)
+	if err == nil && info.IsDir() {
+		info, err = os.Stat(filepath.Join(path, "index.html"))
+	}
+
+	if err != nil || info.IsDir() {
+		return
+	}
+
+	w.Header().Set("ETag", fmt.Sprintf(`"%x-%x"`, info.ModTime().UnixNano(), info.Size()))
+
+	if staticConfig.maxAge > 0 {
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(staticConfig.maxAge.Seconds())))
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"gofr.dev/pkg/gofr/config"
 	"gofr.dev/pkg/gofr/container"
@@ -111,6 +112,102 @@ func TestRouter_AddStaticFiles(t *testing.T) {
 	assert.Equal(t, http.StatusForbidden, rec.Code)
 }
 
+func TestRouter_AddStaticFilesWithSPA(t *testing.T) {
+	createTestFileAndDirectory(t, "testSPADir")
+
+	defer os.RemoveAll("testSPADir")
+
+	currentWorkingDir, _ := os.Getwd()
+
+	router := NewRouter()
+	router.AddStaticFilesWithSPA("/", currentWorkingDir+"/testSPADir", "indexTest.html")
+
+	testCases := []struct {
+		desc       string
+		method     string
+		path       string
+		statusCode int
+		spaIndex   bool
+	}{
+		{desc: "existing file", method: http.MethodGet, path: "/indexTest.html", statusCode: http.StatusOK, spaIndex: true},
+		{desc: "client side route", method: http.MethodGet, path: "/dashboard/settings", statusCode: http.StatusOK, spaIndex: true},
+		{desc: "client side route with HEAD", method: http.MethodHead, path: "/dashboard", statusCode: http.StatusOK},
+		{desc: "missing asset", method: http.MethodGet, path: "/assets/app.js", statusCode: http.StatusNotFound},
+		{desc: "client side route with POST", method: http.MethodPost, path: "/dashboard", statusCode: http.StatusNotFound},
+		{desc: "openapi file", method: http.MethodGet, path: "/openapi.json", statusCode: http.StatusForbidden},
+	}
+
+	for i, tc := range testCases {
+		req := httptest.NewRequest(tc.method, tc.path, http.NoBody)
+		rec := httptest.NewRecorder()
+
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, tc.statusCode, rec.Code, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		if tc.spaIndex {
+			assert.Contains(t, rec.Body.String(), "Testing Static File", "TEST[%d], Failed.\n%s", i, tc.desc)
+		}
+	}
+}
+
+func TestRouter_StaticFilesConditionalGet(t *testing.T) {
+	createTestFileAndDirectory(t, "testCacheDir")
+
+	defer os.RemoveAll("testCacheDir")
+
+	currentWorkingDir, _ := os.Getwd()
+
+	router := NewRouter()
+	router.AddStaticFiles("/default", currentWorkingDir+"/testCacheDir")
+
+	router.StaticFilesMaxAge = time.Hour
+	router.AddStaticFiles("/cached", currentWorkingDir+"/testCacheDir")
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/default/indexTest.html", http.NoBody))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "no-cache", rec.Header().Get("Cache-Control"))
+
+	etag, lastModified := rec.Header().Get("ETag"), rec.Header().Get("Last-Modified")
+
+	require.NotEmpty(t, etag)
+	require.NotEmpty(t, lastModified)
+
+	testCases := []struct {
+		desc         string
+		path         string
+		header       string
+		value        string
+		statusCode   int
+		cacheControl string
+	}{
+		{desc: "matching etag", path: "/default/indexTest.html", header: "If-None-Match", value: etag,
+			statusCode: http.StatusNotModified, cacheControl: "no-cache"},
+		{desc: "changed etag", path: "/default/indexTest.html", header: "If-None-Match", value: `"changed"`,
+			statusCode: http.StatusOK, cacheControl: "no-cache"},
+		{desc: "not modified since", path: "/default/indexTest.html", header: "If-Modified-Since", value: lastModified,
+			statusCode: http.StatusNotModified, cacheControl: "no-cache"},
+		{desc: "configured max-age", path: "/cached/indexTest.html", header: "If-None-Match", value: etag,
+			statusCode: http.StatusNotModified, cacheControl: "public, max-age=3600"},
+		{desc: "missing file", path: "/cached/missing.html", statusCode: http.StatusNotFound},
+	}
+
+	for i, tc := range testCases {
+		req := httptest.NewRequest(http.MethodGet, tc.path, http.NoBody)
+		if tc.header != "" {
+			req.Header.Set(tc.header, tc.value)
+		}
+
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		assert.Equal(t, tc.statusCode, rec.Code, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.cacheControl, rec.Header().Get("Cache-Control"), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
 func createTestFileAndDirectory(t *testing.T, dirName string) {
 	t.Helper()
 
@@ -0,0 +1,394 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+var (
+	errUnknownValidationRule = errors.New("unknown validation rule")
+	errInvalidRuleParam      = errors.New("invalid validation rule parameter")
+
+	uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+	// checkedTypes caches the result of checking the rules of the struct types which have been validated.
+	checkedTypes sync.Map
+)
+
+// Validate validates the fields of the struct v, or of the struct v points to, using their `validate` tags, and returns
+// an ErrorValidation listing every field which failed. The tags are comma separated rules, following the syntax of
+// github.com/go-playground/validator:
+//
+//	type User struct {
+//		Name  string   `json:"name" validate:"required,min=3"`
+//		Email string   `json:"email" validate:"omitempty,email"`
+//		Role  string   `json:"role" validate:"oneof=admin member"`
+//		Tags  []string `json:"tags" validate:"max=5"`
+//	}
+//
+// The supported rules are required, omitempty, min, max, len, gt, gte, lt, lte, oneof, email, url and uuid. min, max
+// and len apply to the length of strings, slices and maps, and to the value of numbers. The nested structs, and
+// the slices of structs, are validated as well. The fields are named by their JSON name in the errors.
+//
+// The rules of all the fields of a struct type are checked the first time a value of the type is validated, whatever
+// the values of the fields, and an unknown rule, an invalid parameter or a rule which cannot be applied to the type of
+// the field makes every validation of the type return an error naming the field, instead of an ErrorValidation.
+func Validate(v any) error {
+	val := reflect.ValueOf(v)
+	for val.Kind() == reflect.Pointer {
+		if val.IsNil() {
+			return nil
+		}
+
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return nil
+	}
+
+	if err := checkType(val.Type()); err != nil {
+		return err
+	}
+
+	var fieldErrors []FieldError
+
+	if err := validateStruct(val, "", &fieldErrors); err != nil {
+		return err
+	}
+
+	if len(fieldErrors) > 0 {
+		return ErrorValidation{Fields: fieldErrors}
+	}
+
+	return nil
+}
+
+func validateStruct(val reflect.Value, prefix string, fieldErrors *[]FieldError) error {
+	typ := val.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		// the exported fields of the unexported embedded structs are still decoded from JSON
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+
+		name := prefix + fieldName(field)
+		value := val.Field(i)
+
+		if tag := field.Tag.Get("validate"); tag != "" && tag != "-" {
+			rule, message, err := validateField(value, tag)
+			if err != nil {
+				return fmt.Errorf("field %s: %w", name, err)
+			}
+
+			if rule != "" {
+				*fieldErrors = append(*fieldErrors, FieldError{Field: name, Rule: rule, Message: name + " " + message})
+
+				continue
+			}
+		}
+
+		// the fields of the embedded structs are the fields of the struct in JSON
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			name = strings.TrimSuffix(prefix, ".")
+		}
+
+		if err := validateNested(value, name, fieldErrors); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateNested validates the structs held by value, directly, through a pointer or as the elements of a slice.
+func validateNested(value reflect.Value, name string, fieldErrors *[]FieldError) error {
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+
+		value = value.Elem()
+	}
+
+	switch value.Kind() { //nolint:exhaustive // only the structs and their collections are validated
+	case reflect.Struct:
+		if name != "" {
+			name += "."
+		}
+
+		return validateStruct(value, name, fieldErrors)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := validateNested(value.Index(i), fmt.Sprintf("%s[%d]", name, i), fieldErrors); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkType checks the rules of the fields of the struct type, and of the structs nested in it, once for each type.
+func checkType(typ reflect.Type) error {
+	if err, ok := checkedTypes.Load(typ); ok {
+		if err == nil {
+			return nil
+		}
+
+		return err.(error)
+	}
+
+	err := checkStruct(typ, make(map[reflect.Type]bool))
+
+	checkedTypes.Store(typ, err)
+
+	return err
+}
+
+func checkStruct(typ reflect.Type, seen map[reflect.Type]bool) error {
+	if seen[typ] {
+		return nil
+	}
+
+	seen[typ] = true
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+
+		if tag := field.Tag.Get("validate"); tag != "" && tag != "-" {
+			if err := checkTag(field.Type, tag); err != nil {
+				return fmt.Errorf("field %s of %s: %w", field.Name, typ, err)
+			}
+		}
+
+		if err := checkNested(field.Type, seen); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkNested checks the rules of the struct types held by typ, directly, through a pointer or as the elements of a
+// slice.
+func checkNested(typ reflect.Type, seen map[reflect.Type]bool) error {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() { //nolint:exhaustive // only the structs and their collections are validated
+	case reflect.Struct:
+		return checkStruct(typ, seen)
+	case reflect.Slice, reflect.Array:
+		return checkNested(typ.Elem(), seen)
+	}
+
+	return nil
+}
+
+// checkTag returns an error if a rule of the tag is unknown, has an invalid parameter or cannot be applied to typ.
+func checkTag(typ reflect.Type, tag string) error {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	for _, rule := range strings.Split(tag, ",") {
+		rule, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+
+		switch rule {
+		case "required", "omitempty", "oneof":
+		case "min", "max", "len", "gt", "gte", "lt", "lte":
+			if _, err := strconv.ParseFloat(param, 64); err != nil {
+				return fmt.Errorf("%w: %s=%s", errInvalidRuleParam, rule, param)
+			}
+
+			switch typ.Kind() { //nolint:exhaustive // the other kinds cannot be compared
+			case reflect.String, reflect.Slice, reflect.Array, reflect.Map,
+				reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+				reflect.Float32, reflect.Float64:
+			default:
+				return fmt.Errorf("%w: %s cannot be applied to %s", errInvalidRuleParam, rule, typ.Kind())
+			}
+		case "email", "url", "uuid":
+			if typ.Kind() != reflect.String {
+				return fmt.Errorf("%w: %s cannot be applied to %s", errInvalidRuleParam, rule, typ.Kind())
+			}
+		default:
+			return fmt.Errorf("%w: %s", errUnknownValidationRule, rule)
+		}
+	}
+
+	return nil
+}
+
+// validateField returns the first rule of the tag which the value does not satisfy along with the message describing
+// the failure, or an empty rule if the value satisfies all of them.
+func validateField(value reflect.Value, tag string) (failedRule, message string, err error) {
+	for _, rule := range strings.Split(tag, ",") {
+		rule, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+
+		switch rule {
+		case "required":
+			if value.IsZero() {
+				return rule, "is required", nil
+			}
+
+			continue
+		case "omitempty":
+			if value.IsZero() {
+				return "", "", nil
+			}
+
+			continue
+		}
+
+		for value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				return "", "", nil
+			}
+
+			value = value.Elem()
+		}
+
+		message, err := checkRule(value, rule, param)
+		if err != nil {
+			return "", "", err
+		}
+
+		if message != "" {
+			return rule, message, nil
+		}
+	}
+
+	return "", "", nil
+}
+
+// checkRule returns the message describing why value does not satisfy the rule, or an empty string if it does.
+func checkRule(value reflect.Value, rule, param string) (string, error) {
+	switch rule {
+	case "min", "max", "len", "gt", "gte", "lt", "lte":
+		return compare(value, rule, param)
+	case "oneof":
+		options := strings.Fields(param)
+		for _, option := range options {
+			if formatValue(value) == option {
+				return "", nil
+			}
+		}
+
+		return "must be one of: " + strings.Join(options, ", "), nil
+	case "email":
+		addr, err := mail.ParseAddress(value.String())
+		if err != nil || addr.Address != value.String() {
+			return "must be a valid email address", nil
+		}
+	case "url":
+		u, err := url.ParseRequestURI(value.String())
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return "must be a valid URL", nil
+		}
+	case "uuid":
+		if !uuidRegex.MatchString(value.String()) {
+			return "must be a valid UUID", nil
+		}
+	default:
+		return "", fmt.Errorf("%w: %s", errUnknownValidationRule, rule)
+	}
+
+	return "", nil
+}
+
+// compare checks the length of strings and collections, or the value of numbers, against the param of the rule.
+func compare(value reflect.Value, rule, param string) (string, error) {
+	limit, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s=%s", errInvalidRuleParam, rule, param)
+	}
+
+	var actual float64
+
+	unit := ""
+
+	switch value.Kind() { //nolint:exhaustive // the other kinds cannot be compared
+	case reflect.String:
+		actual, unit = float64(utf8.RuneCountInString(value.String())), " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		actual, unit = float64(value.Len()), " items"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		actual = float64(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		actual = float64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		actual = value.Float()
+	default:
+		return "", fmt.Errorf("%w: %s cannot be applied to %s", errInvalidRuleParam, rule, value.Kind())
+	}
+
+	var (
+		ok      bool
+		message string
+	)
+
+	switch rule {
+	case "min", "gte":
+		ok, message = actual >= limit, "must be at least "
+	case "max", "lte":
+		ok, message = actual <= limit, "must be at most "
+	case "len":
+		ok, message = actual == limit, "must be exactly "
+	case "gt":
+		ok, message = actual > limit, "must be greater than "
+	case "lt":
+		ok, message = actual < limit, "must be less than "
+	}
+
+	if ok {
+		return "", nil
+	}
+
+	return message + param + unit, nil
+}
+
+// formatValue returns the value as a string, without using Interface as the values of the fields of unexported
+// embedded structs cannot be accessed using it.
+func formatValue(value reflect.Value) string {
+	switch value.Kind() { //nolint:exhaustive // the other kinds are formatted by reflect
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool())
+	default:
+		return value.String()
+	}
+}
+
+// fieldName returns the JSON name of the field, as it is the one known to the clients.
+func fieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+
+	return name
+}
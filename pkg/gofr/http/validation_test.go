@@ -0,0 +1,182 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type address struct {
+	City string `json:"city" validate:"required"`
+}
+
+type base struct {
+	ID string `json:"id" validate:"omitempty,uuid"`
+}
+
+type user struct {
+	base
+
+	Name     string    `json:"name" validate:"required,min=3,max=10"`
+	Email    string    `json:"email" validate:"omitempty,email"`
+	Age      int       `json:"age" validate:"gte=18,lt=130"`
+	Role     string    `json:"role" validate:"oneof=admin member"`
+	Website  *string   `json:"website" validate:"omitempty,url"`
+	Code     string    `json:"code" validate:"omitempty,len=4"`
+	Level    int       `json:"level" validate:"oneof=1 2 3"`
+	Tags     []string  `json:"tags" validate:"max=2"`
+	Score    float64   `json:"score" validate:"gt=0"`
+	Address  address   `json:"address"`
+	Previous []address `json:"previous"`
+}
+
+func TestValidate(t *testing.T) {
+	website, invalidWebsite := "https://gofr.dev", "gofr.dev"
+
+	valid := user{
+		base:     base{ID: "0f8fad5b-d9cb-469f-a165-70867728950e"},
+		Name:     "gofr",
+		Email:    "gofr@gofr.dev",
+		Age:      18,
+		Role:     "admin",
+		Website:  &website,
+		Code:     "ab12",
+		Level:    2,
+		Tags:     []string{"go"},
+		Score:    0.5,
+		Address:  address{City: "Bengaluru"},
+		Previous: []address{{City: "Delhi"}},
+	}
+
+	tests := []struct {
+		desc   string
+		modify func(u *user)
+		fields []FieldError
+	}{
+		{"valid", func(*user) {}, nil},
+		{"optional fields omitted", func(u *user) { u.ID, u.Email, u.Website, u.Code = "", "", nil, "" }, nil},
+		{"required", func(u *user) { u.Name = "" }, []FieldError{{"name", "required", "name is required"}}},
+		{"min length", func(u *user) { u.Name = "go" },
+			[]FieldError{{"name", "min", "name must be at least 3 characters"}}},
+		{"max length", func(u *user) { u.Name = "gofr-framework" },
+			[]FieldError{{"name", "max", "name must be at most 10 characters"}}},
+		{"email", func(u *user) { u.Email = "gofr" },
+			[]FieldError{{"email", "email", "email must be a valid email address"}}},
+		{"numbers", func(u *user) { u.Age, u.Score = 17, 0 }, []FieldError{
+			{"age", "gte", "age must be at least 18"},
+			{"score", "gt", "score must be greater than 0"},
+		}},
+		{"oneof", func(u *user) { u.Role = "owner" },
+			[]FieldError{{"role", "oneof", "role must be one of: admin, member"}}},
+		{"oneof numbers", func(u *user) { u.Level = 4 },
+			[]FieldError{{"level", "oneof", "level must be one of: 1, 2, 3"}}},
+		{"url", func(u *user) { u.Website = &invalidWebsite },
+			[]FieldError{{"website", "url", "website must be a valid URL"}}},
+		{"exact length", func(u *user) { u.Code = "abc" },
+			[]FieldError{{"code", "len", "code must be exactly 4 characters"}}},
+		{"slice length", func(u *user) { u.Tags = []string{"a", "b", "c"} },
+			[]FieldError{{"tags", "max", "tags must be at most 2 items"}}},
+		{"embedded struct", func(u *user) { u.ID = "1" }, []FieldError{{"id", "uuid", "id must be a valid UUID"}}},
+		{"nested structs", func(u *user) { u.Address.City, u.Previous = "", []address{{}, {City: "Delhi"}, {}} },
+			[]FieldError{
+				{"address.city", "required", "address.city is required"},
+				{"previous[0].city", "required", "previous[0].city is required"},
+				{"previous[2].city", "required", "previous[2].city is required"},
+			}},
+	}
+
+	for i, tc := range tests {
+		u := valid
+		tc.modify(&u)
+
+		err := Validate(&u)
+
+		if tc.fields == nil {
+			require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+			continue
+		}
+
+		assert.Equal(t, ErrorValidation{Fields: tc.fields}, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestValidate_InvalidRules(t *testing.T) {
+	tests := []struct {
+		desc  string
+		value any
+		err   error
+	}{
+		{"unknown rule", &struct {
+			Name string `validate:"unknown"`
+		}{Name: "gofr"}, errUnknownValidationRule},
+		{"invalid parameter", &struct {
+			Name string `validate:"min=three"`
+		}{Name: "gofr"}, errInvalidRuleParam},
+		{"rule not applicable to the type", &struct {
+			Enabled bool `validate:"min=1"`
+		}{Enabled: true}, errInvalidRuleParam},
+		{"format rule not applicable to the type", &struct {
+			Count int `validate:"email"`
+		}{Count: 1}, errInvalidRuleParam},
+		{"unknown rule skipped by omitempty", &struct {
+			Name string `validate:"omitempty,unknown"`
+		}{}, errUnknownValidationRule},
+		{"unknown rule of a nested struct", &struct {
+			Addresses []struct {
+				City string `validate:"required,city"`
+			}
+		}{}, errUnknownValidationRule},
+	}
+
+	for i, tc := range tests {
+		require.ErrorIs(t, Validate(tc.value), tc.err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestValidate_InvalidRuleMessage(t *testing.T) {
+	type product struct {
+		Name string `validate:"required,unknown"`
+	}
+
+	err := Validate(&product{Name: "gofr"})
+
+	require.ErrorIs(t, err, errUnknownValidationRule)
+	assert.Equal(t, "field Name of http.product: unknown validation rule: unknown", err.Error())
+}
+
+type category struct {
+	Name     string     `json:"name" validate:"required"`
+	Children []category `json:"children"`
+}
+
+func TestValidate_RecursiveType(t *testing.T) {
+	err := Validate(&category{Name: "drinks", Children: []category{{}}})
+
+	assert.Equal(t, ErrorValidation{Fields: []FieldError{{"children[0].name", "required", "children[0].name is required"}}}, err)
+}
+
+func TestValidate_NotStruct(t *testing.T) {
+	var u *user
+
+	require.NoError(t, Validate(u))
+	require.NoError(t, Validate(&[]string{}))
+}
+
+func TestErrorValidation_Response(t *testing.T) {
+	err := ErrorValidation{Fields: []FieldError{
+		{"name", "required", "name is required"},
+		{"age", "gte", "age must be at least 18"},
+	}}
+
+	recorder := httptest.NewRecorder()
+	NewResponder(recorder, http.MethodPost).Respond(nil, err)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.JSONEq(t, `{"error":{"message":"'2' invalid field(s): name, age","details":[
+		{"field":"name","rule":"required","message":"name is required"},
+		{"field":"age","rule":"gte","message":"age must be at least 18"}]}}`, recorder.Body.String())
+}
@@ -2,6 +2,8 @@ package gofr
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net/http"
@@ -9,6 +11,8 @@ import (
 	"os"
 	"time"
 
+	gWebsocket "github.com/gorilla/websocket"
+
 	"gofr.dev/pkg/gofr/container"
 	gofrHTTP "gofr.dev/pkg/gofr/http"
 	"gofr.dev/pkg/gofr/http/middleware"
@@ -22,18 +26,28 @@ type httpServer struct {
 	srv      *http.Server
 	certFile string
 	keyFile  string
+
+	trustedProxies *middleware.TrustedProxies
+
+	// clientCAFile is the file of the CAs signing the client certificates, which the server requires when it is set.
+	clientCAFile string
 }
 
 var (
 	errInvalidCertificateFile = errors.New("invalid certificate file")
 	errInvalidKeyFile         = errors.New("invalid key file")
+	errInvalidClientCAFile    = errors.New("invalid client CA file")
+	errClientCAWithoutTLS     = errors.New("client CA file is set without a certificate and key file")
 )
 
-func newHTTPServer(c *container.Container, port int, middlewareConfigs map[string]string) *httpServer {
+func newHTTPServer(c *container.Container, port int, middlewareConfigs map[string]string, routeMetrics bool) *httpServer {
 	r := gofrHTTP.NewRouter()
 	wsManager := websocket.New()
+	trustedProxies := &middleware.TrustedProxies{}
 
 	r.Use(
+		middleware.ClientIP(trustedProxies),
+		middleware.RequestID,
 		middleware.WSHandlerUpgrade(c, wsManager),
 		middleware.Tracer,
 		middleware.Logging(c.Logger),
@@ -41,10 +55,15 @@ func newHTTPServer(c *container.Container, port int, middlewareConfigs map[strin
 		middleware.Metrics(c.Metrics()),
 	)
 
+	if routeMetrics {
+		r.Use(middleware.RouteMetrics(c.Metrics()))
+	}
+
 	return &httpServer{
-		router: r,
-		port:   port,
-		ws:     wsManager,
+		router:         r,
+		port:           port,
+		ws:             wsManager,
+		trustedProxies: trustedProxies,
 	}
 }
 
@@ -91,6 +110,16 @@ func (s *httpServer) Run(c *container.Container) {
 			return
 		}
 
+		if s.clientCAFile != "" {
+			tlsConfig, err := clientAuthTLSConfig(s.clientCAFile)
+			if err != nil {
+				c.Error(err)
+				return
+			}
+
+			s.srv.TLSConfig = tlsConfig
+		}
+
 		// Start HTTPS server with TLS
 		if err := s.srv.ListenAndServeTLS(s.certFile, s.keyFile); err != nil {
 			c.Errorf("error while listening to https server, err: %v", err)
@@ -111,6 +140,11 @@ func (s *httpServer) Shutdown(ctx context.Context) error {
 	}
 
 	return ShutdownWithContext(ctx, func(ctx context.Context) error {
+		// the WebSocket connections are hijacked from the server, so they are not closed by its shutdown
+		if s.ws != nil {
+			s.ws.CloseAll(gWebsocket.CloseGoingAway, "server shutting down")
+		}
+
 		return s.srv.Shutdown(ctx)
 	}, func() error {
 		if err := s.srv.Close(); err != nil {
@@ -132,3 +166,33 @@ func validateCertificateAndKeyFiles(certificateFile, keyFile string) error {
 
 	return nil
 }
+
+// clientAuthTLSConfig returns the TLS config of a server requiring the clients to present a certificate signed by one
+// of the CAs of caFile, i.e. mutual TLS.
+func clientAuthTLSConfig(caFile string) (*tls.Config, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w : %v", errInvalidClientCAFile, err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("%w : no certificate found in %v", errInvalidClientCAFile, caFile)
+	}
+
+	return &tls.Config{
+		ClientCAs:  pool,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
+
+// checkClientCA returns an error when the client CA file is set without the certificate and key files of the server.
+// The client certificates are only verified over TLS, so the server would otherwise accept any client.
+func checkClientCA(clientCAFile, certFile, keyFile string) error {
+	if clientCAFile != "" && (certFile == "" || keyFile == "") {
+		return errClientCAWithoutTLS
+	}
+
+	return nil
+}
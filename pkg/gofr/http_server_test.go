@@ -2,10 +2,21 @@ package gofr
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"io"
+	"math/big"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -211,6 +222,171 @@ func TestValidateCertificateAndKeyFiles_Error(t *testing.T) {
 	}
 }
 
+func TestRun_ClientCertificateAuth(t *testing.T) {
+	dir := t.TempDir()
+	caFile, caCert, caKey := writeTestCA(t, dir)
+	serverCert, serverKey := writeTestCert(t, dir, "server", caCert, caKey, x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := writeTestCert(t, dir, "client", caCert, caKey, x509.ExtKeyUsageClientAuth)
+
+	port := testutil.GetFreePort(t)
+	c := &container.Container{Logger: logging.NewLogger(logging.INFO)}
+
+	router := &gofrHTTP.Router{}
+	router.Add(http.MethodGet, "/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := newContext(nil, gofrHTTP.NewRequest(r), c)
+
+		_, _ = io.WriteString(w, ctx.ClientCertCN())
+	}))
+
+	server := &httpServer{router: router, port: port, certFile: serverCert, keyFile: serverKey, clientCAFile: caFile}
+
+	go server.Run(c)
+
+	t.Cleanup(func() { _ = server.Shutdown(context.Background()) })
+
+	time.Sleep(100 * time.Millisecond)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(caCert)
+
+	cert, err := tls.LoadX509KeyPair(clientCert, clientKey)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc         string
+		certificates []tls.Certificate
+		expCN        string
+		expErr       bool
+	}{
+		{desc: "client certificate", certificates: []tls.Certificate{cert}, expCN: "client"},
+		{desc: "no client certificate", expErr: true},
+	}
+
+	for i, tc := range testCases {
+		client := &http.Client{
+			Timeout: time.Second,
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{
+				RootCAs:      pool,
+				Certificates: tc.certificates,
+				MinVersion:   tls.VersionTLS12,
+			}},
+		}
+
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet,
+			fmt.Sprintf("https://localhost:%d", port), http.NoBody)
+
+		resp, err := client.Do(req)
+		if tc.expErr {
+			require.Error(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+			continue
+		}
+
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		assert.Equal(t, tc.expCN, string(body), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestClientAuthTLSConfig_Error(t *testing.T) {
+	_, err := clientAuthTLSConfig("non-existent-ca.pem")
+	require.ErrorIs(t, err, errInvalidClientCAFile)
+
+	// an empty file has no certificate
+	_, err = clientAuthTLSConfig(createTempCertFile(t))
+	require.ErrorIs(t, err, errInvalidClientCAFile)
+}
+
+func Test_checkClientCA(t *testing.T) {
+	tests := []struct {
+		desc                            string
+		clientCAFile, certFile, keyFile string
+		err                             error
+	}{
+		{"client CA with certificate and key", "ca.pem", "cert.pem", "key.pem", nil},
+		{"no client CA", "", "", "", nil},
+		{"client CA without certificate and key", "ca.pem", "", "", errClientCAWithoutTLS},
+		{"client CA without key", "ca.pem", "cert.pem", "", errClientCAWithoutTLS},
+	}
+
+	for i, tc := range tests {
+		err := checkClientCA(tc.clientCAFile, tc.certFile, tc.keyFile)
+
+		assert.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+// writeTestCA writes the certificate of a self-signed CA to dir.
+func writeTestCA(t *testing.T, dir string) (caFile string, caCert *x509.Certificate, caKey *ecdsa.PrivateKey) {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "gofr-test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	caCert, err = x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	caFile = filepath.Join(dir, "ca.pem")
+	writeTestPEM(t, caFile, "CERTIFICATE", der)
+
+	return caFile, caCert, caKey
+}
+
+// writeTestCert writes a certificate for localhost with name as its common name, signed by the CA, to dir.
+func writeTestCert(t *testing.T, dir, name string, caCert *x509.Certificate, caKey *ecdsa.PrivateKey,
+	usage x509.ExtKeyUsage) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile = filepath.Join(dir, name+".pem"), filepath.Join(dir, name+"-key.pem")
+	writeTestPEM(t, certFile, "CERTIFICATE", der)
+	writeTestPEM(t, keyFile, "EC PRIVATE KEY", keyDER)
+
+	return certFile, keyFile
+}
+
+func writeTestPEM(t *testing.T, file, blockType string, der []byte) {
+	t.Helper()
+
+	err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600)
+	require.NoError(t, err)
+}
+
 // Helper function to create a temporary key file.
 func createTempKeyFile(t *testing.T) string {
 	t.Helper()
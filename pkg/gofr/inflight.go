@@ -0,0 +1,107 @@
+package gofr
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// inFlight tracks the HTTP requests and pubsub messages being handled, so that the shutdown can wait for them to
+// complete and report the ones still running when its timeout expires. A nil *inFlight tracks nothing.
+type inFlight struct {
+	mu      sync.Mutex
+	next    uint64
+	entries map[uint64]inFlightEntry
+	drained chan struct{}
+}
+
+type inFlightEntry struct {
+	name  string
+	start time.Time
+}
+
+func newInFlight() *inFlight {
+	return &inFlight{entries: make(map[uint64]inFlightEntry)}
+}
+
+// start records the start of handling name, and returns the function to call when it completes.
+func (f *inFlight) start(name string) func() {
+	if f == nil {
+		return func() {}
+	}
+
+	f.mu.Lock()
+	id := f.next
+	f.next++
+	f.entries[id] = inFlightEntry{name: name, start: time.Now()}
+	f.mu.Unlock()
+
+	return func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		delete(f.entries, id)
+
+		if len(f.entries) == 0 && f.drained != nil {
+			close(f.drained)
+			f.drained = nil
+		}
+	}
+}
+
+// wait blocks until nothing is in flight, or returns the error of ctx if it is done first.
+func (f *inFlight) wait(ctx context.Context) error {
+	if f == nil {
+		return nil
+	}
+
+	f.mu.Lock()
+
+	if len(f.entries) == 0 {
+		f.mu.Unlock()
+
+		return nil
+	}
+
+	if f.drained == nil {
+		f.drained = make(chan struct{})
+	}
+
+	drained := f.drained
+
+	f.mu.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// pending returns the names of the entries in flight along with how long they have been running, oldest first.
+func (f *inFlight) pending() []string {
+	if f == nil {
+		return nil
+	}
+
+	f.mu.Lock()
+	entries := make([]inFlightEntry, 0, len(f.entries))
+
+	for _, entry := range f.entries {
+		entries = append(entries, entry)
+	}
+	f.mu.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].start.Before(entries[j].start) })
+
+	pending := make([]string, len(entries))
+
+	for i, entry := range entries {
+		pending[i] = fmt.Sprintf("%s (running for %v)", entry.name, time.Since(entry.start).Round(time.Millisecond))
+	}
+
+	return pending
+}
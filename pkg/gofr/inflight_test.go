@@ -0,0 +1,54 @@
+package gofr
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInFlight_Wait(t *testing.T) {
+	f := newInFlight()
+
+	doneFirst := f.start("GET /first")
+	doneSecond := f.start("subscriber orders")
+
+	assert.Len(t, f.pending(), 2)
+	assert.Contains(t, f.pending()[0], "GET /first (running for")
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		doneFirst()
+		doneSecond()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	require.NoError(t, f.wait(ctx))
+	assert.Empty(t, f.pending())
+}
+
+func TestInFlight_WaitTimeout(t *testing.T) {
+	f := newInFlight()
+
+	done := f.start("GET /slow")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, f.wait(ctx), context.DeadlineExceeded)
+	assert.Len(t, f.pending(), 1)
+}
+
+func TestInFlight_Nil(t *testing.T) {
+	var f *inFlight
+
+	f.start("GET /hello")()
+
+	require.NoError(t, f.wait(context.Background()))
+	assert.Empty(t, f.pending())
+}
@@ -91,3 +91,26 @@ func GetLevelFromString(level string) Level {
 		return INFO
 	}
 }
+
+// ParseLevels parses a log level followed by the levels of modules, e.g. "INFO,pubsub=DEBUG,sql=WARN", and returns
+// the level and the levels of the modules. The level defaults to INFO when it is not given.
+func ParseLevels(levels string) (level Level, moduleLevels map[string]Level) {
+	level = INFO
+	moduleLevels = make(map[string]Level)
+
+	for _, part := range strings.Split(levels, ",") {
+		part = strings.TrimSpace(part)
+
+		if module, moduleLevel, ok := strings.Cut(part, "="); ok {
+			moduleLevels[strings.TrimSpace(module)] = GetLevelFromString(strings.TrimSpace(moduleLevel))
+
+			continue
+		}
+
+		if part != "" {
+			level = GetLevelFromString(part)
+		}
+	}
+
+	return level, moduleLevels
+}
@@ -107,3 +107,25 @@ func Test_changeLevel(t *testing.T) {
 
 	assert.Equal(t, ERROR, l.level, "Test_changeLevel failed! expected level to be error ")
 }
+
+func TestParseLevels(t *testing.T) {
+	tests := []struct {
+		desc         string
+		input        string
+		level        Level
+		moduleLevels map[string]Level
+	}{
+		{"empty", "", INFO, map[string]Level{}},
+		{"level only", "WARN", WARN, map[string]Level{}},
+		{"level with modules", "INFO,pubsub=DEBUG, sql=warn", INFO,
+			map[string]Level{"pubsub": DEBUG, "sql": WARN}},
+		{"modules only", "pubsub=DEBUG", INFO, map[string]Level{"pubsub": DEBUG}},
+	}
+
+	for i, tc := range tests {
+		level, moduleLevels := ParseLevels(tc.input)
+
+		assert.Equal(t, tc.level, level, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.moduleLevels, moduleLevels, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
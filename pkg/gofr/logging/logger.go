@@ -4,7 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"os"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/term"
@@ -37,23 +41,76 @@ type Logger interface {
 	ChangeLevel(level Level)
 }
 
+// ModuleLogger is implemented by the Loggers which support per-module log levels, like the logger of GoFr. It is
+// separate from Logger so that the custom implementations of Logger are not broken.
+type ModuleLogger interface {
+	// SetModuleLevel sets the level of the logs of a module, overriding the level of the logger for the module.
+	SetModuleLevel(module string, level Level)
+	// Module returns a logger which tags its logs with the module name and logs at the level set for the module.
+	Module(name string) Logger
+}
+
+// ForModule returns the logger of the module if l is a ModuleLogger, and l itself otherwise.
+func ForModule(l Logger, name string) Logger {
+	if m, ok := l.(ModuleLogger); ok {
+		return m.Module(name)
+	}
+
+	return l
+}
+
+// SetModuleLevel sets the level of the module if l is a ModuleLogger, and does nothing otherwise.
+func SetModuleLevel(l Logger, module string, level Level) {
+	if m, ok := l.(ModuleLogger); ok {
+		m.SetModuleLevel(module, level)
+	}
+}
+
+// FieldLogger is implemented by the Loggers which support structured fields, like the logger of GoFr. It is separate
+// from Logger so that the custom implementations of Logger are not broken.
+type FieldLogger interface {
+	// WithFields returns a logger which adds the fields to all its logs, along with the fields of the logger.
+	WithFields(fields map[string]any) Logger
+}
+
+// WithFields returns the logger adding the fields to its logs if l is a FieldLogger, and l itself otherwise.
+func WithFields(l Logger, fields map[string]any) Logger {
+	if f, ok := l.(FieldLogger); ok {
+		return f.WithFields(fields)
+	}
+
+	return l
+}
+
 type logger struct {
 	level      Level
 	normalOut  io.Writer
 	errorOut   io.Writer
 	isTerminal bool
 	lock       chan struct{}
+
+	// module is the name of the module the logs are tagged with, and root the logger it was created from.
+	module string
+	root   *logger
+
+	fields map[string]any
+
+	mu           sync.RWMutex
+	moduleLevels map[string]Level
+	modules      map[string]*logger
 }
 
 type logEntry struct {
-	Level       Level     `json:"level"`
-	Time        time.Time `json:"time"`
-	Message     any       `json:"message"`
-	GofrVersion string    `json:"gofrVersion"`
+	Level       Level          `json:"level"`
+	Time        time.Time      `json:"time"`
+	Module      string         `json:"module,omitempty"`
+	Message     any            `json:"message"`
+	Fields      map[string]any `json:"fields,omitempty"`
+	GofrVersion string         `json:"gofrVersion"`
 }
 
 func (l *logger) logf(level Level, format string, args ...any) {
-	if level < l.level {
+	if level < l.effectiveLevel() {
 		return
 	}
 
@@ -65,6 +122,8 @@ func (l *logger) logf(level Level, format string, args ...any) {
 	entry := logEntry{
 		Level:       level,
 		Time:        time.Now(),
+		Module:      l.module,
+		Fields:      l.fields,
 		GofrVersion: version.Framework,
 	}
 
@@ -157,16 +216,17 @@ func (l *logger) prettyPrint(e logEntry, out io.Writer) {
 
 	// Pretty printing if the message interface defines a method PrettyPrint else print the log message
 	// This decouples the logger implementation from its usage
-	if fn, ok := e.Message.(PrettyPrint); ok {
-		fmt.Fprintf(out, "\u001B[38;5;%dm%s\u001B[0m [%s] ", e.Level.color(), e.Level.String()[0:4],
-			e.Time.Format(time.TimeOnly))
+	fmt.Fprintf(out, "\u001B[38;5;%dm%s\u001B[0m [%s] ", e.Level.color(), e.Level.String()[0:4],
+		e.Time.Format(time.TimeOnly))
 
+	if e.Module != "" {
+		fmt.Fprintf(out, "\u001B[38;5;8m%s\u001B[0m ", e.Module)
+	}
+
+	if fn, ok := e.Message.(PrettyPrint); ok {
 		fn.PrettyPrint(out)
 	} else {
-		fmt.Fprintf(out, "\u001B[38;5;%dm%s\u001B[0m [%s] ", e.Level.color(), e.Level.String()[0:4],
-			e.Time.Format(time.TimeOnly))
-
-		fmt.Fprintf(out, "%v\n", e.Message)
+		fmt.Fprintf(out, "%v%s\n", e.Message, prettyFields(e.Fields))
 	}
 }
 
@@ -216,8 +276,125 @@ func checkIfTerminal(w io.Writer) bool {
 	}
 }
 
+// ChangeLevel changes the level of the logger, or the level of the module for a logger returned by Module.
 func (l *logger) ChangeLevel(level Level) {
-	l.level = level
+	root := l.rootLogger()
+
+	if l.module != "" {
+		root.SetModuleLevel(l.module, level)
+
+		return
+	}
+
+	root.level = level
+}
+
+func (l *logger) SetModuleLevel(module string, level Level) {
+	root := l.rootLogger()
+
+	root.mu.Lock()
+	defer root.mu.Unlock()
+
+	if root.moduleLevels == nil {
+		root.moduleLevels = make(map[string]Level)
+	}
+
+	root.moduleLevels[module] = level
+}
+
+// Module returns the logger of the module, the same logger is returned for every call with the same name.
+func (l *logger) Module(name string) Logger {
+	root := l.rootLogger()
+
+	root.mu.Lock()
+	defer root.mu.Unlock()
+
+	if m, ok := root.modules[name]; ok {
+		return m
+	}
+
+	if root.modules == nil {
+		root.modules = make(map[string]*logger)
+	}
+
+	m := &logger{
+		normalOut:  root.normalOut,
+		errorOut:   root.errorOut,
+		isTerminal: root.isTerminal,
+		lock:       root.lock,
+		module:     name,
+		root:       root,
+	}
+
+	root.modules[name] = m
+
+	return m
+}
+
+func (l *logger) WithFields(fields map[string]any) Logger {
+	merged := make(map[string]any, len(l.fields)+len(fields))
+
+	maps.Copy(merged, l.fields)
+	maps.Copy(merged, fields)
+
+	return &logger{
+		normalOut:  l.normalOut,
+		errorOut:   l.errorOut,
+		isTerminal: l.isTerminal,
+		lock:       l.lock,
+		module:     l.module,
+		root:       l.rootLogger(),
+		fields:     merged,
+	}
+}
+
+// prettyFields formats the fields as space-separated key=value pairs sorted by key.
+func prettyFields(fields map[string]any) string {
+	if len(fields) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(fields))
+
+	for key := range fields {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	var b strings.Builder
+
+	for _, key := range keys {
+		fmt.Fprintf(&b, " \u001B[38;5;8m%s=\u001B[0m%v", key, fields[key])
+	}
+
+	return b.String()
+}
+
+func (l *logger) rootLogger() *logger {
+	if l.root != nil {
+		return l.root
+	}
+
+	return l
+}
+
+// effectiveLevel returns the level set for the module of the logger, or else the level of the root logger,
+// so that changes of the levels apply to the loggers of the modules created earlier.
+func (l *logger) effectiveLevel() Level {
+	root := l.rootLogger()
+
+	if l.module != "" {
+		root.mu.RLock()
+		level, ok := root.moduleLevels[l.module]
+		root.mu.RUnlock()
+
+		if ok {
+			return level
+		}
+	}
+
+	return root.level
 }
 
 // LogLevelResponder is an interface that provides a method to get the log level.
@@ -249,3 +249,88 @@ func TestPrettyPrint(t *testing.T) {
 		assert.Contains(t, outputLog, v)
 	}
 }
+
+func TestLogger_ModuleLevels(t *testing.T) {
+	logs := testutil.StdoutOutputForFunc(func() {
+		logger := NewLogger(INFO)
+		pubsub := ForModule(logger, "pubsub")
+		sql := ForModule(logger, "sql")
+
+		SetModuleLevel(logger, "pubsub", DEBUG)
+		SetModuleLevel(logger, "sql", WARN)
+
+		logger.Debug("app debug log")
+		pubsub.Debug("pubsub debug log")
+		sql.Info("sql info log")
+		sql.Warn("sql warn log")
+
+		sql.ChangeLevel(INFO)
+		sql.Info("sql info log after change")
+	})
+
+	assert.NotContains(t, logs, "app debug log")
+	assert.Contains(t, logs, `"module":"pubsub","message":"pubsub debug log"`)
+	assert.NotContains(t, logs, `"sql info log"`)
+	assert.Contains(t, logs, `"module":"sql","message":"sql warn log"`)
+	assert.Contains(t, logs, "sql info log after change")
+}
+
+func TestLogger_ModuleFollowsLevel(t *testing.T) {
+	logs := testutil.StdoutOutputForFunc(func() {
+		logger := NewLogger(INFO)
+		redis := ForModule(logger, "redis")
+
+		assert.Same(t, redis, ForModule(logger, "redis"))
+		assert.Same(t, redis, ForModule(redis, "redis"))
+
+		redis.Debug("redis debug log")
+
+		logger.ChangeLevel(DEBUG)
+		redis.Debug("redis debug log after change")
+	})
+
+	assert.NotContains(t, logs, `"redis debug log"`)
+	assert.Contains(t, logs, "redis debug log after change")
+}
+
+func TestForModule_CustomLogger(t *testing.T) {
+	// a custom logger which does not support per-module log levels
+	custom := struct{ Logger }{NewLogger(INFO)}
+
+	SetModuleLevel(custom, "sql", DEBUG)
+
+	assert.Equal(t, custom, ForModule(custom, "sql"))
+}
+
+func TestWithFields_CustomLogger(t *testing.T) {
+	// a custom logger which does not support structured fields
+	custom := struct{ Logger }{NewLogger(INFO)}
+
+	assert.Equal(t, custom, WithFields(custom, map[string]any{"user_id": 42}))
+}
+
+func TestLogger_WithFields(t *testing.T) {
+	logs := testutil.StdoutOutputForFunc(func() {
+		logger := NewLogger(INFO)
+
+		withUser := WithFields(logger, map[string]any{"user_id": 42})
+		withTenant := WithFields(withUser, map[string]any{"tenant": "acme"})
+
+		withUser.Info("user log")
+		withTenant.Info("tenant log")
+		logger.Info("plain log")
+
+		logger.ChangeLevel(WARN)
+		withTenant.Info("suppressed log")
+	})
+
+	assert.Contains(t, logs, `"message":"user log","fields":{"user_id":42}`)
+	assert.Contains(t, logs, `"message":"tenant log","fields":{"tenant":"acme","user_id":42}`)
+	assert.Contains(t, logs, `"message":"plain log","gofrVersion"`)
+	assert.NotContains(t, logs, "suppressed log")
+}
+
+func TestPrettyFields(t *testing.T) {
+	assert.Empty(t, prettyFields(nil))
+	assert.Equal(t, " \u001B[38;5;8ma=\u001B[0m1 \u001B[38;5;8mb=\u001B[0mx", prettyFields(map[string]any{"b": "x", "a": 1}))
+}
@@ -103,3 +103,15 @@ func (m *MockLogger) Logf(format string, args ...any) {
 func (m *MockLogger) ChangeLevel(level Level) {
 	m.level = level
 }
+
+// SetModuleLevel is a no-op for the MockLogger, the logs of all modules are logged at the level of the logger.
+func (*MockLogger) SetModuleLevel(string, Level) {}
+
+func (m *MockLogger) Module(string) Logger {
+	return m
+}
+
+// WithFields returns the MockLogger itself, the fields are not logged.
+func (m *MockLogger) WithFields(map[string]any) Logger {
+	return m
+}
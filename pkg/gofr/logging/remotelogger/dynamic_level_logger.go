@@ -42,6 +42,21 @@ type remoteLogger struct {
 	logging.Logger
 }
 
+// SetModuleLevel sets the level of the module on the underlying logger, if it supports per-module log levels.
+func (r remoteLogger) SetModuleLevel(module string, level logging.Level) {
+	logging.SetModuleLevel(r.Logger, module, level)
+}
+
+// Module returns the logger of the module from the underlying logger, if it supports per-module log levels.
+func (r remoteLogger) Module(name string) logging.Logger {
+	return logging.ForModule(r.Logger, name)
+}
+
+// WithFields returns the logger adding the fields from the underlying logger, if it supports structured fields.
+func (r remoteLogger) WithFields(fields map[string]any) logging.Logger {
+	return logging.WithFields(r.Logger, fields)
+}
+
 // UpdateLogLevel continuously fetches the log level from the remote configuration URL at the specified interval
 // and updates the underlying log level if it has changed.
 func (r *remoteLogger) UpdateLogLevel() {
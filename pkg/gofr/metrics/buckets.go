@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var errInvalidBuckets = errors.New("bucket boundaries must be finite numbers in strictly ascending order")
+
+// ValidateBuckets returns an error if the bucket boundaries of a histogram are not finite numbers in strictly
+// ascending order.
+func ValidateBuckets(buckets []float64) error {
+	for i, bucket := range buckets {
+		if math.IsNaN(bucket) || math.IsInf(bucket, 0) {
+			return fmt.Errorf("%w: %v", errInvalidBuckets, bucket)
+		}
+
+		if i > 0 && bucket <= buckets[i-1] {
+			return fmt.Errorf("%w: %v follows %v", errInvalidBuckets, bucket, buckets[i-1])
+		}
+	}
+
+	return nil
+}
+
+// ParseBuckets parses the comma separated bucket boundaries of a histogram, e.g. "0.1,0.5,1,5", which must be valid
+// as per ValidateBuckets.
+func ParseBuckets(value string) ([]float64, error) {
+	parts := strings.Split(value, ",")
+	buckets := make([]float64, 0, len(parts))
+
+	for _, part := range parts {
+		bucket, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", errInvalidBuckets, part)
+		}
+
+		buckets = append(buckets, bucket)
+	}
+
+	if err := ValidateBuckets(buckets); err != nil {
+		return nil, err
+	}
+
+	return buckets, nil
+}
@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBuckets(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		buckets []float64
+		valid   bool
+	}{
+		{"ascending", []float64{0.1, 0.5, 1, 5}, true},
+		{"no buckets", nil, true},
+		{"unsorted", []float64{1, 0.5, 5}, false},
+		{"duplicate", []float64{0.1, 0.5, 0.5}, false},
+		{"NaN", []float64{0.1, math.NaN()}, false},
+		{"infinite", []float64{0.1, math.Inf(1)}, false},
+	}
+
+	for i, tc := range testCases {
+		err := ValidateBuckets(tc.buckets)
+
+		assert.Equal(t, tc.valid, err == nil, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestParseBuckets(t *testing.T) {
+	buckets, err := ParseBuckets("0.05, 0.1,1,2.5")
+
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0.05, 0.1, 1, 2.5}, buckets)
+
+	for i, value := range []string{"0.1,abc", "1,0.5", "0.1,,1"} {
+		_, err := ParseBuckets(value)
+
+		require.ErrorIs(t, err, errInvalidBuckets, "TEST[%d], Failed.\n%s", i, value)
+	}
+}
@@ -0,0 +1,58 @@
+package metrics
+
+import "sync"
+
+// overflowLabelValue replaces the value of a label once the limit of distinct values for the label is reached.
+const overflowLabelValue = "__overflow__"
+
+// labelValueLimiter keeps track of the distinct values seen for each label of every metric, and replaces any new
+// value with overflowLabelValue once the configured limit is reached. This guards the metrics backend against
+// labels populated from unbounded data, like user input, which would otherwise create a new time series for every value.
+type labelValueLimiter struct {
+	limit int
+
+	mu     sync.Mutex
+	values map[string]map[string]map[string]struct{} // metric name -> label name -> label values
+}
+
+func newLabelValueLimiter(limit int) *labelValueLimiter {
+	return &labelValueLimiter{
+		limit:  limit,
+		values: make(map[string]map[string]map[string]struct{}),
+	}
+}
+
+// limitValue returns the value to be used for the label of the given metric, and whether the limit was reached for
+// the first time, which is used to log the overflow only once.
+func (l *labelValueLimiter) limitValue(metricName, label, value string) (limitedValue string, overflowed bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	labels, ok := l.values[metricName]
+	if !ok {
+		labels = make(map[string]map[string]struct{})
+		l.values[metricName] = labels
+	}
+
+	seen, ok := labels[label]
+	if !ok {
+		seen = make(map[string]struct{})
+		labels[label] = seen
+	}
+
+	if _, ok = seen[value]; ok {
+		return value, false
+	}
+
+	if len(seen) < l.limit {
+		seen[value] = struct{}{}
+
+		return value, false
+	}
+
+	// overflowLabelValue is stored as a seen value as well, so that the overflow is only reported once.
+	_, reported := seen[overflowLabelValue]
+	seen[overflowLabelValue] = struct{}{}
+
+	return overflowLabelValue, !reported
+}
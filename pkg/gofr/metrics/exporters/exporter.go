@@ -1,60 +1,150 @@
 package exporters
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
 	"go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/metric"
 	metricSdk "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
 
 	"gofr.dev/pkg/gofr/version"
 )
 
+var (
+	errMissingOTLPEndpoint   = errors.New("missing endpoint of the OTLP metrics collector")
+	errUnsupportedProtocol   = errors.New("unsupported OTLP metrics protocol, supported protocols are - grpc, http")
+	errInvalidExportInterval = errors.New("invalid OTLP metrics export interval")
+)
+
+// OTLPConfig configures the push of the metrics to an OpenTelemetry collector over OTLP.
+type OTLPConfig struct {
+	// Endpoint is the host and port of the collector, e.g. localhost:4317, optionally prefixed with the http:// or
+	// https:// scheme.
+	Endpoint string
+	// Protocol is either grpc, the default, or http.
+	Protocol string
+	// Interval is the interval between two pushes. Defaults to 60s.
+	Interval time.Duration
+	// AuthHeader is sent as the Authorization header of the pushes, if set.
+	AuthHeader string
+	// Insecure pushes the metrics without TLS. The metrics are also pushed without TLS if the scheme of the Endpoint
+	// is http://, and with TLS otherwise.
+	Insecure bool
+}
+
 func Prometheus(appName, appVersion string) metric.Meter {
-	exporter, err := prometheus.New(prometheus.WithoutTargetInfo())
+	reader, err := PrometheusReader()
 	if err != nil {
 		return nil
 	}
 
-	meter := metricSdk.NewMeterProvider(
-		metricSdk.WithReader(exporter),
+	return NewMeterProvider(appName, false, reader).Meter(appName, metric.WithInstrumentationVersion(appVersion))
+}
+
+// PrometheusReader returns a reader exposing the metrics on the Prometheus scrape endpoint of the metrics server.
+func PrometheusReader() (metricSdk.Reader, error) {
+	return prometheus.New(prometheus.WithoutTargetInfo())
+}
+
+// OTLPReader returns a reader pushing the metrics to the collector of the config periodically.
+func OTLPReader(config OTLPConfig) (metricSdk.Reader, error) {
+	if config.Endpoint == "" {
+		return nil, errMissingOTLPEndpoint
+	}
+
+	if config.Interval < 0 {
+		return nil, fmt.Errorf("%w: %v", errInvalidExportInterval, config.Interval)
+	}
+
+	var headers map[string]string
+	if config.AuthHeader != "" {
+		headers = map[string]string{"Authorization": config.AuthHeader}
+	}
+
+	var (
+		exporter metricSdk.Exporter
+		err      error
+	)
+
+	endpoint, insecure := otlpEndpoint(config.Endpoint, config.Insecure)
+
+	switch strings.ToLower(config.Protocol) {
+	case "", "grpc":
+		opts := []otlpmetricgrpc.Option{otlpmetricgrpc.WithEndpoint(endpoint), otlpmetricgrpc.WithHeaders(headers)}
+		if insecure {
+			opts = append(opts, otlpmetricgrpc.WithInsecure())
+		}
+
+		exporter, err = otlpmetricgrpc.New(context.Background(), opts...)
+	case "http":
+		opts := []otlpmetrichttp.Option{otlpmetrichttp.WithEndpoint(endpoint), otlpmetrichttp.WithHeaders(headers)}
+		if insecure {
+			opts = append(opts, otlpmetrichttp.WithInsecure())
+		}
+
+		exporter, err = otlpmetrichttp.New(context.Background(), opts...)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedProtocol, config.Protocol)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []metricSdk.PeriodicReaderOption
+	if config.Interval > 0 {
+		opts = append(opts, metricSdk.WithInterval(config.Interval))
+	}
+
+	return metricSdk.NewPeriodicReader(exporter, opts...), nil
+}
+
+// otlpEndpoint returns the host and port of the endpoint without its scheme, and whether the metrics are pushed to it
+// without TLS, which is only the case if the scheme is http:// or insecure is set.
+func otlpEndpoint(endpoint string, insecure bool) (string, bool) {
+	lower := strings.ToLower(endpoint)
+
+	switch {
+	case strings.HasPrefix(lower, "http://"):
+		return endpoint[len("http://"):], true
+	case strings.HasPrefix(lower, "https://"):
+		return endpoint[len("https://"):], insecure
+	default:
+		return endpoint, insecure
+	}
+}
+
+// NewMeterProvider returns a meter provider feeding the metrics of the application to all the readers, so that the
+// same metrics can be scraped by Prometheus and pushed over OTLP. When exemplars is set, the trace and span IDs of
+// the sampled span of the context of a measurement are recorded along with it, linking the metrics to the traces.
+func NewMeterProvider(appName string, exemplars bool, readers ...metricSdk.Reader) *metricSdk.MeterProvider {
+	filter := exemplar.AlwaysOffFilter
+	if exemplars {
+		filter = exemplar.TraceBasedFilter
+	}
+
+	opts := []metricSdk.Option{
 		metricSdk.WithResource(resource.NewWithAttributes(
 			semconv.SchemaURL,
 			semconv.ServiceNameKey.String(appName),
 			attribute.String("framework_version", version.Framework),
-		))).Meter(appName, metric.WithInstrumentationVersion(appVersion))
+		)),
+		metricSdk.WithExemplarFilter(filter),
+	}
 
-	return meter
-}
+	for _, reader := range readers {
+		opts = append(opts, metricSdk.WithReader(reader))
+	}
 
-// TODO : OTLPStdOut and OTLPMetricHTTP are not being used but has to be modified such that user can decide the exporter.
-
-// func OTLPStdOut(appName, appVersion string) metric.Meter {
-// 	exporter, err := stdoutmetric.New()
-// 	if err != nil {
-// 		return nil
-// 	}
-//
-// 	meter := metricSdk.NewMeterProvider(
-// 		metricSdk.WithResource(resource.NewSchemaless(semconv.ServiceName(appName))),
-// 		metricSdk.WithReader(metricSdk.NewPeriodicReader(exporter,
-// 			metricSdk.WithInterval(3*time.Second)))).Meter(appName, metric.WithInstrumentationVersion(appVersion))
-//
-// 	return meter
-// }
-//
-// func OTLPMetricHTTP(appName, appVersion string) metric.Meter {
-// 	exporter, err := otlpmetrichttp.New(nil,
-// 		otlpmetrichttp.WithInsecure(),
-// 		otlpmetrichttp.WithURLPath("/metrics"),
-// 		otlpmetrichttp.WithEndpoint("localhost:8000"))
-// 	if err != nil {
-// 		return nil
-// 	}
-//
-// 	meter := metricSdk.NewMeterProvider(metricSdk.WithReader(metricSdk.NewPeriodicReader(exporter,
-// 		metricSdk.WithInterval(3*time.Second)))).Meter(appName, metric.WithInstrumentationVersion(appVersion))
-//
-// 	return meter
-// }
+	return metricSdk.NewMeterProvider(opts...)
+}
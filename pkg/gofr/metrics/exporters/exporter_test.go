@@ -0,0 +1,29 @@
+package exporters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_otlpEndpoint(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		endpoint string
+		insecure bool
+		expHost  string
+		expPlain bool
+	}{
+		{desc: "host and port", endpoint: "collector:4317", expHost: "collector:4317"},
+		{desc: "insecure config", endpoint: "collector:4317", insecure: true, expHost: "collector:4317", expPlain: true},
+		{desc: "http scheme", endpoint: "http://collector:4318", expHost: "collector:4318", expPlain: true},
+		{desc: "https scheme", endpoint: "HTTPS://collector:4318", expHost: "collector:4318"},
+	}
+
+	for i, tc := range testCases {
+		host, insecure := otlpEndpoint(tc.endpoint, tc.insecure)
+
+		assert.Equal(t, tc.expHost, host, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.expPlain, insecure, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"runtime"
 
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
@@ -12,12 +13,20 @@ import (
 func GetHandler(m Manager) http.Handler {
 	var router = mux.NewRouter()
 
-	// Prometheus
-	router.NewRoute().Methods(http.MethodGet).Path("/metrics").Handler(systemMetricsHandler(m, promhttp.Handler()))
+	router.NewRoute().Methods(http.MethodGet).Path("/metrics").Handler(Handler(m))
 
 	return router
 }
 
+// Handler returns the HTTP handler serving the metrics collected by the metrics manager, to be served on any path.
+func Handler(m Manager) http.Handler {
+	// Prometheus, the OpenMetrics format being served to the scrapers requesting it, as it is the one carrying exemplars
+	handler := promhttp.InstrumentMetricHandler(prometheus.DefaultRegisterer,
+		promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{EnableOpenMetrics: true}))
+
+	return systemMetricsHandler(m, handler)
+}
+
 func systemMetricsHandler(m Manager, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var stats runtime.MemStats
@@ -3,6 +3,7 @@ package metrics
 import (
 	"context"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 )
@@ -24,6 +25,39 @@ type Manager interface {
 	SetGauge(name string, value float64, labels ...string)
 }
 
+// SummaryManager is implemented by the Managers which support summaries, like the one of GoFr. It is separate from
+// Manager so that the existing implementations of Manager are not broken, and is used by type asserting the Manager:
+//
+//	if summaries, ok := app.Metrics().(metrics.SummaryManager); ok {
+//		summaries.NewSummary("transaction_latency", "latency of transactions", 0.5, 0.95, 0.99)
+//	}
+type SummaryManager interface {
+	NewSummary(name, desc string, quantiles ...float64)
+	RecordSummary(ctx context.Context, name string, value float64, labels ...string)
+}
+
+// CounterAdder is implemented by the Managers which can increase a counter by more than 1, like the one of GoFr. It is
+// separate from Manager so that the existing implementations of Manager are not broken, and is used by type asserting
+// the Manager:
+//
+//	if adder, ok := app.Metrics().(metrics.CounterAdder); ok {
+//		adder.AddCounter(ctx, "uploaded_bytes_total", int64(len(body)))
+//	}
+type CounterAdder interface {
+	AddCounter(ctx context.Context, name string, value int64, labels ...string)
+}
+
+// CollectorRegisterer is implemented by the Managers which serve the metrics of Prometheus collectors, like the one of
+// GoFr. It is separate from Manager so that the existing implementations of Manager are not broken, and is used by
+// type asserting the Manager:
+//
+//	if registerer, ok := app.Metrics().(metrics.CollectorRegisterer); ok {
+//		registerer.RegisterCollector(collectors.NewBuildInfoCollector())
+//	}
+type CollectorRegisterer interface {
+	RegisterCollector(collector prometheus.Collector)
+}
+
 // Logger defines a simple interface for logging messages at different log levels.
 type Logger interface {
 	Error(args ...any)
@@ -33,9 +67,28 @@ type Logger interface {
 }
 
 type metricsManager struct {
-	meter  metric.Meter
-	store  Store
-	logger Logger
+	meter      metric.Meter
+	store      Store
+	logger     Logger
+	registerer prometheus.Registerer
+
+	// labelLimiter is nil when there is no limit on the number of distinct values of a label.
+	labelLimiter *labelValueLimiter
+}
+
+// Option is used to configure the metrics manager while creating it.
+type Option func(m *metricsManager)
+
+// WithLabelValueLimit limits the number of distinct values each label of a metric can have. Once the limit is reached
+// for a label, any new value of the label is recorded as "__overflow__" and a warning is logged. This protects the
+// metrics backend when a label is populated with unbounded values, like IDs or user input.
+// A limit less than or equal to zero disables the check.
+func WithLabelValueLimit(limit int) Option {
+	return func(m *metricsManager) {
+		if limit > 0 {
+			m.labelLimiter = newLabelValueLimiter(limit)
+		}
+	}
 }
 
 // Developer Note: float64Gauge is used instead of metric.Float64ObservableGauge because we need a synchronous gauge metric
@@ -46,12 +99,19 @@ type float64Gauge struct {
 }
 
 // NewMetricsManager creates a new metrics manager instance with the provided metric  meter and logger.
-func NewMetricsManager(meter metric.Meter, logger Logger) Manager {
-	return &metricsManager{
-		meter:  meter,
-		store:  newOtelStore(),
-		logger: logger,
+func NewMetricsManager(meter metric.Meter, logger Logger, opts ...Option) Manager {
+	m := &metricsManager{
+		meter:      meter,
+		store:      newOtelStore(),
+		logger:     logger,
+		registerer: prometheus.DefaultRegisterer,
 	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
 }
 
 // Developer Note : we are not checking the name or desc parameter because the OTEL
@@ -106,7 +166,16 @@ func (m *metricsManager) NewUpDownCounter(name, desc string) {
 //	[10, 100), [100, 1000), [1000, +Inf), where each range represents response times
 //	within a certain range, and the last bucket includes all values above 1000ms (represented by +Inf,
 //	which stands for positive infinity).
+//
+// The bucket boundaries must be in strictly ascending order, otherwise an error is logged and the histogram is not
+// registered, as the values would be counted in the wrong buckets.
 func (m *metricsManager) NewHistogram(name, desc string, buckets ...float64) {
+	if err := ValidateBuckets(buckets); err != nil {
+		m.logger.Errorf("invalid buckets for histogram %v: %v", name, err)
+
+		return
+	}
+
 	histogram, err := m.meter.Float64Histogram(name, metric.WithDescription(desc),
 		metric.WithExplicitBucketBoundaries(buckets...))
 	if err != nil {
@@ -142,6 +211,51 @@ func (m *metricsManager) NewGauge(name, desc string) {
 	}
 }
 
+// NewSummary registers a new summary metrics which reports the given quantiles of the recorded values,
+// without having to define buckets upfront. If no quantiles are provided, 0.5, 0.9 and 0.99 are reported.
+//
+//	Usage:
+//	m.NewSummary("api_request_latency", "Latency of API requests", 0.5, 0.95, 0.99)
+//
+// OpenTelemetry does not support summaries, so the quantiles are calculated in the application over the last 1024
+// values recorded for each set of labels, and exported as a gauge with an additional "quantile" label.
+func (m *metricsManager) NewSummary(name, desc string, quantiles ...float64) {
+	for _, q := range quantiles {
+		if q < 0 || q > 1 {
+			m.logger.Errorf("invalid quantile %v for summary %v, quantiles must be in the range [0, 1]", q, name)
+
+			return
+		}
+	}
+
+	summary := newFloat64Summary(quantiles)
+
+	_, err := m.meter.Float64ObservableGauge(name, metric.WithDescription(desc), metric.WithFloat64Callback(summary.callbackFunc))
+	if err != nil {
+		m.logger.Error(err)
+
+		return
+	}
+
+	err = m.store.setSummary(name, summary)
+	if err != nil {
+		m.logger.Error(err)
+	}
+}
+
+// RegisterCollector registers a Prometheus collector, whose metrics are served on the metrics endpoint along with
+// the metrics of the application, e.g. an existing collector of a library.
+//
+//	Usage:
+//	m.RegisterCollector(collectors.NewBuildInfoCollector())
+//
+// The metrics of the collectors are only scraped from the metrics endpoint, and are not pushed over OTLP.
+func (m *metricsManager) RegisterCollector(collector prometheus.Collector) {
+	if err := m.registerer.Register(collector); err != nil {
+		m.logger.Errorf("failed to register the metrics collector: %v", err)
+	}
+}
+
 // callbackFunc implements the callback function for the underlying asynchronous gauge
 // it observes the current state of all previous set() calls.
 func (f *float64Gauge) callbackFunc(_ context.Context, o metric.Float64Observer) error {
@@ -178,6 +292,22 @@ func (m *metricsManager) IncrementCounter(ctx context.Context, name string, labe
 	counter.Add(ctx, 1, metric.WithAttributes(m.getAttributes(name, labels...)...))
 }
 
+// AddCounter increases the specified counter metric by the value, e.g. by the size of a message to count the bytes
+// sent. Negative values are ignored by the counter, as its value cannot decrease.
+//
+//	Usage:
+//	 m.AddCounter(ctx, "sent_bytes_total", 512, "label1", "value1")
+func (m *metricsManager) AddCounter(ctx context.Context, name string, value int64, labels ...string) {
+	counter, err := m.store.getCounter(name)
+	if err != nil {
+		m.logger.Error(err)
+
+		return
+	}
+
+	counter.Add(ctx, value, metric.WithAttributes(m.getAttributes(name, labels...)...))
+}
+
 // DeltaUpDownCounter increases or decreases the last value with the value specified.
 //
 //	Usage:
@@ -241,6 +371,26 @@ func (m *metricsManager) SetGauge(name string, value float64, labels ...string)
 	gauge.set(value, attribute.NewSet(m.getAttributes(name, labels...)...))
 }
 
+// RecordSummary records the specified value in the summary metric, which is used to calculate the quantiles.
+//
+//	Usage:
+//
+//	    // Record the latency of an API request without any labels.
+//	 1. m.RecordSummary(ctx, "api_request_latency", 25.5)
+//
+//	    // Record the latency of an API request with labels.
+//	 2. m.RecordSummary(ctx, "api_request_latency", 25.5, "label1", "value1", "label2", "value2")
+func (m *metricsManager) RecordSummary(_ context.Context, name string, value float64, labels ...string) {
+	summary, err := m.store.getSummary(name)
+	if err != nil {
+		m.logger.Error(err)
+
+		return
+	}
+
+	summary.record(value, attribute.NewSet(m.getAttributes(name, labels...)...))
+}
+
 func (f *float64Gauge) set(val float64, attrs attribute.Set) {
 	f.observations[attrs] = val
 }
@@ -261,9 +411,25 @@ func (m *metricsManager) getAttributes(name string, labels ...string) []attribut
 
 	if labels != nil {
 		for i := 0; i < len(labels)-1; i += 2 {
-			attributes = append(attributes, attribute.String(labels[i], labels[i+1]))
+			attributes = append(attributes, attribute.String(labels[i], m.limitLabelValue(name, labels[i], labels[i+1])))
 		}
 	}
 
 	return attributes
 }
+
+// limitLabelValue returns the value to be recorded for the label, replacing it with "__overflow__" if the
+// label has already reached the limit of distinct values.
+func (m *metricsManager) limitLabelValue(name, label, value string) string {
+	if m.labelLimiter == nil {
+		return value
+	}
+
+	value, overflowed := m.labelLimiter.limitValue(name, label, value)
+	if overflowed {
+		m.logger.Warnf("metrics %v label %v has reached the limit of %v distinct values, new values will be recorded as %v",
+			name, label, m.labelLimiter.limit, overflowLabelValue)
+	}
+
+	return value
+}
@@ -5,9 +5,12 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/metrics/exporters"
@@ -23,11 +26,25 @@ func Test_NewMetricsManagerSuccess(t *testing.T) {
 	metrics.NewUpDownCounter("up-down-counter", "this is metric to test up-down-counter")
 	metrics.NewHistogram("histogram-test", "this is metric to test histogram")
 
+	summaries, ok := metrics.(SummaryManager)
+	require.True(t, ok, "the manager should support summaries")
+
+	summaries.NewSummary("summary-test", "this is metric to test summary", 0.5, 0.99)
+
 	metrics.SetGauge("gauge-test", 50)
 	metrics.IncrementCounter(context.Background(), "counter-test")
+
+	adder, ok := metrics.(CounterAdder)
+	require.True(t, ok, "the manager should support adding to counters")
+
+	adder.AddCounter(context.Background(), "counter-test", 4)
 	metrics.DeltaUpDownCounter(context.Background(), "up-down-counter", 10)
 	metrics.RecordHistogram(context.Background(), "histogram-test", 1)
 
+	for i := 1; i <= 100; i++ {
+		summaries.RecordSummary(context.Background(), "summary-test", float64(i))
+	}
+
 	server := httptest.NewServer(GetHandler(metrics))
 
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/metrics", http.NoBody)
@@ -45,7 +62,7 @@ func Test_NewMetricsManagerSuccess(t *testing.T) {
 	assert.Contains(t, stringBody, `counter_test_total this is metric to test counter`,
 		"TEST Failed. counter-test metrics registration failed")
 
-	assert.Contains(t, stringBody, `counter_test_total{otel_scope_name="testing-app",otel_scope_version="v1.0.0"} 1`,
+	assert.Contains(t, stringBody, `counter_test_total{otel_scope_name="testing-app",otel_scope_version="v1.0.0"} 5`,
 		"TEST Failed. gauge-test metrics registration failed")
 
 	assert.Contains(t, stringBody, `gauge_test this is metric to test gauge`, "TEST Failed. gauge-test metrics registration failed")
@@ -64,6 +81,15 @@ func Test_NewMetricsManagerSuccess(t *testing.T) {
 
 	assert.Contains(t, stringBody, `histogram_test_bucket{otel_scope_name="testing-app",otel_scope_version="v1.0.0",le="0"} 0`,
 		"TEST Failed. histogram metrics value did not reflect")
+
+	assert.Contains(t, stringBody, `summary_test this is metric to test summary`,
+		"TEST Failed. summary metrics registration failed")
+
+	assert.Contains(t, stringBody, `summary_test{otel_scope_name="testing-app",otel_scope_version="v1.0.0",quantile="0.5"} 50`,
+		"TEST Failed. summary metrics p50 value did not reflect")
+
+	assert.Contains(t, stringBody, `summary_test{otel_scope_name="testing-app",otel_scope_version="v1.0.0",quantile="0.99"} 99`,
+		"TEST Failed. summary metrics p99 value did not reflect")
 }
 
 func Test_NewMetricsManagerMetricsNotRegistered(t *testing.T) {
@@ -160,3 +186,110 @@ func Test_NewMetricsManagerLabelHighCardinality(t *testing.T) {
 
 	assert.Contains(t, log, `metrics counter-test has high cardinality: 24`, "TEST Failed. high cardinality of metrics")
 }
+
+func Test_NewMetricsManagerSummaryErrors(t *testing.T) {
+	logs := func() {
+		metrics := NewMetricsManager(exporters.Prometheus("testing-app", "v1.0.0"),
+			logging.NewMockLogger(logging.INFO))
+
+		summaries, _ := metrics.(SummaryManager)
+
+		summaries.NewSummary("invalid-summary", "summary with invalid quantile", 1.5)
+		summaries.RecordSummary(context.Background(), "summary-test", 1)
+	}
+
+	log := testutil.StderrOutputForFunc(logs)
+
+	assert.Contains(t, log, `invalid quantile 1.5 for summary invalid-summary`, "TEST Failed. invalid quantile accepted")
+	assert.Contains(t, log, `Metrics summary-test is not registered`, "TEST Failed. summary-test metrics registered")
+}
+
+func Test_NewMetricsManagerHistogramErrors(t *testing.T) {
+	logs := func() {
+		metrics := NewMetricsManager(exporters.Prometheus("testing-app", "v1.0.0"),
+			logging.NewMockLogger(logging.INFO))
+
+		metrics.NewHistogram("unsorted-histogram", "histogram with unsorted buckets", 1, 10, 5)
+		metrics.RecordHistogram(context.Background(), "unsorted-histogram", 1)
+	}
+
+	log := testutil.StderrOutputForFunc(logs)
+
+	assert.Contains(t, log, `invalid buckets for histogram unsorted-histogram`, "TEST Failed. unsorted buckets accepted")
+	assert.Contains(t, log, `Metrics unsorted-histogram is not registered`, "TEST Failed. unsorted-histogram registered")
+}
+
+func Test_NewMetricsManagerRegisterCollector(t *testing.T) {
+	collector := prometheus.NewGauge(prometheus.GaugeOpts{Name: "custom_collector_gauge", Help: "gauge of a custom collector"})
+	collector.Set(7)
+
+	defer prometheus.DefaultRegisterer.Unregister(collector)
+
+	logs := testutil.StderrOutputForFunc(func() {
+		metrics, ok := NewMetricsManager(exporters.Prometheus("testing-app", "v1.0.0"),
+			logging.NewMockLogger(logging.INFO)).(CollectorRegisterer)
+		require.True(t, ok)
+
+		metrics.RegisterCollector(collector)
+		metrics.RegisterCollector(collector)
+	})
+
+	assert.Contains(t, logs, "failed to register the metrics collector", "TEST Failed. duplicate collector registered")
+
+	// the registry is shared by the tests, which register the same metrics, so the families gathered are checked
+	families, _ := prometheus.DefaultGatherer.Gather()
+
+	var value float64
+
+	for _, family := range families {
+		if family.GetName() == "custom_collector_gauge" {
+			value = family.GetMetric()[0].GetGauge().GetValue()
+		}
+	}
+
+	assert.InDelta(t, 7.0, value, 0, "TEST Failed. collector not gathered with the metrics of the application")
+}
+
+func Test_quantile(t *testing.T) {
+	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	assert.InDelta(t, 0.0, quantile(nil, 0.5), 0)
+	assert.InDelta(t, 1.0, quantile(values, 0), 0)
+	assert.InDelta(t, 5.0, quantile(values, 0.5), 0)
+	assert.InDelta(t, 9.0, quantile(values, 0.9), 0)
+	assert.InDelta(t, 10.0, quantile(values, 1), 0)
+}
+
+func Test_NewMetricsManagerLabelValueLimit(t *testing.T) {
+	logs := func() {
+		m := NewMetricsManager(exporters.Prometheus("testing-app", "v1.0.0"),
+			logging.NewMockLogger(logging.INFO), WithLabelValueLimit(2))
+
+		manager, _ := m.(*metricsManager)
+
+		attrs := manager.getAttributes("counter-test", "user", "1")
+		assert.Equal(t, "1", attrs[0].Value.AsString())
+
+		attrs = manager.getAttributes("counter-test", "user", "2")
+		assert.Equal(t, "2", attrs[0].Value.AsString())
+
+		attrs = manager.getAttributes("counter-test", "user", "3")
+		assert.Equal(t, overflowLabelValue, attrs[0].Value.AsString())
+
+		attrs = manager.getAttributes("counter-test", "user", "4")
+		assert.Equal(t, overflowLabelValue, attrs[0].Value.AsString())
+
+		// values seen before the limit was reached are still recorded as is
+		attrs = manager.getAttributes("counter-test", "user", "1")
+		assert.Equal(t, "1", attrs[0].Value.AsString())
+
+		// limit is applied separately to every metric
+		attrs = manager.getAttributes("gauge-test", "user", "3")
+		assert.Equal(t, "3", attrs[0].Value.AsString())
+	}
+
+	log := testutil.StdoutOutputForFunc(logs)
+
+	assert.Equal(t, 1, strings.Count(log, "metrics counter-test label user has reached the limit of 2 distinct values"),
+		"TEST Failed. label value overflow not logged exactly once")
+}
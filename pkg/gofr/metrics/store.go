@@ -9,19 +9,22 @@ type store struct {
 	upDownCounter map[string]metric.Float64UpDownCounter
 	histogram     map[string]metric.Float64Histogram
 	gauge         map[string]float64Gauge
+	summary       map[string]*float64Summary
 }
 
 // Store represents a store for registered metrics. It provides methods to retrieve and manage different
-// types of metrics (counters, up-down counters, histograms, and gauges).
+// types of metrics (counters, up-down counters, histograms, gauges and summaries).
 type Store interface {
 	getCounter(name string) (metric.Int64Counter, error)
 	getUpDownCounter(name string) (metric.Float64UpDownCounter, error)
 	getHistogram(name string) (metric.Float64Histogram, error)
 	getGauge(name string) (float64Gauge, error)
+	getSummary(name string) (*float64Summary, error)
 	setCounter(name string, m metric.Int64Counter) error
 	setUpDownCounter(name string, m metric.Float64UpDownCounter) error
 	setHistogram(name string, m metric.Float64Histogram) error
 	setGauge(name string, m float64Gauge) error
+	setSummary(name string, m *float64Summary) error
 }
 
 func newOtelStore() Store {
@@ -30,6 +33,7 @@ func newOtelStore() Store {
 		upDownCounter: make(map[string]metric.Float64UpDownCounter),
 		histogram:     make(map[string]metric.Float64Histogram),
 		gauge:         make(map[string]float64Gauge),
+		summary:       make(map[string]*float64Summary),
 	}
 }
 
@@ -69,6 +73,15 @@ func (s store) getGauge(name string) (float64Gauge, error) {
 	return m, nil
 }
 
+func (s store) getSummary(name string) (*float64Summary, error) {
+	m, ok := s.summary[name]
+	if !ok {
+		return nil, metricsNotRegistered{metricsName: name}
+	}
+
+	return m, nil
+}
+
 func (s store) setCounter(name string, m metric.Int64Counter) error {
 	_, ok := s.counter[name]
 	if !ok {
@@ -112,3 +125,14 @@ func (s store) setGauge(name string, m float64Gauge) error {
 
 	return metricsAlreadyRegistered{metricsName: name}
 }
+
+func (s store) setSummary(name string, m *float64Summary) error {
+	_, ok := s.summary[name]
+	if !ok {
+		s.summary[name] = m
+
+		return nil
+	}
+
+	return metricsAlreadyRegistered{metricsName: name}
+}
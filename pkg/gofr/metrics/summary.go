@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// summaryWindowSize is the number of most recent observations, per label set, over which the quantiles are calculated.
+const summaryWindowSize = 1024
+
+// defaultSummaryQuantiles are used when no quantiles are provided while registering a summary.
+var defaultSummaryQuantiles = []float64{0.5, 0.9, 0.99}
+
+// Developer Note: OpenTelemetry does not have a summary instrument, it only supports histograms with buckets.
+// So, float64Summary calculates the quantiles itself over a sliding window of the most recent observations
+// and exports them through an asynchronous gauge, with the quantile added as the "quantile" label.
+// This is the same shape in which Prometheus exposes the quantiles of a summary.
+type float64Summary struct {
+	quantiles []float64
+
+	mu           sync.Mutex
+	observations map[attribute.Set]*summaryWindow
+}
+
+// summaryWindow is a ring buffer holding the last summaryWindowSize observations.
+type summaryWindow struct {
+	values []float64
+	next   int
+}
+
+func newFloat64Summary(quantiles []float64) *float64Summary {
+	if len(quantiles) == 0 {
+		quantiles = defaultSummaryQuantiles
+	}
+
+	return &float64Summary{
+		quantiles:    quantiles,
+		observations: make(map[attribute.Set]*summaryWindow),
+	}
+}
+
+func (s *float64Summary) record(val float64, attrs attribute.Set) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w, ok := s.observations[attrs]
+	if !ok {
+		w = &summaryWindow{values: make([]float64, 0, summaryWindowSize)}
+		s.observations[attrs] = w
+	}
+
+	if len(w.values) < summaryWindowSize {
+		w.values = append(w.values, val)
+
+		return
+	}
+
+	w.values[w.next] = val
+	w.next = (w.next + 1) % summaryWindowSize
+}
+
+// callbackFunc implements the callback function for the underlying asynchronous gauge,
+// it observes the configured quantiles of every label set.
+func (s *float64Summary) callbackFunc(_ context.Context, o metric.Float64Observer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for attrs, w := range s.observations {
+		sorted := make([]float64, len(w.values))
+		copy(sorted, w.values)
+		sort.Float64s(sorted)
+
+		for _, q := range s.quantiles {
+			labels := append(attrs.ToSlice(), attribute.String("quantile", strconv.FormatFloat(q, 'f', -1, 64)))
+
+			o.Observe(quantile(sorted, q), metric.WithAttributes(labels...))
+		}
+	}
+
+	return nil
+}
+
+// quantile returns the q-quantile of sorted values using the nearest-rank method.
+func quantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := int(math.Ceil(q*float64(len(sorted)))) - 1
+
+	return sorted[min(max(rank, 0), len(sorted)-1)]
+}
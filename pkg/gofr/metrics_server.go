@@ -2,31 +2,50 @@ package gofr
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/gorilla/mux"
+
+	"gofr.dev/pkg/gofr/config"
 	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/http/middleware"
 	"gofr.dev/pkg/gofr/metrics"
 )
 
+const defaultMetricsPath = "/metrics"
+
+var (
+	errInvalidMetricsAuth      = errors.New("METRICS_AUTH should be in the format username:password")
+	errInvalidMetricsAllowedIP = errors.New("invalid METRICS_ALLOWED_IPS")
+)
+
 type metricServer struct {
-	port int
-	srv  *http.Server
+	port   int
+	path   string
+	access metricsAccess
+	srv    *http.Server
 }
 
-func newMetricServer(port int) *metricServer {
-	return &metricServer{port: port}
+func newMetricServer(port int, path string, access metricsAccess) *metricServer {
+	return &metricServer{port: port, path: path, access: access}
 }
 
 func (m *metricServer) Run(c *container.Container) {
 	if m != nil {
 		c.Logf("Starting metrics server on port: %d", m.port)
 
+		router := mux.NewRouter()
+		router.NewRoute().Methods(http.MethodGet).Path(m.path).Handler(m.access.protect(metrics.Handler(c.Metrics())))
+
 		m.srv = &http.Server{
 			Addr:              fmt.Sprintf(":%d", m.port),
-			Handler:           metrics.GetHandler(c.Metrics()),
+			Handler:           router,
 			ReadHeaderTimeout: 5 * time.Second,
 		}
 
@@ -47,3 +66,89 @@ func (m *metricServer) Shutdown(ctx context.Context) error {
 		return m.srv.Shutdown(ctx)
 	}, nil)
 }
+
+// metricsAccess restricts the access to the metrics endpoint to the scrapers using the credentials of METRICS_AUTH
+// and from the IPs of METRICS_ALLOWED_IPS, when they are configured.
+type metricsAccess struct {
+	username, password string
+	allowed            []*net.IPNet
+}
+
+func newMetricsAccess(conf config.Config) (metricsAccess, error) {
+	var access metricsAccess
+
+	if auth := conf.Get("METRICS_AUTH"); auth != "" {
+		username, password, ok := strings.Cut(auth, ":")
+		if !ok || username == "" || password == "" {
+			return metricsAccess{}, errInvalidMetricsAuth
+		}
+
+		access.username, access.password = username, password
+	}
+
+	allowed, err := middleware.ParseNetworks(strings.Split(conf.Get("METRICS_ALLOWED_IPS"), ",")...)
+	if err != nil {
+		return metricsAccess{}, fmt.Errorf("%w, %w", errInvalidMetricsAllowedIP, err)
+	}
+
+	access.allowed = allowed
+
+	return access, nil
+}
+
+func (a metricsAccess) protect(next http.Handler) http.Handler {
+	if a.username == "" && len(a.allowed) == 0 {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !a.isAllowed(r) {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+
+			return
+		}
+
+		if a.username != "" {
+			username, password, ok := r.BasicAuth()
+			if !ok || subtle.ConstantTimeCompare([]byte(username), []byte(a.username)) != 1 ||
+				subtle.ConstantTimeCompare([]byte(password), []byte(a.password)) != 1 {
+				w.Header().Set("WWW-Authenticate", `Basic realm="metrics"`)
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isAllowed returns true if the client of the request is in the allowed networks. The client is resolved by the
+// ClientIP middleware on the HTTP server, which only trusts the forwarded headers of the trusted proxies, and is the
+// peer of the connection on the metrics server.
+func (a metricsAccess) isAllowed(r *http.Request) bool {
+	if len(a.allowed) == 0 {
+		return true
+	}
+
+	client := middleware.ClientIPFromContext(r.Context())
+	if client == "" {
+		client = r.RemoteAddr
+		if host, _, err := net.SplitHostPort(client); err == nil {
+			client = host
+		}
+	}
+
+	ip := net.ParseIP(client)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range a.allowed {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
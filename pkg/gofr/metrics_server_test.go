@@ -0,0 +1,103 @@
+package gofr
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr/config"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+func Test_newMetricsAccess(t *testing.T) {
+	tests := []struct {
+		desc   string
+		config map[string]string
+		err    error
+	}{
+		{"no access control", map[string]string{}, nil},
+		{"basic auth", map[string]string{"METRICS_AUTH": "user:password"}, nil},
+		{"allowed IPs", map[string]string{"METRICS_ALLOWED_IPS": "10.0.0.0/8, 127.0.0.1,::1"}, nil},
+		{"auth without password", map[string]string{"METRICS_AUTH": "user"}, errInvalidMetricsAuth},
+		{"auth without user", map[string]string{"METRICS_AUTH": ":password"}, errInvalidMetricsAuth},
+		{"auth with empty password", map[string]string{"METRICS_AUTH": "user:"}, errInvalidMetricsAuth},
+		{"invalid IP", map[string]string{"METRICS_ALLOWED_IPS": "10.0.0.0/8,localhost"}, errInvalidMetricsAllowedIP},
+		{"invalid CIDR", map[string]string{"METRICS_ALLOWED_IPS": "10.0.0.0/33"}, errInvalidMetricsAllowedIP},
+	}
+
+	for i, tc := range tests {
+		_, err := newMetricsAccess(config.NewMockConfig(tc.config))
+
+		assert.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func Test_metricsAccessProtect(t *testing.T) {
+	access, err := newMetricsAccess(config.NewMockConfig(map[string]string{
+		"METRICS_AUTH":        "user:password",
+		"METRICS_ALLOWED_IPS": "10.0.0.0/8,192.168.1.1",
+	}))
+	require.NoError(t, err)
+
+	handler := access.protect(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		desc               string
+		remoteAddr         string
+		username, password string
+		statusCode         int
+	}{
+		{"allowed network with credentials", "10.1.2.3:5000", "user", "password", http.StatusOK},
+		{"allowed IP with credentials", "192.168.1.1:5000", "user", "password", http.StatusOK},
+		{"allowed network without credentials", "10.1.2.3:5000", "", "", http.StatusUnauthorized},
+		{"allowed network with wrong password", "10.1.2.3:5000", "user", "wrong", http.StatusUnauthorized},
+		{"other IP with credentials", "192.168.1.2:5000", "user", "password", http.StatusForbidden},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", http.NoBody)
+		req.RemoteAddr = tc.remoteAddr
+
+		if tc.username != "" {
+			req.SetBasicAuth(tc.username, tc.password)
+		}
+
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+
+		assert.Equal(t, tc.statusCode, resp.Code, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestApp_MetricsOnHTTPPort(t *testing.T) {
+	configs := testutil.NewServerConfigs(t)
+	t.Setenv("METRICS_PORT", strconv.Itoa(configs.HTTPPort))
+	t.Setenv("METRICS_PATH", "/internal/metrics")
+	t.Setenv("METRICS_AUTH", "user:password")
+
+	app := New()
+
+	assert.Nil(t, app.metricServer, "the metrics should be served by the HTTP server")
+
+	req := httptest.NewRequest(http.MethodGet, "/internal/metrics", http.NoBody)
+
+	resp := httptest.NewRecorder()
+	app.httpServer.router.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	req.SetBasicAuth("user", "password")
+
+	resp = httptest.NewRecorder()
+	app.httpServer.router.ServeHTTP(resp, req)
+
+	// the apps of the other tests register their metrics in the same Prometheus registry, which fails the gathering
+	assert.NotEqual(t, http.StatusNotFound, resp.Code)
+	assert.NotEqual(t, http.StatusUnauthorized, resp.Code)
+}
@@ -13,10 +13,15 @@ type Datasource struct {
 	Clickhouse Clickhouse
 	Cassandra  Cassandra
 	Mongo      Mongo
+	KVStore    KVStore
 }
 
 // It is a base implementation for migration manager, on this other database drivers have been wrapped.
 
+func (*Datasource) lock(*container.Container) (func(), error) {
+	return func() {}, nil
+}
+
 func (*Datasource) checkAndCreateMigrationTable(*container.Container) error {
 	return nil
 }
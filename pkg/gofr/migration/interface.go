@@ -63,9 +63,18 @@ type Mongo interface {
 	StartSession() (any, error)
 }
 
+// KVStore is an interface representing a key-value store, e.g. BadgerDB, in which the migrations can seed keys.
+type KVStore interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key, value string) error
+	Delete(ctx context.Context, key string) error
+}
+
 // keeping the migrator interface unexported as, right now it is not being implemented directly, by the externalDB drivers.
 // keeping the implementations for externalDB at one place such that if any change in migration logic, we would change directly here.
 type migrator interface {
+	lock(c *container.Container) (release func(), err error)
+
 	checkAndCreateMigrationTable(c *container.Container) error
 	getLastMigration(c *container.Container) int64
 
@@ -0,0 +1,126 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr/container"
+)
+
+// kvMigrationKey is the key holding the records of the migrations in the key-value store, as a JSON object
+// of the records by their version.
+const kvMigrationKey = "gofr_migrations"
+
+// kvStoreDS keeps the records of the migrations in the stores supporting batch operations, as GetAll tells a key
+// which does not exist from a failure.
+type kvStoreDS struct {
+	container.BatchKVStore
+}
+
+type kvStoreMigrator struct {
+	container.BatchKVStore
+	migrator
+}
+
+type kvData struct {
+	Method    string    `json:"method"`
+	StartTime time.Time `json:"startTime"`
+	Duration  int64     `json:"duration"`
+}
+
+// apply initializes kvStoreMigrator using the KVStore interface.
+func (ds kvStoreDS) apply(m migrator) migrator {
+	return kvStoreMigrator{
+		BatchKVStore: ds.BatchKVStore,
+		migrator:     m,
+	}
+}
+
+// getLastMigration retrieves the latest migration version recorded in the key-value store.
+func (m kvStoreMigrator) getLastMigration(c *container.Container) int64 {
+	var lastMigration int64
+
+	records, err := m.records(context.Background())
+	if err != nil {
+		c.Errorf("failed to get migration record from key-value store, err: %v", err)
+
+		return -1
+	}
+
+	for version := range records {
+		lastMigration = max(lastMigration, version)
+	}
+
+	c.Debugf("key-value store last migration fetched value is: %v", lastMigration)
+
+	return max(m.migrator.getLastMigration(c), lastMigration)
+}
+
+func (m kvStoreMigrator) commitMigration(c *container.Container, data transactionData) error {
+	ctx := context.Background()
+
+	records, err := m.records(ctx)
+	if err != nil {
+		return err
+	}
+
+	records[data.MigrationNumber] = kvData{
+		Method:    "UP",
+		StartTime: data.StartTime,
+		Duration:  time.Since(data.StartTime).Milliseconds(),
+	}
+
+	value, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+
+	if err := m.BatchKVStore.Set(ctx, kvMigrationKey, string(value)); err != nil {
+		return err
+	}
+
+	c.Debugf("inserted record for migration %v in key-value store %v key", data.MigrationNumber, kvMigrationKey)
+
+	return m.migrator.commitMigration(c, data)
+}
+
+func (m kvStoreMigrator) rollback(c *container.Container, data transactionData) {
+	m.migrator.rollback(c, data)
+
+	c.Fatalf("migration %v failed", data.MigrationNumber)
+}
+
+// records returns the records of the migrations, which are empty until the first migration is committed.
+func (m kvStoreMigrator) records(ctx context.Context) (map[int64]kvData, error) {
+	// GetAll does not fail for the keys which do not exist, unlike Get
+	values, err := m.BatchKVStore.GetAll(ctx, []string{kvMigrationKey})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(map[int64]kvData)
+
+	value, ok := values[kvMigrationKey]
+	if !ok {
+		return records, nil
+	}
+
+	var byVersion map[string]kvData
+
+	if err := json.Unmarshal([]byte(value), &byVersion); err != nil {
+		return nil, err
+	}
+
+	for key, record := range byVersion {
+		version, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		records[version] = record
+	}
+
+	return records, nil
+}
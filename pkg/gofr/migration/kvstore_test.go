@@ -0,0 +1,136 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/logging"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+var errKVStoreConn = errors.New("error connecting to key-value store")
+
+func kvStoreSetup(t *testing.T) (migrator, *container.MockBatchKVStore, *container.Container) {
+	t.Helper()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mockKV := container.NewMockBatchKVStore(gomock.NewController(t))
+
+	ds := Datasource{KVStore: mockKV}
+
+	return kvStoreDS{BatchKVStore: mockKV}.apply(&ds), mockKV, mockContainer
+}
+
+func Test_KVStoreGetLastMigration(t *testing.T) {
+	migratorWithKV, mockKV, mockContainer := kvStoreSetup(t)
+
+	testCases := []struct {
+		desc   string
+		values map[string]string
+		err    error
+		resp   int64
+	}{
+		{"no migrations", map[string]string{}, nil, 0},
+		{"migrations", map[string]string{kvMigrationKey: `{"1":{"method":"UP"},"3":{"method":"UP"}}`}, nil, 3},
+		{"connection failed", nil, errKVStoreConn, -1},
+		{"invalid record", map[string]string{kvMigrationKey: `invalid`}, nil, -1},
+	}
+
+	for i, tc := range testCases {
+		mockKV.EXPECT().GetAll(gomock.Any(), []string{kvMigrationKey}).Return(tc.values, tc.err)
+
+		resp := migratorWithKV.getLastMigration(mockContainer)
+
+		assert.Equal(t, tc.resp, resp, "TEST[%v]\n %v Failed! ", i, tc.desc)
+	}
+}
+
+func Test_KVStoreCommitMigration(t *testing.T) {
+	migratorWithKV, mockKV, mockContainer := kvStoreSetup(t)
+
+	data := transactionData{MigrationNumber: 2, StartTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	mockKV.EXPECT().GetAll(gomock.Any(), []string{kvMigrationKey}).
+		Return(map[string]string{kvMigrationKey: `{"1":{"method":"UP"}}`}, nil)
+	mockKV.EXPECT().Set(gomock.Any(), kvMigrationKey, gomock.Any()).DoAndReturn(func(_ context.Context, _, value string) error {
+		assert.Contains(t, value, `"1":{"method":"UP"`)
+		assert.Contains(t, value, `"2":{"method":"UP","startTime":"2024-01-01T00:00:00Z"`)
+
+		return nil
+	})
+
+	require.NoError(t, migratorWithKV.commitMigration(mockContainer, data))
+
+	mockKV.EXPECT().GetAll(gomock.Any(), []string{kvMigrationKey}).Return(map[string]string{}, nil)
+	mockKV.EXPECT().Set(gomock.Any(), kvMigrationKey, gomock.Any()).Return(errKVStoreConn)
+
+	require.ErrorIs(t, migratorWithKV.commitMigration(mockContainer, data), errKVStoreConn)
+
+	mockKV.EXPECT().GetAll(gomock.Any(), []string{kvMigrationKey}).Return(nil, errKVStoreConn)
+
+	require.ErrorIs(t, migratorWithKV.commitMigration(mockContainer, data), errKVStoreConn)
+}
+
+func TestMigrationRunKVStore(t *testing.T) {
+	logs := testutil.StdoutOutputForFunc(func() {
+		mockContainer, _ := container.NewMockContainer(t)
+		mockKV := container.NewMockBatchKVStore(gomock.NewController(t))
+		mockContainer.KVStore = mockKV
+		mockContainer.SQL = nil
+		mockContainer.Redis = nil
+		mockContainer.Mongo = nil
+		mockContainer.Cassandra = nil
+		mockContainer.Clickhouse = nil
+		mockContainer.PubSub = nil
+		mockContainer.Logger = logging.NewMockLogger(logging.DEBUG)
+
+		mockKV.EXPECT().GetAll(gomock.Any(), []string{kvMigrationKey}).
+			Return(map[string]string{kvMigrationKey: `{"1":{"method":"UP"}}`}, nil).Times(2)
+		mockKV.EXPECT().Set(gomock.Any(), "feature:checkout", "enabled").Return(nil)
+		mockKV.EXPECT().Set(gomock.Any(), kvMigrationKey, gomock.Any()).Return(nil)
+
+		Run(map[int64]Migrate{
+			1: {UP: func(Datasource) error {
+				t.Error("migration 1 should be skipped as it already ran")
+
+				return nil
+			}},
+			2: {UP: func(d Datasource) error {
+				return d.KVStore.Set(context.Background(), "feature:checkout", "enabled")
+			}},
+		}, mockContainer)
+	})
+
+	assert.Contains(t, logs, "Migration 2 ran successfully")
+}
+
+func TestMigrationRunKVStoreWithoutBatch(t *testing.T) {
+	logs := testutil.StdoutOutputForFunc(func() {
+		mockContainer, mocks := container.NewMockContainer(t)
+		mockContainer.SQL = nil
+		mockContainer.Redis = nil
+		mockContainer.Mongo = nil
+		mockContainer.Cassandra = nil
+		mockContainer.Clickhouse = nil
+		mockContainer.PubSub = nil
+		mockContainer.Logger = logging.NewMockLogger(logging.DEBUG)
+
+		mocks.KVStore.EXPECT().Set(gomock.Any(), "feature:checkout", "enabled").Return(nil)
+
+		Run(map[int64]Migrate{
+			1: {UP: func(d Datasource) error {
+				return d.KVStore.Set(context.Background(), "feature:checkout", "enabled")
+			}},
+		}, mockContainer)
+	})
+
+	assert.Contains(t, logs, "the records of the migrations are not kept in the key-value store")
+	assert.Contains(t, logs, "Migration 1 ran successfully")
+}
@@ -46,7 +46,18 @@ func Run(migrationsMap map[int64]Migrate, c *container.Container) {
 		return
 	}
 
-	err := mg.checkAndCreateMigrationTable(c)
+	// the migrations are run by a single instance of the application at a time, the other ones wait for the lock
+	// and find the migrations already run once they acquire it.
+	release, err := mg.lock(c)
+	if err != nil {
+		c.Fatalf("failed to acquire migration lock, err: %v", err)
+
+		return
+	}
+
+	defer release()
+
+	err = mg.checkAndCreateMigrationTable(c)
 	if err != nil {
 		c.Fatalf("failed to create gofr_migration table, err: %v", err)
 
@@ -174,6 +185,21 @@ func getMigrator(c *container.Container) (Datasource, migrator, bool) {
 		c.Debug("initialized data source for Mongo")
 	}
 
+	if !isNil(c.KVStore) {
+		ok = true
+
+		ds.KVStore = c.KVStore
+
+		if store, isBatch := c.KVStore.(container.BatchKVStore); isBatch {
+			mg = kvStoreDS{store}.apply(mg)
+		} else {
+			c.Warn("the records of the migrations are not kept in the key-value store, as it does not implement " +
+				"container.BatchKVStore")
+		}
+
+		c.Debug("initialized data source for KVStore")
+	}
+
 	return ds, mg, ok
 }
 
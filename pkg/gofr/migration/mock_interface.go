@@ -451,6 +451,21 @@ func (mr *MockmigratorMockRecorder) getLastMigration(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getLastMigration", reflect.TypeOf((*Mockmigrator)(nil).getLastMigration), c)
 }
 
+// lock mocks base method.
+func (m *Mockmigrator) lock(c *container.Container) (func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "lock", c)
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// lock indicates an expected call of lock.
+func (mr *MockmigratorMockRecorder) lock(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "lock", reflect.TypeOf((*Mockmigrator)(nil).lock), c)
+}
+
 // rollback mocks base method.
 func (m *Mockmigrator) rollback(c *container.Container, data transactionData) {
 	m.ctrl.T.Helper()
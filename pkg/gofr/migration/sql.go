@@ -2,6 +2,8 @@ package migration
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 
 	"gofr.dev/pkg/gofr/container"
@@ -22,8 +24,23 @@ const (
 	insertGoFrMigrationRowMySQL = `INSERT INTO gofr_migrations (version, method, start_time,duration) VALUES (?, ?, ?, ?);`
 
 	insertGoFrMigrationRowPostgres = `INSERT INTO gofr_migrations (version, method, start_time,duration) VALUES ($1, $2, $3, $4);`
+
+	// the transaction level lock of Postgres is released along with the transaction, including when the connection
+	// is lost, and the session level lock of MySQL is released on RELEASE_LOCK or when the connection is lost.
+	lockSQLMigrationsPostgres = `SELECT pg_advisory_xact_lock($1);`
+
+	lockSQLMigrationsMySQL = `SELECT GET_LOCK(?, -1);`
+
+	unlockSQLMigrationsMySQL = `SELECT RELEASE_LOCK(?);`
+
+	// sqlMigrationLockName names the lock in MySQL, and sqlMigrationLockKey is the key of the advisory lock in Postgres,
+	// which are arbitrary values identifying the migrations among the other locks of the database.
+	sqlMigrationLockName = "gofr_migrations"
+	sqlMigrationLockKey  = 7_384_912_665_170_210_833
 )
 
+var errMigrationLockNotAcquired = errors.New("migration lock not acquired")
+
 // database/sql is the package imported so named it sqlDS.
 type sqlDS struct {
 	SQL
@@ -42,6 +59,68 @@ type sqlMigrator struct {
 	migrator
 }
 
+// lock acquires a lock on the database, which is held until release is called, in a transaction kept open for the
+// whole run, as the locks belong to the connection which acquired them and the transaction pins one connection of
+// the pool. SQLite does not support such locks, and its database is not shared between the instances anyway.
+func (d sqlMigrator) lock(c *container.Container) (func(), error) {
+	dialect := c.SQL.Dialect()
+	if dialect != "postgres" && dialect != "mysql" {
+		return d.migrator.lock(c)
+	}
+
+	tx, err := c.SQL.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	c.Debug("waiting for the SQL migration lock")
+
+	if dialect == "postgres" {
+		_, err = tx.Exec(lockSQLMigrationsPostgres, sqlMigrationLockKey)
+	} else {
+		var acquired sql.NullInt64
+
+		err = tx.QueryRow(lockSQLMigrationsMySQL, sqlMigrationLockName).Scan(&acquired)
+		if err == nil && acquired.Int64 != 1 {
+			err = errMigrationLockNotAcquired
+		}
+	}
+
+	if err != nil {
+		_ = tx.Rollback()
+
+		return nil, err
+	}
+
+	c.Debug("acquired the SQL migration lock")
+
+	release, err := d.migrator.lock(c)
+	if err != nil {
+		d.unlock(c, tx)
+
+		return nil, err
+	}
+
+	return func() {
+		release()
+		d.unlock(c, tx)
+	}, nil
+}
+
+func (sqlMigrator) unlock(c *container.Container, tx *gofrSql.Tx) {
+	if c.SQL.Dialect() == "mysql" {
+		var released sql.NullInt64
+
+		if err := tx.QueryRow(unlockSQLMigrationsMySQL, sqlMigrationLockName).Scan(&released); err != nil {
+			c.Errorf("unable to release the SQL migration lock: %v", err)
+		}
+	}
+
+	if err := tx.Rollback(); err != nil {
+		c.Errorf("unable to end the SQL migration lock transaction: %v", err)
+	}
+}
+
 func (d sqlMigrator) checkAndCreateMigrationTable(c *container.Container) error {
 	if _, err := c.SQL.Exec(createSQLGoFrMigrationsTable); err != nil {
 		return err
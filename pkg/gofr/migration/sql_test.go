@@ -6,10 +6,13 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"gofr.dev/pkg/gofr/container"
+	gofrSql "gofr.dev/pkg/gofr/datasource/sql"
+	"gofr.dev/pkg/gofr/logging"
 )
 
 func TestQuery(t *testing.T) {
@@ -264,3 +267,92 @@ func TestRollbackNoTransaction(t *testing.T) {
 	migrator := sqlMigrator{}
 	migrator.rollback(mockContainer, transactionData{})
 }
+
+func newLockContainer(t *testing.T, dialect string) (*container.Container, sqlmock.Sqlmock) {
+	t.Helper()
+
+	db, mock, _ := gofrSql.NewSQLMocksWithConfig(t, &gofrSql.DBConfig{Dialect: dialect})
+
+	return &container.Container{Logger: logging.NewMockLogger(logging.DEBUG), SQL: db}, mock
+}
+
+func TestSQLMigrator_Lock(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		dialect string
+		expect  func(mock sqlmock.Sqlmock)
+	}{
+		{"postgres", "postgres", func(mock sqlmock.Sqlmock) {
+			mock.ExpectBegin()
+			mock.ExpectExec(lockSQLMigrationsPostgres).WithArgs(sqlMigrationLockKey).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectRollback()
+		}},
+		{"mysql", "mysql", func(mock sqlmock.Sqlmock) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(lockSQLMigrationsMySQL).WithArgs(sqlMigrationLockName).
+				WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+			mock.ExpectQuery(unlockSQLMigrationsMySQL).WithArgs(sqlMigrationLockName).
+				WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+			mock.ExpectRollback()
+		}},
+		{"sqlite does not lock", "sqlite", func(sqlmock.Sqlmock) {}},
+	}
+
+	for i, tc := range testCases {
+		c, mock := newLockContainer(t, tc.dialect)
+		tc.expect(mock)
+
+		ctrl := gomock.NewController(t)
+		mockMigrator := NewMockmigrator(ctrl)
+
+		released := false
+
+		mockMigrator.EXPECT().lock(c).Return(func() { released = true }, nil)
+
+		release, err := sqlMigrator{SQL: c.SQL, migrator: mockMigrator}.lock(c)
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		release()
+
+		require.True(t, released, "TEST[%d], Failed.\n%s", i, tc.desc)
+		require.NoError(t, mock.ExpectationsWereMet(), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestSQLMigrator_LockError(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		dialect string
+		expect  func(mock sqlmock.Sqlmock)
+		err     error
+	}{
+		{"begin error", "postgres", func(mock sqlmock.Sqlmock) {
+			mock.ExpectBegin().WillReturnError(errBeginTx)
+		}, errBeginTx},
+		{"postgres lock error", "postgres", func(mock sqlmock.Sqlmock) {
+			mock.ExpectBegin()
+			mock.ExpectExec(lockSQLMigrationsPostgres).WithArgs(sqlMigrationLockKey).WillReturnError(sql.ErrConnDone)
+			mock.ExpectRollback()
+		}, sql.ErrConnDone},
+		{"mysql lock not acquired", "mysql", func(mock sqlmock.Sqlmock) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(lockSQLMigrationsMySQL).WithArgs(sqlMigrationLockName).
+				WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(nil))
+			mock.ExpectRollback()
+		}, errMigrationLockNotAcquired},
+	}
+
+	for i, tc := range testCases {
+		c, mock := newLockContainer(t, tc.dialect)
+		tc.expect(mock)
+
+		ctrl := gomock.NewController(t)
+
+		release, err := sqlMigrator{SQL: c.SQL, migrator: NewMockmigrator(ctrl)}.lock(c)
+
+		require.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.desc)
+		require.Nil(t, release, "TEST[%d], Failed.\n%s", i, tc.desc)
+		require.NoError(t, mock.ExpectationsWereMet(), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
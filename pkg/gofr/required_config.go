@@ -0,0 +1,19 @@
+package gofr
+
+// RequireConfig declares configs which must be set for the application to run. Run logs all the missing
+// configs and exits before starting any server if one of them is not set.
+func (a *App) RequireConfig(keys ...string) {
+	a.requiredConfigs = append(a.requiredConfigs, keys...)
+}
+
+func (a *App) missingConfigs() []string {
+	var missing []string
+
+	for _, key := range a.requiredConfigs {
+		if a.Config.Get(key) == "" {
+			missing = append(missing, key)
+		}
+	}
+
+	return missing
+}
@@ -0,0 +1,22 @@
+package gofr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gofr.dev/pkg/gofr/config"
+)
+
+func TestApp_RequireConfig(t *testing.T) {
+	app := &App{Config: config.NewMockConfig(map[string]string{
+		"AZURE_STORAGE_ACCOUNT": "account",
+	})}
+
+	assert.Empty(t, app.missingConfigs())
+
+	app.RequireConfig("AZURE_STORAGE_ACCOUNT", "AZURE_STORAGE_KEY")
+	app.RequireConfig("AZURE_CONTAINER")
+
+	assert.Equal(t, []string{"AZURE_STORAGE_KEY", "AZURE_CONTAINER"}, app.missingConfigs())
+}
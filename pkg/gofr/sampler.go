@@ -0,0 +1,74 @@
+package gofr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const defaultTraceRatio = 1.0
+
+// errInvalidTraceRatio is returned when the trace sample ratio is not between 0 and 1.
+var errInvalidTraceRatio = errors.New("trace sample ratio must be between 0 and 1")
+
+// ratioSampler samples root spans based on a ratio of trace IDs. The ratio can be changed while the application
+// is running, which is not possible with the sampler of an already created tracer provider.
+type ratioSampler struct {
+	sampler atomic.Pointer[sdktrace.Sampler]
+}
+
+func newRatioSampler(ratio float64) *ratioSampler {
+	s := &ratioSampler{}
+	s.setRatio(ratio)
+
+	return s
+}
+
+func (s *ratioSampler) setRatio(ratio float64) {
+	sampler := sdktrace.TraceIDRatioBased(ratio)
+	s.sampler.Store(&sampler)
+}
+
+func (s *ratioSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	return (*s.sampler.Load()).ShouldSample(p)
+}
+
+func (s *ratioSampler) Description() string {
+	return (*s.sampler.Load()).Description()
+}
+
+// SetTraceSampleRatio sets the proportion of new traces that are sampled, overriding the TRACER_RATIO config.
+// The ratio must be between 0 and 1. Requests carrying a sampling decision of an upstream service in the
+// traceparent header always follow that decision.
+func (a *App) SetTraceSampleRatio(ratio float64) {
+	if ratio < 0 || ratio > 1 {
+		a.container.Errorf("%v, got %v", errInvalidTraceRatio, ratio)
+
+		return
+	}
+
+	// the sampler is created along with the tracer provider by New, without which the ratio would not be used
+	if a.traceSampler == nil {
+		a.container.Warnf("trace sample ratio not set to %v, as tracing is not configured", ratio)
+
+		return
+	}
+
+	a.traceSampler.setRatio(ratio)
+}
+
+func parseTraceRatio(value string) (float64, error) {
+	ratio, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return defaultTraceRatio, err
+	}
+
+	if ratio < 0 || ratio > 1 {
+		return defaultTraceRatio, fmt.Errorf("%w, got %v", errInvalidTraceRatio, ratio)
+	}
+
+	return ratio, nil
+}
@@ -0,0 +1,88 @@
+package gofr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/testutil"
+)
+
+func Test_parseTraceRatio(t *testing.T) {
+	tests := []struct {
+		desc  string
+		value string
+		ratio float64
+		err   bool
+	}{
+		{"valid ratio", "0.25", 0.25, false},
+		{"sample nothing", "0", 0, false},
+		{"not a number", "abc", defaultTraceRatio, true},
+		{"ratio above 1", "1.5", defaultTraceRatio, true},
+		{"negative ratio", "-0.1", defaultTraceRatio, true},
+	}
+
+	for i, tc := range tests {
+		ratio, err := parseTraceRatio(tc.value)
+
+		assert.InDeltaf(t, tc.ratio, ratio, 0, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equalf(t, tc.err, err != nil, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestApp_SetTraceSampleRatio(t *testing.T) {
+	mockContainer, _ := container.NewMockContainer(t)
+
+	a := &App{container: mockContainer, traceSampler: newRatioSampler(1)}
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.ParentBased(a.traceSampler)))
+
+	_, span := tp.Tracer("test").Start(context.Background(), "sampled")
+	assert.True(t, span.SpanContext().IsSampled())
+
+	a.SetTraceSampleRatio(0)
+
+	_, span = tp.Tracer("test").Start(context.Background(), "not sampled")
+	assert.False(t, span.SpanContext().IsSampled())
+
+	// a sampled parent propagated from upstream is respected irrespective of the ratio
+	traceID, err := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+	require.NoError(t, err)
+
+	spanID, err := trace.SpanIDFromHex("0102030405060708")
+	require.NoError(t, err)
+
+	parent := trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled, Remote: true,
+	}))
+
+	_, span = tp.Tracer("test").Start(parent, "upstream sampled")
+	assert.True(t, span.SpanContext().IsSampled())
+
+	logs := testutil.StderrOutputForFunc(func() {
+		mockContainer, _ := container.NewMockContainer(t)
+		a.container = mockContainer
+
+		a.SetTraceSampleRatio(2)
+	})
+
+	assert.Contains(t, logs, "trace sample ratio must be between 0 and 1")
+}
+
+func TestApp_SetTraceSampleRatio_TracingNotConfigured(t *testing.T) {
+	logs := testutil.StdoutOutputForFunc(func() {
+		mockContainer, _ := container.NewMockContainer(t)
+		a := &App{container: mockContainer}
+
+		a.SetTraceSampleRatio(0.5)
+
+		assert.Nil(t, a.traceSampler)
+	})
+
+	assert.Contains(t, logs, "trace sample ratio not set to 0.5, as tracing is not configured")
+}
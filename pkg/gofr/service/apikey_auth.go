@@ -82,6 +82,13 @@ func (a *apiKeyAuthProvider) DeleteWithHeaders(ctx context.Context, path string,
 	return a.HTTP.DeleteWithHeaders(ctx, path, body, headers)
 }
 
+func (a *apiKeyAuthProvider) GetStream(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	headers = setXApiKey(headers, a.apiKey)
+
+	return a.HTTP.GetStream(ctx, path, queryParams, headers)
+}
+
 func setXApiKey(headers map[string]string, apiKey string) map[string]string {
 	if headers == nil {
 		headers = make(map[string]string)
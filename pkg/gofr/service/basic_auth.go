@@ -111,6 +111,16 @@ func (ba *basicAuthProvider) DeleteWithHeaders(ctx context.Context, path string,
 	return ba.HTTP.DeleteWithHeaders(ctx, path, body, headers)
 }
 
+func (ba *basicAuthProvider) GetStream(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	err := ba.populateHeaders(headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return ba.HTTP.GetStream(ctx, path, queryParams, headers)
+}
+
 func (ba *basicAuthProvider) populateHeaders(headers map[string]string) error {
 	if headers == nil {
 		headers = make(map[string]string)
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ErrorBulkheadFull is returned for the requests to a service which are rejected by its bulkhead, as the maximum
+// number of requests are already in flight and queued.
+type ErrorBulkheadFull struct {
+	Service string
+}
+
+func (e ErrorBulkheadFull) Error() string {
+	return fmt.Sprintf("too many concurrent requests to the service %s", e.Service)
+}
+
+func (ErrorBulkheadFull) StatusCode() int {
+	return http.StatusServiceUnavailable
+}
+
+// BulkheadConfig caps the number of concurrent requests in flight to the service, so that a slow service cannot hold
+// all the connections and goroutines of the application. The requests beyond the limit wait for a request to
+// complete, up to MaxQueue of them, and the ones beyond the queue fail fast with ErrorBulkheadFull.
+type BulkheadConfig struct {
+	// MaxConcurrent is the maximum number of requests in flight to the service. The bulkhead is disabled if it is
+	// not positive.
+	MaxConcurrent int
+	// MaxQueue is the maximum number of requests waiting for one in flight to complete. The requests are rejected
+	// as soon as MaxConcurrent requests are in flight if it is not positive.
+	MaxQueue int
+
+	metrics Metrics
+	service string
+}
+
+func (b *BulkheadConfig) AddOption(h HTTP) HTTP {
+	if b.MaxConcurrent <= 0 {
+		return h
+	}
+
+	return &bulkhead{
+		slots:   make(chan struct{}, b.MaxConcurrent),
+		queue:   make(chan struct{}, max(b.MaxQueue, 0)),
+		metrics: b.metrics,
+		service: b.service,
+		HTTP:    h,
+	}
+}
+
+// forService returns a copy of the config which counts the requests in flight and rejected of the service in the
+// metrics.
+func (b *BulkheadConfig) forService(address string, _ Logger, metrics Metrics) Options {
+	c := *b
+	c.metrics = metrics
+	c.service = address
+
+	return &c
+}
+
+type bulkhead struct {
+	// slots holds a token for every request in flight, and queue for every request waiting for a slot.
+	slots chan struct{}
+	queue chan struct{}
+
+	metrics Metrics
+	service string
+
+	HTTP
+}
+
+// acquire takes a slot for a request, waiting in the queue if all of them are taken, and returns the function
+// releasing it.
+func (b *bulkhead) acquire(ctx context.Context) (release func(), err error) {
+	select {
+	case b.slots <- struct{}{}:
+	default:
+		if err := b.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	b.deltaInFlight(ctx, 1)
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			<-b.slots
+			b.deltaInFlight(ctx, -1)
+		})
+	}, nil
+}
+
+func (b *bulkhead) wait(ctx context.Context) error {
+	select {
+	case b.queue <- struct{}{}:
+	default:
+		if b.metrics != nil {
+			b.metrics.IncrementCounter(ctx, "app_http_service_bulkhead_rejected_total", "service", b.service)
+		}
+
+		return ErrorBulkheadFull{Service: b.service}
+	}
+
+	defer func() { <-b.queue }()
+
+	select {
+	case b.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *bulkhead) deltaInFlight(ctx context.Context, delta float64) {
+	if b.metrics != nil {
+		b.metrics.DeltaUpDownCounter(ctx, "app_http_service_in_flight", delta, "service", b.service)
+	}
+}
+
+func (b *bulkhead) do(ctx context.Context, f func() (*http.Response, error)) (*http.Response, error) {
+	release, err := b.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	return f()
+}
+
+func (b *bulkhead) Get(ctx context.Context, path string, queryParams map[string]any) (*http.Response, error) {
+	return b.do(ctx, func() (*http.Response, error) {
+		return b.HTTP.Get(ctx, path, queryParams)
+	})
+}
+
+func (b *bulkhead) GetWithHeaders(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	return b.do(ctx, func() (*http.Response, error) {
+		return b.HTTP.GetWithHeaders(ctx, path, queryParams, headers)
+	})
+}
+
+func (b *bulkhead) Post(ctx context.Context, path string, queryParams map[string]any,
+	body []byte) (*http.Response, error) {
+	return b.do(ctx, func() (*http.Response, error) {
+		return b.HTTP.Post(ctx, path, queryParams, body)
+	})
+}
+
+func (b *bulkhead) PostWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
+	headers map[string]string) (*http.Response, error) {
+	return b.do(ctx, func() (*http.Response, error) {
+		return b.HTTP.PostWithHeaders(ctx, path, queryParams, body, headers)
+	})
+}
+
+func (b *bulkhead) Put(ctx context.Context, path string, queryParams map[string]any, body []byte) (
+	*http.Response, error) {
+	return b.do(ctx, func() (*http.Response, error) {
+		return b.HTTP.Put(ctx, path, queryParams, body)
+	})
+}
+
+func (b *bulkhead) PutWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
+	headers map[string]string) (*http.Response, error) {
+	return b.do(ctx, func() (*http.Response, error) {
+		return b.HTTP.PutWithHeaders(ctx, path, queryParams, body, headers)
+	})
+}
+
+func (b *bulkhead) Patch(ctx context.Context, path string, queryParams map[string]any, body []byte) (
+	*http.Response, error) {
+	return b.do(ctx, func() (*http.Response, error) {
+		return b.HTTP.Patch(ctx, path, queryParams, body)
+	})
+}
+
+func (b *bulkhead) PatchWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
+	headers map[string]string) (*http.Response, error) {
+	return b.do(ctx, func() (*http.Response, error) {
+		return b.HTTP.PatchWithHeaders(ctx, path, queryParams, body, headers)
+	})
+}
+
+func (b *bulkhead) Delete(ctx context.Context, path string, body []byte) (*http.Response, error) {
+	return b.do(ctx, func() (*http.Response, error) {
+		return b.HTTP.Delete(ctx, path, body)
+	})
+}
+
+func (b *bulkhead) DeleteWithHeaders(ctx context.Context, path string, body []byte, headers map[string]string) (
+	*http.Response, error) {
+	return b.do(ctx, func() (*http.Response, error) {
+		return b.HTTP.DeleteWithHeaders(ctx, path, body, headers)
+	})
+}
+
+// GetStream holds the slot of the request until its body is closed, as the response is streamed as it is read.
+func (b *bulkhead) GetStream(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	release, err := b.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := b.HTTP.GetStream(ctx, path, queryParams, headers)
+	if err != nil || resp == nil || resp.Body == nil {
+		release()
+
+		return resp, err
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+// releasingBody releases the slot of a streamed request once its body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releasingBody) Close() error {
+	defer r.release()
+
+	return r.ReadCloser.Close()
+}
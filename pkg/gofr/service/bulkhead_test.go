@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/logging"
+)
+
+// newBlockingServer returns a server whose requests block until unblock is closed, and a channel receiving a value
+// for every request it receives.
+func newBlockingServer(t *testing.T) (server *httptest.Server, received <-chan struct{}, unblock chan struct{}) {
+	t.Helper()
+
+	requests := make(chan struct{}, 10)
+	unblock = make(chan struct{})
+
+	server = httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		requests <- struct{}{}
+		<-unblock
+	}))
+	t.Cleanup(server.Close)
+
+	return server, requests, unblock
+}
+
+func TestBulkhead(t *testing.T) {
+	server, received, unblock := newBlockingServer(t)
+
+	ctrl := gomock.NewController(t)
+	metrics := NewMockMetrics(ctrl)
+
+	metrics.EXPECT().RecordHistogram(gomock.Any(), "app_http_service_response", gomock.Any(), gomock.Any()).AnyTimes()
+	metrics.EXPECT().DeltaUpDownCounter(gomock.Any(), "app_http_service_in_flight", 1.0, "service", server.URL).Times(2)
+	metrics.EXPECT().DeltaUpDownCounter(gomock.Any(), "app_http_service_in_flight", -1.0, "service", server.URL).Times(2)
+	metrics.EXPECT().IncrementCounter(gomock.Any(), "app_http_service_bulkhead_rejected_total", "service", server.URL)
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), metrics,
+		&BulkheadConfig{MaxConcurrent: 1, MaxQueue: 1})
+
+	var wg sync.WaitGroup
+
+	for range 2 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			resp, err := svc.Get(context.Background(), "test", nil)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		}()
+	}
+
+	// one request is in flight and the other one is queued, so the third one is rejected
+	<-received
+
+	require.Eventually(t, func() bool {
+		return len(svc.(*bulkhead).queue) == 1
+	}, time.Second, time.Millisecond)
+
+	_, err := svc.Get(context.Background(), "test", nil)
+
+	var bulkheadErr ErrorBulkheadFull
+
+	require.ErrorAs(t, err, &bulkheadErr)
+	assert.Equal(t, server.URL, bulkheadErr.Service)
+	assert.Equal(t, http.StatusServiceUnavailable, bulkheadErr.StatusCode())
+
+	close(unblock)
+	wg.Wait()
+}
+
+func TestBulkhead_QueueCancelled(t *testing.T) {
+	server, received, unblock := newBlockingServer(t)
+	defer close(unblock)
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), nil, &BulkheadConfig{MaxConcurrent: 1, MaxQueue: 1})
+
+	go func() {
+		resp, err := svc.Get(context.Background(), "test", nil)
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	<-received
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := svc.Get(ctx, "test", nil)
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Empty(t, svc.(*bulkhead).queue, "the request should leave the queue when its context is done")
+}
+
+func TestBulkhead_StreamHoldsSlot(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("data"))
+	}))
+	defer server.Close()
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), nil, &BulkheadConfig{MaxConcurrent: 1})
+
+	resp, err := svc.GetStream(context.Background(), "stream", nil, nil)
+	require.NoError(t, err)
+
+	_, err = svc.Get(context.Background(), "test", nil)
+	require.True(t, errors.As(err, &ErrorBulkheadFull{}), "the slot should be held until the stream is closed")
+
+	require.NoError(t, resp.Body.Close())
+
+	resp, err = svc.Get(context.Background(), "test", nil)
+	require.NoError(t, err)
+
+	resp.Body.Close()
+}
+
+func TestBulkhead_Disabled(t *testing.T) {
+	svc := NewHTTPService("http://localhost", logging.NewMockLogger(logging.INFO), nil, &BulkheadConfig{})
+
+	_, ok := svc.(*httpService)
+
+	assert.True(t, ok, "the bulkhead should not wrap the service without a limit")
+}
@@ -0,0 +1,350 @@
+package service
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultCacheTTL        = time.Minute
+	defaultCacheMaxEntries = 1000
+)
+
+// CacheStore stores the cached responses of a service, e.g. in memory or in Redis. Caching is best-effort,
+// so a store which fails to read an entry should report it as not found.
+type CacheStore interface {
+	Get(ctx context.Context, key string) ([]byte, bool)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration)
+}
+
+// CacheConfig caches the successful responses of the GET requests to the service, keyed by the URL and query
+// parameters of the request. The max-age of the Cache-Control header of a response takes precedence over the TTL,
+// and responses with no-store or private are not cached. Responses with an ETag are kept for twice their TTL, so
+// that they can be revalidated using If-None-Match once they expire.
+//
+// Cached responses are shared by all the requests to the same URL, irrespective of their headers, so it should not
+// be used for services whose responses depend on the user making the request.
+type CacheConfig struct {
+	// TTL is the time for which a response is served from the cache. Defaults to 1 minute.
+	TTL time.Duration
+	// Store is where the responses are cached, e.g. the one returned by NewRedisCacheStore. Defaults to an in-memory
+	// store keeping up to MaxEntries responses.
+	Store CacheStore
+	// MaxEntries is the maximum number of responses kept by the default in-memory store, beyond which the least
+	// recently used ones are evicted. Defaults to 1000.
+	MaxEntries int
+
+	address string
+}
+
+func (c *CacheConfig) AddOption(h HTTP) HTTP {
+	cp := &cacheProvider{
+		ttl:     c.TTL,
+		store:   c.Store,
+		address: c.address,
+		HTTP:    h,
+	}
+
+	if cp.ttl <= 0 {
+		cp.ttl = defaultCacheTTL
+	}
+
+	if cp.store == nil {
+		cp.store = NewMemoryCacheStore(c.MaxEntries)
+	}
+
+	return cp
+}
+
+// forService returns a copy of the config which prefixes the cache keys with the address of the service, so that
+// a store can be shared by several services.
+func (c *CacheConfig) forService(address string, _ Logger, _ Metrics) Options {
+	cc := *c
+	cc.address = address
+
+	return &cc
+}
+
+type cacheProvider struct {
+	ttl     time.Duration
+	store   CacheStore
+	address string
+
+	HTTP
+}
+
+// cacheEntry is a response stored in the cache.
+type cacheEntry struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	ETag       string      `json:"etag,omitempty"`
+	Expires    time.Time   `json:"expires"`
+}
+
+func (e *cacheEntry) response() *http.Response {
+	return &http.Response{
+		StatusCode: e.StatusCode,
+		Header:     e.Header.Clone(),
+		Body:       io.NopCloser(bytes.NewReader(e.Body)),
+	}
+}
+
+func (cp *cacheProvider) Get(ctx context.Context, path string, queryParams map[string]any) (*http.Response, error) {
+	return cp.GetWithHeaders(ctx, path, queryParams, nil)
+}
+
+func (cp *cacheProvider) GetWithHeaders(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	key := cp.key(path, queryParams)
+
+	entry, found := cp.load(ctx, key)
+	if found && time.Now().Before(entry.Expires) {
+		return entry.response(), nil
+	}
+
+	if found && entry.ETag != "" {
+		revalidate := make(map[string]string, len(headers)+1)
+
+		for k, v := range headers {
+			revalidate[k] = v
+		}
+
+		revalidate["If-None-Match"] = entry.ETag
+		headers = revalidate
+	}
+
+	resp, err := cp.HTTP.GetWithHeaders(ctx, path, queryParams, headers)
+	if err != nil {
+		return resp, err
+	}
+
+	if found && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+
+		if ttl, ok := cp.cacheTTL(resp.Header); ok {
+			entry.Expires = time.Now().Add(ttl)
+			cp.save(ctx, key, entry, ttl)
+		}
+
+		return entry.response(), nil
+	}
+
+	ttl, cacheable := cp.cacheTTL(resp.Header)
+	if !cacheable || resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry = &cacheEntry{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+		ETag:       resp.Header.Get("ETag"),
+		Expires:    time.Now().Add(ttl),
+	}
+
+	cp.save(ctx, key, entry, ttl)
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
+
+// cacheTTL returns the time for which a response with the given headers is fresh, and false if it must not be cached.
+func (cp *cacheProvider) cacheTTL(header http.Header) (time.Duration, bool) {
+	ttl := cp.ttl
+
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+
+		switch {
+		case directive == "no-store", directive == "private":
+			return 0, false
+		case directive == "no-cache":
+			ttl = 0
+		case strings.HasPrefix(directive, "max-age="):
+			if seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age=")); err == nil {
+				ttl = time.Duration(seconds) * time.Second
+			}
+		}
+	}
+
+	// a response which cannot be served without revalidation is only useful if it can be revalidated
+	if ttl <= 0 && header.Get("ETag") == "" {
+		return 0, false
+	}
+
+	return ttl, true
+}
+
+func (cp *cacheProvider) load(ctx context.Context, key string) (*cacheEntry, bool) {
+	data, ok := cp.store.Get(ctx, key)
+	if !ok {
+		return nil, false
+	}
+
+	var entry cacheEntry
+
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+
+	return &entry, true
+}
+
+func (cp *cacheProvider) save(ctx context.Context, key string, entry *cacheEntry, ttl time.Duration) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	if entry.ETag != "" {
+		ttl = 2 * max(ttl, cp.ttl)
+	}
+
+	cp.store.Set(ctx, key, data, ttl)
+}
+
+// key returns the URL of the request with the query parameters sorted, so that the same request has the same key.
+func (cp *cacheProvider) key(path string, queryParams map[string]any) string {
+	names := make([]string, 0, len(queryParams))
+
+	for name := range queryParams {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var b strings.Builder
+
+	b.WriteString(strings.TrimSuffix(cp.address, "/") + "/" + strings.TrimPrefix(path, "/"))
+
+	for i, name := range names {
+		if i == 0 {
+			b.WriteString("?")
+		} else {
+			b.WriteString("&")
+		}
+
+		fmt.Fprintf(&b, "%s=%v", name, queryParams[name])
+	}
+
+	return b.String()
+}
+
+// memoryCacheStore is the in-memory CacheStore used when no store is configured.
+type memoryCacheStore struct {
+	// entries is an LRU list of the entries, most recently used first.
+	mu         sync.Mutex
+	maxEntries int
+	entries    *list.List
+	keys       map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCacheStore returns a CacheStore which keeps up to maxEntries responses in memory, or 1000 if it is not
+// positive, evicting the least recently used ones beyond it. Expired entries are removed when they are read.
+func NewMemoryCacheStore(maxEntries int) CacheStore {
+	if maxEntries <= 0 {
+		maxEntries = defaultCacheMaxEntries
+	}
+
+	return &memoryCacheStore{maxEntries: maxEntries, entries: list.New(), keys: make(map[string]*list.Element)}
+}
+
+func (m *memoryCacheStore) Get(_ context.Context, key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.keys[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry, _ := e.Value.(*memoryCacheEntry)
+
+	if time.Now().After(entry.expires) {
+		m.entries.Remove(e)
+		delete(m.keys, key)
+
+		return nil, false
+	}
+
+	m.entries.MoveToFront(e)
+
+	return entry.value, true
+}
+
+func (m *memoryCacheStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := &memoryCacheEntry{key: key, value: value, expires: time.Now().Add(ttl)}
+
+	if e, ok := m.keys[key]; ok {
+		e.Value = entry
+		m.entries.MoveToFront(e)
+
+		return
+	}
+
+	m.keys[key] = m.entries.PushFront(entry)
+
+	for m.entries.Len() > m.maxEntries {
+		evicted, _ := m.entries.Remove(m.entries.Back()).(*memoryCacheEntry)
+		delete(m.keys, evicted.key)
+	}
+}
+
+// redisCacheStore is a CacheStore keeping the responses in Redis.
+type redisCacheStore struct {
+	client redis.Cmdable
+}
+
+// NewRedisCacheStore returns a CacheStore which keeps the responses in Redis using the client, so that they are
+// shared by the replicas of the application and survive its restarts. The responses expire along with their keys.
+func NewRedisCacheStore(client redis.Cmdable) CacheStore {
+	return &redisCacheStore{client: client}
+}
+
+func (r *redisCacheStore) Get(ctx context.Context, key string) ([]byte, bool) {
+	value, err := r.client.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, false
+	}
+
+	return value, true
+}
+
+func (r *redisCacheStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	// caching is best-effort, so a failure to store the response is not reported
+	_ = r.client.Set(ctx, key, value, ttl).Err()
+}
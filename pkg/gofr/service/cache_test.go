@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr/logging"
+)
+
+func getBody(t *testing.T, svc HTTP, path string, queryParams map[string]any) (int, string) {
+	t.Helper()
+
+	resp, err := svc.Get(context.Background(), path, queryParams)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return resp.StatusCode, string(body)
+}
+
+func TestCacheProvider_TTL(t *testing.T) {
+	tests := []struct {
+		desc         string
+		cacheControl string
+		requests     int
+	}{
+		{"cached for the TTL", "", 1},
+		{"max-age overrides the TTL", "max-age=60", 1},
+		{"expired max-age", "max-age=0", 3},
+		{"no-store", "no-store", 3},
+		{"private", "private, max-age=60", 3},
+	}
+
+	for i, tc := range tests {
+		requests := 0
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests++
+
+			if tc.cacheControl != "" {
+				w.Header().Set("Cache-Control", tc.cacheControl)
+			}
+
+			_, _ = w.Write([]byte("fact"))
+		}))
+
+		svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), nil, &CacheConfig{TTL: time.Minute})
+
+		for range 3 {
+			status, body := getBody(t, svc, "fact", map[string]any{"max_length": 20})
+
+			assert.Equal(t, http.StatusOK, status, "TEST[%d], Failed.\n%s", i, tc.desc)
+			assert.Equal(t, "fact", body, "TEST[%d], Failed.\n%s", i, tc.desc)
+		}
+
+		assert.Equal(t, tc.requests, requests, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		server.Close()
+	}
+}
+
+func TestCacheProvider_KeyedByURL(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		_, _ = w.Write([]byte(r.URL.RequestURI()))
+	}))
+	defer server.Close()
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), nil, &CacheConfig{})
+
+	_, body := getBody(t, svc, "fact", map[string]any{"a": 1, "b": 2})
+	assert.Equal(t, "/fact?a=1&b=2", body)
+
+	_, body = getBody(t, svc, "fact", map[string]any{"b": 2, "a": 1})
+	assert.Equal(t, "/fact?a=1&b=2", body)
+
+	_, body = getBody(t, svc, "fact", map[string]any{"a": 2})
+	assert.Equal(t, "/fact?a=2", body)
+
+	_, body = getBody(t, svc, "breeds", nil)
+	assert.Equal(t, "/breeds", body)
+
+	assert.Equal(t, 3, requests)
+}
+
+func TestCacheProvider_ETagRevalidation(t *testing.T) {
+	requests, revalidations := 0, 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Cache-Control", "no-cache")
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			revalidations++
+
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		_, _ = w.Write([]byte("fact"))
+	}))
+	defer server.Close()
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), nil, &CacheConfig{TTL: time.Minute})
+
+	for range 3 {
+		status, body := getBody(t, svc, "fact", nil)
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "fact", body)
+	}
+
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, 2, revalidations)
+}
+
+func TestCacheProvider_ErrorsNotCached(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), nil, &CacheConfig{})
+
+	for range 2 {
+		status, _ := getBody(t, svc, "fact", nil)
+
+		assert.Equal(t, http.StatusServiceUnavailable, status)
+	}
+
+	assert.Equal(t, 2, requests)
+}
+
+func TestMemoryCacheStore(t *testing.T) {
+	store := NewMemoryCacheStore(0)
+	ctx := context.Background()
+
+	store.Set(ctx, "fresh", []byte("value"), time.Minute)
+	store.Set(ctx, "expired", []byte("value"), -time.Second)
+
+	value, ok := store.Get(ctx, "fresh")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), value)
+
+	_, ok = store.Get(ctx, "expired")
+	assert.False(t, ok)
+
+	_, ok = store.Get(ctx, "missing")
+	assert.False(t, ok)
+}
+
+func TestMemoryCacheStore_Eviction(t *testing.T) {
+	store := NewMemoryCacheStore(2)
+	ctx := context.Background()
+
+	store.Set(ctx, "a", []byte("a"), time.Minute)
+	store.Set(ctx, "b", []byte("b"), time.Minute)
+
+	// reading a makes b the least recently used entry
+	_, ok := store.Get(ctx, "a")
+	require.True(t, ok)
+
+	store.Set(ctx, "c", []byte("c"), time.Minute)
+	store.Set(ctx, "a", []byte("a2"), time.Minute)
+
+	_, ok = store.Get(ctx, "b")
+	assert.False(t, ok, "the least recently used entry must be evicted")
+
+	value, ok := store.Get(ctx, "a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("a2"), value)
+
+	_, ok = store.Get(ctx, "c")
+	assert.True(t, ok)
+}
+
+func TestRedisCacheStore(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	store := NewRedisCacheStore(client)
+	ctx := context.Background()
+
+	store.Set(ctx, "fresh", []byte("value"), time.Minute)
+	store.Set(ctx, "no ttl", []byte("value"), 0)
+
+	value, ok := store.Get(ctx, "fresh")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), value)
+	assert.Equal(t, time.Minute, server.TTL("fresh"))
+
+	_, ok = store.Get(ctx, "no ttl")
+	assert.False(t, ok)
+
+	server.FastForward(2 * time.Minute)
+
+	_, ok = store.Get(ctx, "fresh")
+	assert.False(t, ok)
+
+	server.Close()
+
+	_, ok = store.Get(ctx, "fresh")
+	assert.False(t, ok, "a failure to read must be reported as not found")
+}
@@ -3,15 +3,18 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
 )
 
-// circuitBreaker states.
+// circuitBreaker states, which are also the values of the app_http_service_circuit_state gauge.
 const (
 	ClosedState = iota
 	OpenState
+	// HalfOpenState is the state of an open circuit while the health of the service is checked to close it.
+	HalfOpenState
 )
 
 var (
@@ -24,17 +27,25 @@ var (
 type CircuitBreakerConfig struct {
 	Threshold int           // Threshold represents the max no of retry before switching the circuit breaker state.
 	Interval  time.Duration // Interval represents the time interval duration between hitting the HealthURL
+
+	service string
+	logger  Logger
+	metrics Metrics
 }
 
 // circuitBreaker represents a circuit breaker implementation.
 type circuitBreaker struct {
 	mu           sync.RWMutex
-	state        int // ClosedState or OpenState
+	state        int // ClosedState, OpenState or HalfOpenState
 	failureCount int
 	threshold    int
 	interval     time.Duration
 	lastChecked  time.Time
 
+	service string
+	logger  Logger
+	metrics Metrics
+
 	HTTP
 }
 
@@ -46,9 +57,14 @@ func NewCircuitBreaker(config CircuitBreakerConfig, h HTTP) *circuitBreaker {
 		state:     ClosedState,
 		threshold: config.Threshold,
 		interval:  config.Interval,
+		service:   config.service,
+		logger:    config.logger,
+		metrics:   config.metrics,
 		HTTP:      h,
 	}
 
+	cb.setGauge()
+
 	// Perform asynchronous health checks
 	go cb.startHealthChecks()
 
@@ -64,17 +80,30 @@ func (cb *circuitBreaker) executeWithCircuitBreaker(ctx context.Context, f func(
 	if cb.state == OpenState {
 		if time.Since(cb.lastChecked) > cb.interval {
 			// Check health before potentially closing the circuit
+			cb.setState(HalfOpenState)
+
 			if cb.healthCheck(ctx) {
 				cb.resetCircuit()
 				return nil, nil
 			}
+
+			cb.setState(OpenState)
 		}
 
 		return nil, ErrCircuitOpen
 	}
 
+	if cb.state == HalfOpenState {
+		return nil, ErrCircuitOpen
+	}
+
 	result, err := f(ctx)
 
+	// the requests rejected by the rate limiter or the bulkhead were not sent, so they tell nothing about the service
+	if isRejected(err) {
+		return result, err
+	}
+
 	if err != nil {
 		cb.handleFailure()
 	} else {
@@ -89,12 +118,60 @@ func (cb *circuitBreaker) executeWithCircuitBreaker(ctx context.Context, f func(
 	return result, err
 }
 
-// isOpen returns true if the circuit breaker is in the open state.
+// isOpen returns true if the circuit breaker is in the open or half-open state.
 func (cb *circuitBreaker) isOpen() bool {
 	cb.mu.Lock()
 	defer cb.mu.Unlock()
 
-	return cb.state == OpenState
+	return cb.state != ClosedState
+}
+
+// CircuitState returns the state of the circuit breaker, ClosedState, OpenState or HalfOpenState.
+func (cb *circuitBreaker) CircuitState() int {
+	cb.mu.RLock()
+	defer cb.mu.RUnlock()
+
+	return cb.state
+}
+
+// CircuitState returns ClosedState for the services without a circuit breaker, as their requests are always sent.
+func (*httpService) CircuitState() int {
+	return ClosedState
+}
+
+// setState transitions the circuit breaker to the state, logging the transition and updating the gauge of the state.
+// It must be called with the lock held.
+func (cb *circuitBreaker) setState(state int) {
+	if cb.state == state {
+		return
+	}
+
+	previous := cb.state
+	cb.state = state
+
+	if cb.logger != nil {
+		cb.logger.Log(fmt.Sprintf("circuit breaker of the service %s transitioned from %s to %s", cb.service,
+			stateName(previous), stateName(state)))
+	}
+
+	cb.setGauge()
+}
+
+func (cb *circuitBreaker) setGauge() {
+	if cb.metrics != nil {
+		cb.metrics.SetGauge("app_http_service_circuit_state", float64(cb.state), "service", cb.service)
+	}
+}
+
+func stateName(state int) string {
+	switch state {
+	case OpenState:
+		return "open"
+	case HalfOpenState:
+		return "half-open"
+	default:
+		return "closed"
+	}
 }
 
 // healthCheck performs the health check for the circuit breaker.
@@ -110,24 +187,49 @@ func (cb *circuitBreaker) startHealthChecks() {
 
 	for range ticker.C {
 		if cb.isOpen() {
-			go func() {
-				if cb.healthCheck(context.TODO()) {
-					cb.resetCircuit()
-				}
-			}()
+			go cb.recover(context.TODO())
 		}
 	}
 }
 
+// recover checks the health of the service of an open circuit, which is half-open during the check so that a single
+// check is done at a time, and closes the circuit if the service is healthy. It returns true if the circuit is closed.
+func (cb *circuitBreaker) recover(ctx context.Context) bool {
+	cb.mu.Lock()
+
+	if cb.state != OpenState {
+		closed := cb.state == ClosedState
+		cb.mu.Unlock()
+
+		return closed
+	}
+
+	cb.setState(HalfOpenState)
+	cb.mu.Unlock()
+
+	healthy := cb.healthCheck(ctx)
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if healthy {
+		cb.resetCircuit()
+	} else {
+		cb.openCircuit()
+	}
+
+	return healthy
+}
+
 // openCircuit transitions the circuit breaker to the open state.
 func (cb *circuitBreaker) openCircuit() {
-	cb.state = OpenState
+	cb.setState(OpenState)
 	cb.lastChecked = time.Now()
 }
 
 // resetCircuit transitions the circuit breaker to the closed state.
 func (cb *circuitBreaker) resetCircuit() {
-	cb.state = ClosedState
+	cb.setState(ClosedState)
 	cb.failureCount = 0
 }
 
@@ -148,13 +250,23 @@ func (cb *CircuitBreakerConfig) AddOption(h HTTP) HTTP {
 	return NewCircuitBreaker(*cb, h)
 }
 
+// forService returns a copy of the config which logs the transitions of the circuit breaker of the service and
+// reports its state in the metrics.
+func (cb *CircuitBreakerConfig) forService(address string, logger Logger, metrics Metrics) Options {
+	c := *cb
+	c.service = address
+	c.logger = logger
+	c.metrics = metrics
+
+	return &c
+}
+
 func (cb *circuitBreaker) tryCircuitRecovery() bool {
-	if time.Since(cb.lastChecked) > cb.interval && cb.healthCheck(context.TODO()) {
-		cb.resetCircuit()
-		return true
-	}
+	cb.mu.RLock()
+	due := time.Since(cb.lastChecked) > cb.interval
+	cb.mu.RUnlock()
 
-	return false
+	return due && cb.recover(context.TODO())
 }
 
 func (*circuitBreaker) handleCircuitBreakerResult(result any, err error) (*http.Response, error) {
@@ -242,6 +354,20 @@ func (cb *circuitBreaker) DeleteWithHeaders(ctx context.Context, path string, bo
 	return cb.doRequest(ctx, http.MethodDelete, path, nil, body, headers)
 }
 
+// GetStream is a wrapper for GetStream of the underlying service, guarded by the circuit breaker.
+func (cb *circuitBreaker) GetStream(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	if cb.isOpen() && !cb.tryCircuitRecovery() {
+		return nil, ErrCircuitOpen
+	}
+
+	result, err := cb.executeWithCircuitBreaker(ctx, func(ctx context.Context) (*http.Response, error) {
+		return cb.HTTP.GetStream(ctx, path, queryParams, headers)
+	})
+
+	return cb.handleCircuitBreakerResult(result, err)
+}
+
 func (cb *circuitBreaker) Get(ctx context.Context, path string, queryParams map[string]any) (*http.Response, error) {
 	return cb.doRequest(ctx, http.MethodGet, path, queryParams, nil, nil)
 }
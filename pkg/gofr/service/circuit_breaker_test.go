@@ -7,11 +7,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
 
 	"gofr.dev/pkg/gofr/logging"
 	"gofr.dev/pkg/gofr/testutil"
@@ -588,6 +590,16 @@ type mockMetrics struct {
 	mock.Mock
 }
 
+func (m *mockMetrics) IncrementCounter(ctx context.Context, name string, labels ...string) {
+	m.Called(ctx, name, labels)
+}
+
+func (m *mockMetrics) DeltaUpDownCounter(ctx context.Context, name string, value float64, labels ...string) {
+	m.Called(ctx, name, value, labels)
+}
+
+func (*mockMetrics) SetGauge(string, float64, ...string) {}
+
 func (m *mockMetrics) RecordHistogram(ctx context.Context, name string, value float64, labels ...string) {
 	m.Called(ctx, name, value, labels)
 }
@@ -606,3 +618,57 @@ func (*customTransport) RoundTrip(r *http.Request) (*http.Response, error) {
 
 	return nil, testutil.CustomError{ErrorMessage: "cb error"}
 }
+
+func TestCircuitBreaker_State(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metrics := NewMockMetrics(ctrl)
+
+	metrics.EXPECT().RecordHistogram(gomock.Any(), "app_http_service_response", gomock.Any(), gomock.Any()).AnyTimes()
+	gomock.InOrder(
+		metrics.EXPECT().SetGauge("app_http_service_circuit_state", float64(ClosedState), "service", "http://svc"),
+		metrics.EXPECT().SetGauge("app_http_service_circuit_state", float64(OpenState), "service", "http://svc"),
+		metrics.EXPECT().SetGauge("app_http_service_circuit_state", float64(HalfOpenState), "service", "http://svc"),
+		metrics.EXPECT().SetGauge("app_http_service_circuit_state", float64(ClosedState), "service", "http://svc"),
+	)
+
+	svc := &httpService{
+		Client:  &http.Client{Transport: &customTransport{}},
+		url:     "http://svc",
+		Tracer:  otel.Tracer("gofr-http-client"),
+		Logger:  logging.NewMockLogger(logging.DEBUG),
+		Metrics: metrics,
+	}
+
+	logs := testutil.StdoutOutputForFunc(func() {
+		config := &CircuitBreakerConfig{Threshold: 1, Interval: time.Hour}
+		cb := config.forService("http://svc", logging.NewMockLogger(logging.DEBUG), metrics).AddOption(svc)
+
+		assert.Equal(t, ClosedState, cb.CircuitState())
+
+		for range 2 {
+			_, err := cb.Get(context.Background(), "invalid", nil)
+			require.Error(t, err)
+		}
+
+		assert.Equal(t, OpenState, cb.CircuitState())
+
+		cb.(*circuitBreaker).lastChecked = time.Now().Add(-2 * time.Hour)
+
+		resp, err := cb.Get(context.Background(), "success", nil)
+		require.NoError(t, err)
+
+		_ = resp.Body.Close()
+
+		assert.Equal(t, ClosedState, cb.CircuitState())
+	})
+
+	assert.Contains(t, logs, "circuit breaker of the service http://svc transitioned from closed to open")
+	assert.Contains(t, logs, "circuit breaker of the service http://svc transitioned from open to half-open")
+	assert.Contains(t, logs, "circuit breaker of the service http://svc transitioned from half-open to closed")
+}
+
+func TestHTTPService_CircuitStateWithoutCircuitBreaker(t *testing.T) {
+	svc := NewHTTPService("http://svc", logging.NewMockLogger(logging.DEBUG), nil)
+
+	assert.Equal(t, ClosedState, svc.CircuitState())
+}
@@ -5,30 +5,55 @@ import (
 	"net/http"
 )
 
+// DefaultHeaders adds headers to every request to the service.
 type DefaultHeaders struct {
 	Headers map[string]string
+	// HeaderProvider is called for every request to get the headers which change over time, e.g. a bearer
+	// token which is rotated. The request fails with the error returned by it.
+	HeaderProvider func(ctx context.Context) (map[string]string, error)
 }
 
 func (a *DefaultHeaders) AddOption(h HTTP) HTTP {
 	return &customHeader{
-		Headers: a.Headers,
-		HTTP:    h,
+		Headers:        a.Headers,
+		HeaderProvider: a.HeaderProvider,
+		HTTP:           h,
 	}
 }
 
 type customHeader struct {
-	Headers map[string]string
+	Headers        map[string]string
+	HeaderProvider func(ctx context.Context) (map[string]string, error)
 
 	HTTP
 }
 
+// headers returns the headers of the request with the default headers and the headers of the provider added.
+func (a *customHeader) headers(ctx context.Context, headers map[string]string) (map[string]string, error) {
+	headers = setCustomHeader(headers, a.Headers)
+
+	if a.HeaderProvider == nil {
+		return headers, nil
+	}
+
+	provided, err := a.HeaderProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return setCustomHeader(headers, provided), nil
+}
+
 func (a *customHeader) Get(ctx context.Context, path string, queryParams map[string]any) (*http.Response, error) {
 	return a.GetWithHeaders(ctx, path, queryParams, nil)
 }
 
 func (a *customHeader) GetWithHeaders(ctx context.Context, path string, queryParams map[string]any,
 	headers map[string]string) (*http.Response, error) {
-	headers = setCustomHeader(headers, a.Headers)
+	headers, err := a.headers(ctx, headers)
+	if err != nil {
+		return nil, err
+	}
 
 	return a.HTTP.GetWithHeaders(ctx, path, queryParams, headers)
 }
@@ -40,7 +65,10 @@ func (a *customHeader) Post(ctx context.Context, path string, queryParams map[st
 
 func (a *customHeader) PostWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
 	headers map[string]string) (*http.Response, error) {
-	headers = setCustomHeader(headers, a.Headers)
+	headers, err := a.headers(ctx, headers)
+	if err != nil {
+		return nil, err
+	}
 
 	return a.HTTP.PostWithHeaders(ctx, path, queryParams, body, headers)
 }
@@ -52,7 +80,10 @@ func (a *customHeader) Put(ctx context.Context, api string, queryParams map[stri
 
 func (a *customHeader) PutWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
 	headers map[string]string) (*http.Response, error) {
-	headers = setCustomHeader(headers, a.Headers)
+	headers, err := a.headers(ctx, headers)
+	if err != nil {
+		return nil, err
+	}
 
 	return a.HTTP.PutWithHeaders(ctx, path, queryParams, body, headers)
 }
@@ -64,7 +95,10 @@ func (a *customHeader) Patch(ctx context.Context, path string, queryParams map[s
 
 func (a *customHeader) PatchWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
 	headers map[string]string) (*http.Response, error) {
-	headers = setCustomHeader(headers, a.Headers)
+	headers, err := a.headers(ctx, headers)
+	if err != nil {
+		return nil, err
+	}
 
 	return a.HTTP.PatchWithHeaders(ctx, path, queryParams, body, headers)
 }
@@ -75,11 +109,24 @@ func (a *customHeader) Delete(ctx context.Context, path string, body []byte) (*h
 
 func (a *customHeader) DeleteWithHeaders(ctx context.Context, path string, body []byte, headers map[string]string) (
 	*http.Response, error) {
-	headers = setCustomHeader(headers, a.Headers)
+	headers, err := a.headers(ctx, headers)
+	if err != nil {
+		return nil, err
+	}
 
 	return a.HTTP.DeleteWithHeaders(ctx, path, body, headers)
 }
 
+func (a *customHeader) GetStream(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	headers, err := a.headers(ctx, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.HTTP.GetStream(ctx, path, queryParams, headers)
+}
+
 func setCustomHeader(headers, customHeader map[string]string) map[string]string {
 	if headers == nil {
 		headers = make(map[string]string)
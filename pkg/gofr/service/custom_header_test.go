@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -167,3 +169,53 @@ func TestCustomDomainProvider_Delete(t *testing.T) {
 	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
 	require.NoError(t, err)
 }
+
+func TestCustomHeader_HeaderProvider(t *testing.T) {
+	var received []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "tenant-1", r.Header.Get("X-Tenant"))
+
+		received = append(received, r.Header.Get("Authorization"))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	calls := 0
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), nil, &DefaultHeaders{
+		Headers: map[string]string{"X-Tenant": "tenant-1"},
+		HeaderProvider: func(context.Context) (map[string]string, error) {
+			calls++
+
+			return map[string]string{"Authorization": fmt.Sprintf("Bearer token-%d", calls)}, nil
+		},
+	})
+
+	for range 2 {
+		resp, err := svc.Get(context.Background(), "path", nil)
+		require.NoError(t, err)
+
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, []string{"Bearer token-1", "Bearer token-2"}, received)
+}
+
+func TestCustomHeader_HeaderProviderError(t *testing.T) {
+	errToken := errors.New("token refresh failed")
+
+	svc := NewHTTPService("http://localhost", logging.NewMockLogger(logging.INFO), nil, &DefaultHeaders{
+		HeaderProvider: func(context.Context) (map[string]string, error) {
+			return nil, errToken
+		},
+	})
+
+	resp, err := svc.PostWithHeaders(context.Background(), "path", nil, nil, nil)
+	if resp != nil {
+		resp.Body.Close()
+	}
+
+	require.ErrorIs(t, err, errToken)
+}
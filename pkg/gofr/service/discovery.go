@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultDiscoveryRefreshInterval  = 30 * time.Second
+	defaultDiscoveryFailureThreshold = 3
+)
+
+// ErrorNoInstances is returned for the requests to a service registered with a DiscoveryConfig which has no healthy
+// instances, e.g. as its resolver returned none.
+type ErrorNoInstances struct {
+	Service string
+}
+
+func (e ErrorNoInstances) Error() string {
+	return fmt.Sprintf("no healthy instances of the service %s", e.Service)
+}
+
+func (ErrorNoInstances) StatusCode() int {
+	return http.StatusServiceUnavailable
+}
+
+// Resolver returns the addresses of the current instances of the service with the name, e.g. from Consul or the DNS
+// SRV records of the name. An address is either a host and port, e.g. 10.0.0.5:8080, or a URL, e.g.
+// https://10.0.0.5:8443.
+type Resolver interface {
+	Resolve(ctx context.Context, name string) ([]string, error)
+}
+
+// ResolverFunc is an adapter to use a function as a Resolver.
+type ResolverFunc func(ctx context.Context, name string) ([]string, error)
+
+func (f ResolverFunc) Resolve(ctx context.Context, name string) ([]string, error) {
+	return f(ctx, name)
+}
+
+// DiscoveryConfig resolves the instances of the service using the Resolver, instead of sending the requests to the
+// address of the service, and balances the requests across the healthy instances using the LoadBalancer. The
+// service is registered with a logical address, e.g. http://orders, whose host is the name passed to the Resolver,
+// and whose scheme is used for the instances resolved without one.
+//
+// The instances are resolved again every RefreshInterval, until the service is closed. If the service has a
+// HealthConfig, the instances are only used once their health endpoint responds with status 200. An instance whose
+// requests fail to be sent FailureThreshold times in a row, e.g. as it cannot be connected, is not used until the
+// next refresh.
+type DiscoveryConfig struct {
+	Resolver Resolver
+	// RefreshInterval is the interval at which the instances are resolved again. Defaults to 30 seconds.
+	RefreshInterval time.Duration
+	// ResolveTimeout bounds each call to the Resolver, including the first one made when the service is created.
+	// Defaults to 5 seconds.
+	ResolveTimeout time.Duration
+	// FailureThreshold is the number of consecutive requests of an instance failing to be sent after which the
+	// instance is not used until the next refresh. Defaults to 3.
+	FailureThreshold int
+	// LoadBalancer picks the instance which a request is sent to. Defaults to RoundRobin.
+	LoadBalancer LoadBalancer
+
+	address string
+	logger  Logger
+	health  *HealthConfig
+}
+
+// AddOption returns h as it is, as the requests are balanced by the transport of the client of the service.
+func (*DiscoveryConfig) AddOption(h HTTP) HTTP {
+	return h
+}
+
+// forService returns a copy of the config which resolves the instances of the service with the address.
+func (d *DiscoveryConfig) forService(address string, logger Logger, _ Metrics) Options {
+	c := *d
+	c.address = address
+	c.logger = logger
+
+	return &c
+}
+
+// withHealth returns a copy of the config which checks the health of the instances using the HealthConfig of the
+// service, if any.
+func (d *DiscoveryConfig) withHealth(options []Options) *DiscoveryConfig {
+	c := *d
+
+	for _, o := range options {
+		if h, ok := o.(*HealthConfig); ok {
+			c.health = h
+		}
+	}
+
+	return &c
+}
+
+// wrapTransport returns the transport balancing the requests sent using next across the instances of the service.
+func (d *DiscoveryConfig) wrapTransport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	b := &balancer{
+		resolver:         d.Resolver,
+		interval:         d.RefreshInterval,
+		resolveTimeout:   d.ResolveTimeout,
+		failureThreshold: int64(d.FailureThreshold),
+		strategy:         d.LoadBalancer,
+		logger:           d.logger,
+		next:             next,
+		stop:             make(chan struct{}),
+	}
+
+	if b.interval <= 0 {
+		b.interval = defaultDiscoveryRefreshInterval
+	}
+
+	if b.resolveTimeout <= 0 {
+		b.resolveTimeout = defaultTimeout * time.Second
+	}
+
+	if b.failureThreshold <= 0 {
+		b.failureThreshold = defaultDiscoveryFailureThreshold
+	}
+
+	if b.strategy == nil {
+		b.strategy = RoundRobin()
+	}
+
+	if u, err := url.Parse(d.address); err == nil {
+		b.name, b.scheme = u.Host, u.Scheme
+	}
+
+	if d.health != nil {
+		b.healthEndpoint = d.health.HealthEndpoint
+
+		b.healthTimeout = time.Duration(d.health.Timeout) * time.Second
+		if b.healthTimeout <= 0 {
+			b.healthTimeout = defaultTimeout * time.Second
+		}
+	}
+
+	b.refresh(context.Background())
+
+	go b.startRefresh()
+
+	return b
+}
+
+// balancer is a transport which sends each request to the healthy instance of the service picked by its strategy.
+type balancer struct {
+	name             string
+	scheme           string
+	resolver         Resolver
+	interval         time.Duration
+	resolveTimeout   time.Duration
+	failureThreshold int64
+	strategy         LoadBalancer
+	logger           Logger
+
+	healthEndpoint string
+	healthTimeout  time.Duration
+
+	next http.RoundTripper
+
+	mu        sync.RWMutex
+	instances []*instance
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// RoundTrip sends the request to the instance picked, which counts it in flight until its response body is closed.
+func (b *balancer) RoundTrip(req *http.Request) (*http.Response, error) {
+	in := b.pick()
+	if in == nil {
+		return nil, ErrorNoInstances{Service: b.name}
+	}
+
+	r := req.Clone(req.Context())
+	r.URL.Scheme = in.url.Scheme
+	r.URL.Host = in.url.Host
+	r.Host = ""
+
+	in.inFlight.Add(1)
+
+	var once sync.Once
+
+	done := func() {
+		once.Do(func() { in.inFlight.Add(-1) })
+	}
+
+	resp, err := b.next.RoundTrip(r)
+	if err != nil {
+		done()
+
+		if req.Context().Err() == nil && in.failures.Add(1) >= b.failureThreshold {
+			b.remove(in)
+		}
+
+		return resp, err
+	}
+
+	in.failures.Store(0)
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: done}
+
+	return resp, nil
+}
+
+func (b *balancer) pick() *instance {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if len(b.instances) == 0 {
+		return nil
+	}
+
+	return b.strategy.pick(b.instances)
+}
+
+// remove stops using the instance until the instances are refreshed.
+func (b *balancer) remove(in *instance) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i, current := range b.instances {
+		if current == in {
+			b.instances = append(b.instances[:i:i], b.instances[i+1:]...)
+
+			b.logf("instance %s of the service %s failed, removed until the next refresh", in.url.Host, b.name)
+
+			return
+		}
+	}
+}
+
+// startRefresh resolves the instances of the service every interval, until the balancer is closed.
+func (b *balancer) startRefresh() {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.stop:
+			return
+		case <-ticker.C:
+			b.refresh(context.Background())
+		}
+	}
+}
+
+// Close stops resolving the instances of the service. It is called when the service is closed.
+func (b *balancer) Close() error {
+	b.stopOnce.Do(func() { close(b.stop) })
+
+	return nil
+}
+
+// refresh resolves the instances of the service, and uses the healthy ones. The instances in use are kept if they
+// cannot be resolved, e.g. as the resolver did not respond within the resolve timeout.
+func (b *balancer) refresh(ctx context.Context) {
+	resolveCtx, cancel := context.WithTimeout(ctx, b.resolveTimeout)
+	addresses, err := b.resolver.Resolve(resolveCtx, b.name)
+
+	cancel()
+
+	if err != nil {
+		b.logf("error resolving the instances of the service %s: %v", b.name, err)
+
+		return
+	}
+
+	b.mu.RLock()
+	current := make(map[string]*instance, len(b.instances))
+
+	for _, in := range b.instances {
+		current[in.url.String()] = in
+	}
+	b.mu.RUnlock()
+
+	instances := make([]*instance, 0, len(addresses))
+	weights := make([]int, 0, len(addresses))
+
+	for _, address := range addresses {
+		raw := address
+		if !strings.Contains(raw, "://") {
+			raw = b.scheme + "://" + raw
+		}
+
+		u, err := url.Parse(raw)
+		if err != nil {
+			b.logf("invalid address %q of the service %s: %v", address, b.name, err)
+
+			continue
+		}
+
+		// the instances still resolved are kept, so that their requests in flight are still counted
+		in, ok := current[u.String()]
+		if !ok {
+			in = &instance{url: u}
+		}
+
+		instances = append(instances, in)
+		weights = append(weights, weightOf(b.strategy, address))
+	}
+
+	up := b.healthy(ctx, instances)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// the weights are set while the instances are not picked
+	b.instances = b.instances[:0:0]
+
+	for i, in := range instances {
+		in.weight = weights[i]
+
+		if up[i] {
+			b.instances = append(b.instances, in)
+		}
+	}
+}
+
+// healthy reports whether the health endpoint of each instance responds with status 200, checked concurrently. All
+// the instances are healthy if the service has no HealthConfig.
+func (b *balancer) healthy(ctx context.Context, instances []*instance) []bool {
+	up := make([]bool, len(instances))
+
+	if b.healthEndpoint == "" {
+		for i := range up {
+			up[i] = true
+		}
+
+		return up
+	}
+
+	var wg sync.WaitGroup
+
+	for i, in := range instances {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			up[i] = b.isHealthy(ctx, in.url)
+		}()
+	}
+
+	wg.Wait()
+
+	return up
+}
+
+func (b *balancer) isHealthy(ctx context.Context, u *url.URL) bool {
+	ctx, cancel := context.WithTimeout(ctx, b.healthTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.JoinPath(b.healthEndpoint).String(), http.NoBody)
+	if err != nil {
+		return false
+	}
+
+	resp, err := b.next.RoundTrip(req)
+	if err != nil {
+		b.logf("health check of instance %s of the service %s failed: %v", u.Host, b.name, err)
+
+		return false
+	}
+
+	resp.Body.Close()
+
+	return resp.StatusCode == http.StatusOK
+}
+
+func (b *balancer) logf(format string, args ...any) {
+	if b.logger != nil {
+		b.logger.Log(fmt.Sprintf(format, args...))
+	}
+}
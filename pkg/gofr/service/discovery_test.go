@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr/logging"
+)
+
+var errResolve = errors.New("consul unavailable")
+
+// newInstance returns a server responding with its name, and with the health status to its health endpoint.
+func newInstance(t *testing.T, name string, healthStatus int) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			w.WriteHeader(healthStatus)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(name))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func staticResolver(t *testing.T, servers ...*httptest.Server) ResolverFunc {
+	t.Helper()
+
+	return func(_ context.Context, name string) ([]string, error) {
+		assert.Equal(t, "orders", name)
+
+		addresses := make([]string, len(servers))
+
+		for i, s := range servers {
+			addresses[i] = s.Listener.Addr().String()
+		}
+
+		return addresses, nil
+	}
+}
+
+func respondedBy(t *testing.T, svc HTTP) string {
+	t.Helper()
+
+	resp, err := svc.Get(context.Background(), "orders", nil)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return string(body)
+}
+
+func TestDiscovery_RoundRobin(t *testing.T) {
+	first, second := newInstance(t, "first", http.StatusOK), newInstance(t, "second", http.StatusOK)
+
+	svc := NewHTTPService("http://orders", logging.NewMockLogger(logging.INFO), nil,
+		&DiscoveryConfig{Resolver: staticResolver(t, first, second)})
+
+	responses := []string{respondedBy(t, svc), respondedBy(t, svc), respondedBy(t, svc), respondedBy(t, svc)}
+
+	assert.ElementsMatch(t, []string{"first", "first", "second", "second"}, responses)
+	assert.NotEqual(t, responses[0], responses[1])
+}
+
+func TestDiscovery_HealthConfig(t *testing.T) {
+	healthy, unhealthy := newInstance(t, "healthy", http.StatusOK), newInstance(t, "unhealthy", http.StatusServiceUnavailable)
+
+	svc := NewHTTPService("http://orders", logging.NewMockLogger(logging.INFO), nil,
+		&DiscoveryConfig{Resolver: staticResolver(t, healthy, unhealthy)},
+		&HealthConfig{HealthEndpoint: "health"})
+
+	for range 3 {
+		assert.Equal(t, "healthy", respondedBy(t, svc))
+	}
+}
+
+func TestDiscovery_FailedInstanceRemoved(t *testing.T) {
+	live, dead := newInstance(t, "live", http.StatusOK), newInstance(t, "dead", http.StatusOK)
+	dead.Close()
+
+	svc := NewHTTPService("http://orders", logging.NewMockLogger(logging.INFO), nil,
+		&DiscoveryConfig{Resolver: staticResolver(t, dead, live), FailureThreshold: 2})
+
+	// the requests are sent to the dead instance in turn, which is not used once they failed twice in a row
+	_, err := svc.Get(context.Background(), "orders", nil)
+	require.Error(t, err)
+
+	assert.Equal(t, "live", respondedBy(t, svc))
+
+	_, err = svc.Get(context.Background(), "orders", nil)
+	require.Error(t, err)
+
+	for range 3 {
+		assert.Equal(t, "live", respondedBy(t, svc))
+	}
+}
+
+func TestDiscovery_ResolveTimeout(t *testing.T) {
+	resolver := ResolverFunc(func(ctx context.Context, _ string) ([]string, error) {
+		<-ctx.Done()
+
+		return nil, ctx.Err()
+	})
+
+	start := time.Now()
+
+	svc := NewHTTPService("http://orders", logging.NewMockLogger(logging.INFO), nil,
+		&DiscoveryConfig{Resolver: resolver, ResolveTimeout: 50 * time.Millisecond})
+	defer svc.Close()
+
+	assert.Less(t, time.Since(start), time.Second, "the service should be created once the resolve times out")
+
+	_, err := svc.Get(context.Background(), "orders", nil)
+
+	var noInstances ErrorNoInstances
+
+	require.ErrorAs(t, err, &noInstances)
+}
+
+func TestDiscovery_Close(t *testing.T) {
+	var resolved atomic.Int64
+
+	resolver := ResolverFunc(func(context.Context, string) ([]string, error) {
+		resolved.Add(1)
+
+		return nil, errResolve
+	})
+
+	svc := NewHTTPService("http://orders", logging.NewMockLogger(logging.INFO), nil,
+		&DiscoveryConfig{Resolver: resolver, RefreshInterval: 10 * time.Millisecond})
+
+	assert.Eventually(t, func() bool { return resolved.Load() > 1 }, time.Second, 5*time.Millisecond,
+		"the instances should be resolved every refresh interval")
+
+	require.NoError(t, svc.Close())
+	require.NoError(t, svc.Close(), "closing the service again should not fail")
+
+	// a refresh in progress when the service is closed may still complete
+	time.Sleep(20 * time.Millisecond)
+
+	closed := resolved.Load()
+
+	time.Sleep(50 * time.Millisecond)
+
+	assert.Equal(t, closed, resolved.Load(), "the instances should not be resolved once the service is closed")
+}
+
+func TestDiscovery_NoInstances(t *testing.T) {
+	svc := NewHTTPService("http://orders", logging.NewMockLogger(logging.INFO), nil,
+		&DiscoveryConfig{Resolver: ResolverFunc(func(context.Context, string) ([]string, error) {
+			return nil, errResolve
+		})})
+
+	_, err := svc.Get(context.Background(), "orders", nil)
+
+	var noInstances ErrorNoInstances
+
+	require.ErrorAs(t, err, &noInstances)
+	assert.Equal(t, "orders", noInstances.Service)
+	assert.Equal(t, http.StatusServiceUnavailable, noInstances.StatusCode())
+}
+
+func TestBalancer_refreshKeepsInstancesOnError(t *testing.T) {
+	u, _ := url.Parse("http://10.0.0.1:8080")
+	kept := &instance{url: u}
+
+	resolver := ResolverFunc(func(context.Context, string) ([]string, error) {
+		return nil, errResolve
+	})
+
+	b := &balancer{name: "orders", scheme: "http", resolver: resolver, resolveTimeout: time.Second, strategy: RoundRobin(),
+		instances: []*instance{kept}}
+
+	b.refresh(context.Background())
+
+	assert.Equal(t, []*instance{kept}, b.instances)
+
+	b.resolver = ResolverFunc(func(context.Context, string) ([]string, error) {
+		return []string{"10.0.0.1:8080", "https://10.0.0.3:8443"}, nil
+	})
+
+	b.refresh(context.Background())
+
+	require.Len(t, b.instances, 2)
+	assert.Same(t, kept, b.instances[0], "the instance still resolved is kept")
+	assert.Equal(t, "https://10.0.0.3:8443", b.instances[1].url.String())
+}
+
+func TestDiscovery_LeastConnections(t *testing.T) {
+	slow, _, unblock := newBlockingServer(t)
+	fast := newInstance(t, "fast", http.StatusOK)
+
+	svc := NewHTTPService("http://orders", logging.NewMockLogger(logging.INFO), nil,
+		&DiscoveryConfig{Resolver: staticResolver(t, slow, fast), LoadBalancer: LeastConnections()})
+
+	// the first request is in flight to the slow instance until it is unblocked
+	go func() {
+		resp, err := svc.Get(context.Background(), "orders", nil)
+		if assert.NoError(t, err) {
+			resp.Body.Close()
+		}
+	}()
+
+	require.Eventually(t, func() bool {
+		return svc.(*httpService).Client.Transport.(*balancer).instances[0].inFlight.Load() == 1
+	}, time.Second, time.Millisecond)
+
+	for range 3 {
+		assert.Equal(t, "fast", respondedBy(t, svc))
+	}
+
+	close(unblock)
+}
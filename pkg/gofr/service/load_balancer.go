@@ -0,0 +1,110 @@
+package service
+
+import (
+	"net/url"
+	"sync"
+	"sync/atomic"
+)
+
+// LoadBalancer is the strategy picking the instance of a service resolved by a DiscoveryConfig which a request is sent
+// to, i.e. RoundRobin, LeastConnections or Weighted.
+type LoadBalancer interface {
+	// pick returns one of the healthy instances, which are never empty.
+	pick(instances []*instance) *instance
+}
+
+// instance is an instance of a service resolved by a DiscoveryConfig.
+type instance struct {
+	url    *url.URL
+	weight int
+
+	// inFlight is the number of requests sent to the instance whose response body is not closed yet.
+	inFlight atomic.Int64
+	// failures is the number of consecutive requests which failed to be sent to the instance.
+	failures atomic.Int64
+	// current is the current weight of the instance in the smooth weighted round-robin.
+	current int
+}
+
+// RoundRobin returns the LoadBalancer sending the requests to the instances in turn. It is the default.
+func RoundRobin() LoadBalancer {
+	return &roundRobin{}
+}
+
+type roundRobin struct {
+	counter atomic.Uint64
+}
+
+func (r *roundRobin) pick(instances []*instance) *instance {
+	return instances[(r.counter.Add(1)-1)%uint64(len(instances))]
+}
+
+// LeastConnections returns the LoadBalancer sending a request to the instance with the fewest requests in flight,
+// so that the slower instances receive fewer requests. The ties are broken in a round-robin.
+func LeastConnections() LoadBalancer {
+	return &leastConnections{}
+}
+
+type leastConnections struct {
+	counter atomic.Uint64
+}
+
+func (l *leastConnections) pick(instances []*instance) *instance {
+	start := int((l.counter.Add(1) - 1) % uint64(len(instances)))
+	least := instances[start]
+
+	for i := 1; i < len(instances); i++ {
+		if in := instances[(start+i)%len(instances)]; in.inFlight.Load() < least.inFlight.Load() {
+			least = in
+		}
+	}
+
+	return least
+}
+
+// Weighted returns the LoadBalancer sending the requests to the instances in proportion to their weight, returned by
+// weight for the address of an instance when it is resolved, e.g. from the metadata of the instance in Consul. The
+// requests are spread using a smooth weighted round-robin, so that an instance does not receive its share at once.
+// An instance whose weight is not positive has a weight of 1.
+func Weighted(weight func(address string) int) LoadBalancer {
+	return &weighted{weight: weight}
+}
+
+type weighted struct {
+	weight func(address string) int
+
+	mu sync.Mutex
+}
+
+func (w *weighted) pick(instances []*instance) *instance {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var (
+		best  *instance
+		total int
+	)
+
+	for _, in := range instances {
+		in.current += in.weight
+		total += in.weight
+
+		if best == nil || in.current > best.current {
+			best = in
+		}
+	}
+
+	best.current -= total
+
+	return best
+}
+
+// weightOf returns the weight of the instance with the address for the load balancer.
+func weightOf(lb LoadBalancer, address string) int {
+	w, ok := lb.(*weighted)
+	if !ok || w.weight == nil {
+		return 1
+	}
+
+	return max(w.weight(address), 1)
+}
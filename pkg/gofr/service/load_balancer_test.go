@@ -0,0 +1,82 @@
+package service
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newInstances(hosts ...string) []*instance {
+	instances := make([]*instance, len(hosts))
+
+	for i, host := range hosts {
+		instances[i] = &instance{url: &url.URL{Scheme: "http", Host: host}, weight: 1}
+	}
+
+	return instances
+}
+
+// picks returns the hosts of the instances picked by lb in n requests.
+func picks(lb LoadBalancer, instances []*instance, n int) []string {
+	hosts := make([]string, n)
+
+	for i := range hosts {
+		hosts[i] = lb.pick(instances).url.Host
+	}
+
+	return hosts
+}
+
+func TestRoundRobin(t *testing.T) {
+	instances := newInstances("a", "b", "c")
+
+	assert.Equal(t, []string{"a", "b", "c", "a"}, picks(RoundRobin(), instances, 4))
+}
+
+func TestLeastConnections(t *testing.T) {
+	instances := newInstances("a", "b", "c")
+
+	instances[0].inFlight.Store(2)
+	instances[1].inFlight.Store(1)
+	instances[2].inFlight.Store(1)
+
+	// the ties between b and c are broken in a round-robin
+	assert.Equal(t, []string{"b", "b", "c", "b"}, picks(LeastConnections(), instances, 4))
+
+	instances[0].inFlight.Store(0)
+
+	assert.Equal(t, []string{"a", "a"}, picks(LeastConnections(), instances, 2))
+}
+
+func TestWeighted(t *testing.T) {
+	weights := map[string]int{"a:80": 5, "b:80": 1, "c:80": 1}
+
+	lb := Weighted(func(address string) int { return weights[address] })
+
+	instances := newInstances("a", "b", "c")
+
+	for _, in := range instances {
+		in.weight = weightOf(lb, in.url.Host+":80")
+	}
+
+	// the smooth weighted round-robin spreads the requests to a among those to b and c
+	assert.Equal(t, []string{"a", "a", "b", "a", "c", "a", "a"}, picks(lb, instances, 7))
+}
+
+func Test_weightOf(t *testing.T) {
+	tests := []struct {
+		desc   string
+		lb     LoadBalancer
+		weight int
+	}{
+		{"round robin", RoundRobin(), 1},
+		{"weighted", Weighted(func(string) int { return 3 }), 3},
+		{"weighted without a positive weight", Weighted(func(string) int { return 0 }), 1},
+		{"weighted without a weight function", Weighted(nil), 1},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.weight, weightOf(tc.lb, "10.0.0.1:8080"), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
@@ -40,6 +40,34 @@ func (m *MockHTTP) EXPECT() *MockHTTPMockRecorder {
 	return m.recorder
 }
 
+// CircuitState mocks base method.
+func (m *MockHTTP) CircuitState() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CircuitState")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// CircuitState indicates an expected call of CircuitState.
+func (mr *MockHTTPMockRecorder) CircuitState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CircuitState", reflect.TypeOf((*MockHTTP)(nil).CircuitState))
+}
+
+// Close mocks base method.
+func (m *MockHTTP) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockHTTPMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockHTTP)(nil).Close))
+}
+
 // Delete mocks base method.
 func (m *MockHTTP) Delete(ctx context.Context, api string, body []byte) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +113,21 @@ func (mr *MockHTTPMockRecorder) Get(ctx, api, queryParams any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockHTTP)(nil).Get), ctx, api, queryParams)
 }
 
+// GetStream mocks base method.
+func (m *MockHTTP) GetStream(ctx context.Context, path string, queryParams map[string]any, headers map[string]string) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStream", ctx, path, queryParams, headers)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStream indicates an expected call of GetStream.
+func (mr *MockHTTPMockRecorder) GetStream(ctx, path, queryParams, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStream", reflect.TypeOf((*MockHTTP)(nil).GetStream), ctx, path, queryParams, headers)
+}
+
 // GetWithHeaders mocks base method.
 func (m *MockHTTP) GetWithHeaders(ctx context.Context, path string, queryParams map[string]any, headers map[string]string) (*http.Response, error) {
 	m.ctrl.T.Helper()
@@ -286,6 +329,21 @@ func (mr *MockhttpClientMockRecorder) Get(ctx, api, queryParams any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockhttpClient)(nil).Get), ctx, api, queryParams)
 }
 
+// GetStream mocks base method.
+func (m *MockhttpClient) GetStream(ctx context.Context, path string, queryParams map[string]any, headers map[string]string) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStream", ctx, path, queryParams, headers)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStream indicates an expected call of GetStream.
+func (mr *MockhttpClientMockRecorder) GetStream(ctx, path, queryParams, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStream", reflect.TypeOf((*MockhttpClient)(nil).GetStream), ctx, path, queryParams, headers)
+}
+
 // GetWithHeaders mocks base method.
 func (m *MockhttpClient) GetWithHeaders(ctx context.Context, path string, queryParams map[string]any, headers map[string]string) (*http.Response, error) {
 	m.ctrl.T.Helper()
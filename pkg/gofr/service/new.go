@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptrace"
 	"strings"
@@ -13,6 +14,8 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
+
+	"gofr.dev/pkg/gofr/http/requestid"
 )
 
 type httpService struct {
@@ -30,6 +33,14 @@ type HTTP interface {
 	// HealthCheck to get the service health and report it to the current application
 	HealthCheck(ctx context.Context) *Health
 	getHealthResponseForEndpoint(ctx context.Context, endpoint string, timeout int) *Health
+
+	// CircuitState returns the state of the circuit breaker of the service, ClosedState, HalfOpenState or OpenState.
+	// It is always ClosedState for the services without a circuit breaker.
+	CircuitState() int
+
+	// Close releases the resources of the service, e.g. stops resolving the instances of a service registered with a
+	// DiscoveryConfig. It is called when the application shuts down.
+	Close() error
 }
 
 type httpClient interface {
@@ -61,6 +72,11 @@ type httpClient interface {
 	Delete(ctx context.Context, api string, body []byte) (*http.Response, error)
 	// DeleteWithHeaders performs an HTTP DELETE request with custom headers.
 	DeleteWithHeaders(ctx context.Context, api string, body []byte, headers map[string]string) (*http.Response, error)
+
+	// GetStream performs an HTTP GET request whose response body is streamed as it is read, bypassing the response
+	// cache. The trace span of the request is ended once the body is read completely or closed, so the body must
+	// always be closed.
+	GetStream(ctx context.Context, path string, queryParams map[string]any, headers map[string]string) (*http.Response, error)
 }
 
 // NewHTTPService function creates a new instance of the httpService struct, which implements the HTTP interface.
@@ -75,11 +91,37 @@ func NewHTTPService(serviceAddress string, logger Logger, metrics Metrics, optio
 		Metrics: metrics,
 	}
 
+	opts := make([]Options, len(options))
+
+	for i, o := range options {
+		if so, ok := o.(serviceOption); ok {
+			o = so.forService(serviceAddress, logger, metrics)
+		}
+
+		opts[i] = o
+	}
+
+	for _, o := range opts {
+		if co, ok := o.(clientOption); ok {
+			co.configureClient(h.Client)
+		}
+	}
+
+	// the balancer wraps the transport once it is configured, e.g. with the timeouts, to send the requests using it
+	for i, o := range opts {
+		switch o := o.(type) {
+		case *DiscoveryConfig:
+			h.Client.Transport = o.withHealth(opts).wrapTransport(h.Client.Transport)
+		case *RateLimiterConfig:
+			opts[i] = o.withHeaderOptions(opts)
+		}
+	}
+
 	var svc HTTP
 	svc = h
 
 	// if options are given, then add them to the httpService struct
-	for _, o := range options {
+	for _, o := range opts {
 		svc = o.AddOption(svc)
 	}
 
@@ -132,14 +174,49 @@ func (h *httpService) DeleteWithHeaders(ctx context.Context, path string, body [
 	return h.createAndSendRequest(ctx, http.MethodDelete, path, nil, body, headers)
 }
 
+func (h *httpService) GetStream(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	uri := h.uri(path)
+
+	ctx, span := h.Tracer.Start(ctx, uri)
+
+	resp, err := h.sendRequest(ctx, http.MethodGet, uri, queryParams, nil, headers)
+	if err != nil {
+		span.End()
+
+		return resp, err
+	}
+
+	resp.Body = &spanBody{ReadCloser: resp.Body, span: span}
+
+	return resp, nil
+}
+
 func (h *httpService) createAndSendRequest(ctx context.Context, method string, path string,
 	queryParams map[string]any, body []byte, headers map[string]string) (*http.Response, error) {
-	uri := h.url + "/" + path
-	uri = strings.TrimRight(uri, "/")
+	uri := h.uri(path)
 
 	ctx, span := h.Tracer.Start(ctx, uri)
 	defer span.End()
 
+	return h.sendRequest(ctx, method, uri, queryParams, body, headers)
+}
+
+// Close stops the transport of the service, e.g. the balancer of a service registered with a DiscoveryConfig.
+func (h *httpService) Close() error {
+	if c, ok := h.Client.Transport.(io.Closer); ok {
+		return c.Close()
+	}
+
+	return nil
+}
+
+func (h *httpService) uri(path string) string {
+	return strings.TrimRight(h.url+"/"+path, "/")
+}
+
+func (h *httpService) sendRequest(ctx context.Context, method, uri string, queryParams map[string]any, body []byte,
+	headers map[string]string) (*http.Response, error) {
 	// Attach client-side trace handling for HTTP request.
 	clientTraceCtx := httptrace.WithClientTrace(ctx, otelhttptrace.NewClientTrace(ctx))
 
@@ -166,6 +243,11 @@ func (h *httpService) createAndSendRequest(ctx context.Context, method string, p
 	// Inject tracing information into the request headers.
 	otel.GetTextMapPropagator().Inject(clientTraceCtx, propagation.HeaderCarrier(req.Header))
 
+	// propagate the ID of the incoming request, unless the caller has set one
+	if id := requestid.FromContext(ctx); id != "" && req.Header.Get(requestid.Header) == "" {
+		req.Header.Set(requestid.Header, id)
+	}
+
 	// encode the query parameters on the request.
 	encodeQueryParameters(req, queryParams)
 
@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/mock/gomock"
 
+	"gofr.dev/pkg/gofr/http/requestid"
 	"gofr.dev/pkg/gofr/logging"
 )
 
@@ -172,6 +173,40 @@ func TestHTTPService_GetWithHeaders(t *testing.T) {
 	assert.NotNil(t, resp, "TEST, Failed.")
 }
 
+func TestHTTPService_RequestIDPropagation(t *testing.T) {
+	var received []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get("X-Request-Id"))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	service := &httpService{
+		Client: http.DefaultClient,
+		url:    server.URL,
+		Tracer: otel.Tracer("gofr-http-client"),
+		Logger: logging.NewMockLogger(logging.INFO),
+	}
+
+	ctx := requestid.NewContext(context.Background(), "req-1")
+
+	for _, headers := range []map[string]string{nil, {"X-Request-Id": "custom"}} {
+		resp, err := service.GetWithHeaders(ctx, "test-path", nil, headers)
+		require.NoError(t, err)
+
+		resp.Body.Close()
+	}
+
+	resp, err := service.Get(context.Background(), "test-path", nil)
+	require.NoError(t, err)
+
+	resp.Body.Close()
+
+	assert.Equal(t, []string{"req-1", "custom", ""}, received)
+}
+
 func TestHTTPService_Put(t *testing.T) {
 	// Setup a test server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2,14 +2,27 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"sync"
+	"time"
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 )
 
+const (
+	// tokenExpiryDelta is the time before its expiry when the token is refreshed.
+	tokenExpiryDelta   = 30 * time.Second
+	tokenFetchTimeout  = 10 * time.Second
+	tokenRetryInterval = 5 * time.Second
+)
+
+// ErrOAuthToken is returned for the requests to a service when the access token cannot be fetched.
+var ErrOAuthToken = errors.New("failed to fetch OAuth token")
+
 // OAuthConfig describes a 2-legged OAuth2 flow, with both the
 // client application information and the server's endpoint URLs.
 type OAuthConfig struct {
@@ -31,38 +44,69 @@ type OAuthConfig struct {
 }
 
 func (h *OAuthConfig) AddOption(svc HTTP) HTTP {
+	config := clientcredentials.Config{
+		ClientID:       h.ClientID,
+		ClientSecret:   h.ClientSecret,
+		TokenURL:       h.TokenURL,
+		Scopes:         h.Scopes,
+		EndpointParams: h.EndpointParams,
+		AuthStyle:      oauth2.AuthStyleInHeader,
+	}
+
+	// the token is fetched without the context of a request, as it is shared by all the requests
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Timeout: tokenFetchTimeout})
+
 	return &oAuth{
-		Config: clientcredentials.Config{
-			ClientID:       h.ClientID,
-			ClientSecret:   h.ClientSecret,
-			TokenURL:       h.TokenURL,
-			Scopes:         h.Scopes,
-			EndpointParams: h.EndpointParams,
-			AuthStyle:      oauth2.AuthStyleInHeader,
-		},
-		HTTP: svc,
+		tokenSource: oauth2.ReuseTokenSourceWithExpiry(nil, config.TokenSource(ctx), tokenExpiryDelta),
+		HTTP:        svc,
 	}
 }
 
+// oAuth adds the access token of the client credentials flow to the requests. The token is cached and refreshed
+// before it expires, and a failure to fetch it is returned for tokenRetryInterval without calling the token
+// endpoint again, so that an unavailable token endpoint is not flooded with requests.
 type oAuth struct {
-	clientcredentials.Config
+	tokenSource oauth2.TokenSource
+
+	mu       sync.Mutex
+	err      error
+	failedAt time.Time
 
 	HTTP
 }
 
-func (o *oAuth) addAuthorizationHeader(ctx context.Context, headers map[string]string) (map[string]string, error) {
-	var err error
+func (o *oAuth) token() (*oauth2.Token, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.err != nil && time.Since(o.failedAt) < tokenRetryInterval {
+		return nil, o.err
+	}
+
+	token, err := o.tokenSource.Token()
+	if err != nil {
+		o.err = fmt.Errorf("%w: %w", ErrOAuthToken, err)
+		o.failedAt = time.Now()
+
+		return nil, o.err
+	}
+
+	o.err = nil
+
+	return token, nil
+}
 
+func (o *oAuth) addAuthorizationHeader(_ context.Context, headers map[string]string) (map[string]string, error) {
 	if headers == nil {
 		headers = make(map[string]string)
 	}
 
-	token, err := o.TokenSource(ctx).Token()
+	token, err := o.token()
 	if err != nil {
 		return nil, err
 	}
 
-	headers["Authorization"] = fmt.Sprintf("%v %v", token.TokenType, token.AccessToken)
+	headers["Authorization"] = fmt.Sprintf("%v %v", token.Type(), token.AccessToken)
 
 	return headers, nil
 }
@@ -121,6 +165,16 @@ func (o *oAuth) DeleteWithHeaders(ctx context.Context, path string, body []byte,
 	return o.HTTP.DeleteWithHeaders(ctx, path, body, headers)
 }
 
+func (o *oAuth) GetStream(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	headers, err := o.addAuthorizationHeader(ctx, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.HTTP.GetStream(ctx, path, queryParams, headers)
+}
+
 func (o *oAuth) Get(ctx context.Context, path string, queryParams map[string]any) (*http.Response, error) {
 	return o.GetWithHeaders(ctx, path, queryParams, nil)
 }
@@ -2,9 +2,11 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -208,3 +210,84 @@ func TestHttpService_GetRequestsOAuthError(t *testing.T) {
 		resp.Body.Close()
 	}
 }
+
+func oAuthTokenServer(t *testing.T, status int, expiresIn int, tokenRequests *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		tokenRequests.Add(1)
+
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":%d}`, tokenRequests.Load(), expiresIn)
+	}))
+}
+
+func TestHttpService_OAuthTokenCaching(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		expiresIn     int
+		tokenRequests int32
+		lastToken     string
+	}{
+		{desc: "token reused until expiry", expiresIn: 3600, tokenRequests: 1, lastToken: "Bearer token-1"},
+		{desc: "token refreshed before expiry", expiresIn: 10, tokenRequests: 3, lastToken: "Bearer token-3"},
+	}
+
+	for i, tc := range testCases {
+		var tokenRequests atomic.Int32
+
+		tokenServer := oAuthTokenServer(t, http.StatusOK, tc.expiresIn, &tokenRequests)
+
+		var authorization string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("Authorization")
+
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		svc := (&OAuthConfig{ClientID: "id", ClientSecret: "secret", TokenURL: tokenServer.URL}).
+			AddOption(&httpService{Client: &http.Client{}, url: server.URL, Tracer: otel.Tracer("gofr-http-client"),
+				Logger: logging.NewMockLogger(logging.DEBUG)})
+
+		for range 3 {
+			resp, err := svc.Get(context.Background(), "test", nil)
+			require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+			resp.Body.Close()
+		}
+
+		assert.Equal(t, tc.tokenRequests, tokenRequests.Load(), "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.lastToken, authorization, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		server.Close()
+		tokenServer.Close()
+	}
+}
+
+func TestHttpService_OAuthTokenError(t *testing.T) {
+	var tokenRequests atomic.Int32
+
+	tokenServer := oAuthTokenServer(t, http.StatusUnauthorized, 0, &tokenRequests)
+	defer tokenServer.Close()
+
+	svc := (&OAuthConfig{ClientID: "id", ClientSecret: "invalid", TokenURL: tokenServer.URL}).
+		AddOption(&httpService{Client: &http.Client{}, url: "http://localhost", Tracer: otel.Tracer("gofr-http-client"),
+			Logger: logging.NewMockLogger(logging.DEBUG)})
+
+	for range 3 {
+		resp, err := svc.Get(context.Background(), "test", nil)
+
+		require.ErrorIs(t, err, ErrOAuthToken)
+		assert.Nil(t, resp)
+	}
+
+	// the failure is returned without calling the token endpoint again until the retry interval elapses
+	assert.Equal(t, int32(1), tokenRequests.Load())
+}
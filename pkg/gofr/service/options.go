@@ -1,5 +1,19 @@
 package service
 
+import "net/http"
+
 type Options interface {
 	AddOption(h HTTP) HTTP
 }
+
+// serviceOption is implemented by the options which need the address, the logger or the metrics of the service they
+// are added to, e.g. to label their metrics.
+type serviceOption interface {
+	forService(address string, logger Logger, metrics Metrics) Options
+}
+
+// clientOption is implemented by the options which configure the http.Client of the service, e.g. its timeouts. They
+// are applied to the client whatever their position among the options of the service.
+type clientOption interface {
+	configureClient(c *http.Client)
+}
@@ -0,0 +1,334 @@
+package service
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultRateLimiterMaxKeys = 1000
+
+// ErrorRateLimitExceeded is returned for the requests to a service which are rejected by its rate limiter, as the
+// requests allowed for their key are used up.
+type ErrorRateLimitExceeded struct {
+	Service string
+	Key     string
+}
+
+func (e ErrorRateLimitExceeded) Error() string {
+	if e.Key == "" {
+		return fmt.Sprintf("rate limit of the service %s exceeded", e.Service)
+	}
+
+	return fmt.Sprintf("rate limit of the service %s exceeded for %s", e.Service, e.Key)
+}
+
+func (ErrorRateLimitExceeded) StatusCode() int {
+	return http.StatusTooManyRequests
+}
+
+// RateLimiterConfig limits the rate of the requests to the service using token buckets, failing the requests beyond
+// the limit fast with ErrorRateLimitExceeded. The requests share a single bucket, unless KeyFunc buckets them, e.g.
+// per path or per tenant, so that a hot endpoint does not use up the requests allowed for the others.
+type RateLimiterConfig struct {
+	// Requests is the number of requests allowed per Per for each key. The rate limiter is disabled if it is not
+	// positive.
+	Requests int
+	// Per is the period of Requests. Defaults to 1 second.
+	Per time.Duration
+	// Burst is the maximum number of requests allowed at once for each key. Defaults to Requests.
+	Burst int
+	// KeyFunc returns the key whose bucket limits a request, given a request carrying the method, URL, query
+	// parameters and headers of the call, including the headers added by the DefaultHeaders, APIKeyConfig and
+	// BasicAuthConfig options of the service. All the requests share a single bucket if it is nil.
+	KeyFunc func(r *http.Request) string
+	// MaxKeys is the maximum number of buckets kept, beyond which the least recently used ones are evicted.
+	// Defaults to 1000.
+	MaxKeys int
+
+	metrics Metrics
+	service string
+
+	// headerOptions are the options of the service adding headers to its requests.
+	headerOptions []Options
+}
+
+func (r *RateLimiterConfig) AddOption(h HTTP) HTTP {
+	if r.Requests <= 0 {
+		return h
+	}
+
+	per := r.Per
+	if per <= 0 {
+		per = time.Second
+	}
+
+	burst := r.Burst
+	if burst <= 0 {
+		burst = r.Requests
+	}
+
+	maxKeys := r.MaxKeys
+	if maxKeys <= 0 {
+		maxKeys = defaultRateLimiterMaxKeys
+	}
+
+	return &rateLimiter{
+		rate:          float64(r.Requests) / per.Seconds(),
+		burst:         float64(burst),
+		keyFunc:       r.KeyFunc,
+		maxKeys:       maxKeys,
+		buckets:       list.New(),
+		keys:          make(map[string]*list.Element),
+		now:           time.Now,
+		metrics:       r.metrics,
+		service:       r.service,
+		headerOptions: r.headerOptions,
+		HTTP:          h,
+	}
+}
+
+// forService returns a copy of the config which counts the requests rejected of the service in the metrics.
+func (r *RateLimiterConfig) forService(address string, _ Logger, metrics Metrics) Options {
+	c := *r
+	c.metrics = metrics
+	c.service = address
+
+	return &c
+}
+
+// withHeaderOptions returns a copy of the config which gives KeyFunc the headers added by the options of the service,
+// whether they are added before or after the rate limiter.
+func (r *RateLimiterConfig) withHeaderOptions(options []Options) *RateLimiterConfig {
+	c := *r
+	c.headerOptions = nil
+
+	for _, o := range options {
+		switch o.(type) {
+		case *DefaultHeaders, *APIKeyConfig, *BasicAuthConfig:
+			c.headerOptions = append(c.headerOptions, o)
+		}
+	}
+
+	return &c
+}
+
+// isRejected returns true for the errors of the requests rejected by the service itself, i.e. by its rate limiter or
+// bulkhead, which are neither failures of the service nor worth retrying.
+func isRejected(err error) bool {
+	var (
+		rateLimited ErrorRateLimitExceeded
+		bulkhead    ErrorBulkheadFull
+	)
+
+	return errors.As(err, &rateLimited) || errors.As(err, &bulkhead)
+}
+
+type rateLimiter struct {
+	// rate is the number of tokens added to a bucket per second, up to burst.
+	rate    float64
+	burst   float64
+	keyFunc func(r *http.Request) string
+
+	// buckets is an LRU list of the buckets of the keys, most recently used first.
+	mu      sync.Mutex
+	maxKeys int
+	buckets *list.List
+	keys    map[string]*list.Element
+	now     func() time.Time
+
+	metrics       Metrics
+	service       string
+	headerOptions []Options
+
+	HTTP
+}
+
+type tokenBucket struct {
+	key     string
+	tokens  float64
+	updated time.Time
+}
+
+// allow takes a token from the bucket of the key, and reports whether one was available.
+func (rl *rateLimiter) allow(key string) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := rl.now()
+
+	e, ok := rl.keys[key]
+	if !ok {
+		e = rl.buckets.PushFront(&tokenBucket{key: key, tokens: rl.burst, updated: now})
+		rl.keys[key] = e
+
+		for rl.buckets.Len() > rl.maxKeys {
+			evicted, _ := rl.buckets.Remove(rl.buckets.Back()).(*tokenBucket)
+			delete(rl.keys, evicted.key)
+		}
+	}
+
+	rl.buckets.MoveToFront(e)
+
+	b, _ := e.Value.(*tokenBucket)
+	b.tokens = min(rl.burst, b.tokens+now.Sub(b.updated).Seconds()*rl.rate)
+	b.updated = now
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+
+	return true
+}
+
+func (rl *rateLimiter) do(ctx context.Context, method, path string, queryParams map[string]any,
+	headers map[string]string, f func() (*http.Response, error)) (*http.Response, error) {
+	key := rl.key(ctx, method, path, queryParams, headers)
+
+	if !rl.allow(key) {
+		if rl.metrics != nil {
+			rl.metrics.IncrementCounter(ctx, "app_http_service_rate_limited_total", "service", rl.service)
+		}
+
+		return nil, ErrorRateLimitExceeded{Service: rl.service, Key: key}
+	}
+
+	return f()
+}
+
+func (rl *rateLimiter) key(ctx context.Context, method, path string, queryParams map[string]any,
+	headers map[string]string) string {
+	if rl.keyFunc == nil {
+		return ""
+	}
+
+	uri := strings.TrimRight(rl.service+"/"+path, "/")
+
+	req, err := http.NewRequestWithContext(ctx, method, uri, http.NoBody)
+	if err != nil {
+		req = &http.Request{Method: method, URL: &url.URL{Path: path}, Header: make(http.Header)}
+	}
+
+	for k, v := range rl.headers(ctx, headers) {
+		req.Header.Set(k, v)
+	}
+
+	encodeQueryParameters(req, queryParams)
+
+	return rl.keyFunc(req)
+}
+
+// headers returns the headers of the request along with the ones added by the header options of the service.
+func (rl *rateLimiter) headers(ctx context.Context, headers map[string]string) map[string]string {
+	recorder := &headerRecorder{headers: maps.Clone(headers)}
+
+	var h HTTP = recorder
+
+	for _, o := range rl.headerOptions {
+		h = o.AddOption(h)
+	}
+
+	// the headers of an option failing to provide them, e.g. as its HeaderProvider failed, are left out
+	_, _ = h.GetWithHeaders(ctx, "", nil, maps.Clone(headers))
+
+	return recorder.headers
+}
+
+// headerRecorder is placed under the header options of a service to record the headers they add to a request,
+// instead of sending it.
+type headerRecorder struct {
+	headers map[string]string
+
+	HTTP
+}
+
+func (r *headerRecorder) GetWithHeaders(_ context.Context, _ string, _ map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	r.headers = headers
+
+	return nil, nil
+}
+
+func (rl *rateLimiter) Get(ctx context.Context, path string, queryParams map[string]any) (*http.Response, error) {
+	return rl.do(ctx, http.MethodGet, path, queryParams, nil, func() (*http.Response, error) {
+		return rl.HTTP.Get(ctx, path, queryParams)
+	})
+}
+
+func (rl *rateLimiter) GetWithHeaders(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	return rl.do(ctx, http.MethodGet, path, queryParams, headers, func() (*http.Response, error) {
+		return rl.HTTP.GetWithHeaders(ctx, path, queryParams, headers)
+	})
+}
+
+func (rl *rateLimiter) GetStream(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	return rl.do(ctx, http.MethodGet, path, queryParams, headers, func() (*http.Response, error) {
+		return rl.HTTP.GetStream(ctx, path, queryParams, headers)
+	})
+}
+
+func (rl *rateLimiter) Post(ctx context.Context, path string, queryParams map[string]any,
+	body []byte) (*http.Response, error) {
+	return rl.do(ctx, http.MethodPost, path, queryParams, nil, func() (*http.Response, error) {
+		return rl.HTTP.Post(ctx, path, queryParams, body)
+	})
+}
+
+func (rl *rateLimiter) PostWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
+	headers map[string]string) (*http.Response, error) {
+	return rl.do(ctx, http.MethodPost, path, queryParams, headers, func() (*http.Response, error) {
+		return rl.HTTP.PostWithHeaders(ctx, path, queryParams, body, headers)
+	})
+}
+
+func (rl *rateLimiter) Put(ctx context.Context, path string, queryParams map[string]any, body []byte) (
+	*http.Response, error) {
+	return rl.do(ctx, http.MethodPut, path, queryParams, nil, func() (*http.Response, error) {
+		return rl.HTTP.Put(ctx, path, queryParams, body)
+	})
+}
+
+func (rl *rateLimiter) PutWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
+	headers map[string]string) (*http.Response, error) {
+	return rl.do(ctx, http.MethodPut, path, queryParams, headers, func() (*http.Response, error) {
+		return rl.HTTP.PutWithHeaders(ctx, path, queryParams, body, headers)
+	})
+}
+
+func (rl *rateLimiter) Patch(ctx context.Context, path string, queryParams map[string]any, body []byte) (
+	*http.Response, error) {
+	return rl.do(ctx, http.MethodPatch, path, queryParams, nil, func() (*http.Response, error) {
+		return rl.HTTP.Patch(ctx, path, queryParams, body)
+	})
+}
+
+func (rl *rateLimiter) PatchWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
+	headers map[string]string) (*http.Response, error) {
+	return rl.do(ctx, http.MethodPatch, path, queryParams, headers, func() (*http.Response, error) {
+		return rl.HTTP.PatchWithHeaders(ctx, path, queryParams, body, headers)
+	})
+}
+
+func (rl *rateLimiter) Delete(ctx context.Context, path string, body []byte) (*http.Response, error) {
+	return rl.do(ctx, http.MethodDelete, path, nil, nil, func() (*http.Response, error) {
+		return rl.HTTP.Delete(ctx, path, body)
+	})
+}
+
+func (rl *rateLimiter) DeleteWithHeaders(ctx context.Context, path string, body []byte, headers map[string]string) (
+	*http.Response, error) {
+	return rl.do(ctx, http.MethodDelete, path, nil, headers, func() (*http.Response, error) {
+		return rl.HTTP.DeleteWithHeaders(ctx, path, body, headers)
+	})
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/logging"
+)
+
+func TestRateLimiter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	t.Cleanup(server.Close)
+
+	ctrl := gomock.NewController(t)
+	metrics := NewMockMetrics(ctrl)
+
+	metrics.EXPECT().RecordHistogram(gomock.Any(), "app_http_service_response", gomock.Any(), gomock.Any()).AnyTimes()
+	metrics.EXPECT().IncrementCounter(gomock.Any(), "app_http_service_rate_limited_total", "service", server.URL).Times(2)
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), metrics, &RateLimiterConfig{
+		Requests: 1,
+		Per:      time.Hour,
+		KeyFunc: func(r *http.Request) string {
+			return r.Header.Get("X-Tenant") + " " + r.URL.Path
+		},
+	})
+
+	tests := []struct {
+		desc    string
+		path    string
+		tenant  string
+		limited bool
+	}{
+		{"first request of a tenant", "users", "acme", false},
+		{"second request of a tenant", "users", "acme", true},
+		{"another path of the tenant", "orders", "acme", false},
+		{"another tenant", "users", "globex", false},
+		{"second request of another tenant", "users", "globex", true},
+	}
+
+	for i, tc := range tests {
+		resp, err := svc.GetWithHeaders(context.Background(), tc.path, nil, map[string]string{"X-Tenant": tc.tenant})
+
+		if !tc.limited {
+			require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+			resp.Body.Close()
+
+			continue
+		}
+
+		var limitErr ErrorRateLimitExceeded
+
+		require.ErrorAs(t, err, &limitErr, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, ErrorRateLimitExceeded{Service: server.URL, Key: tc.tenant + " /" + tc.path}, limitErr,
+			"TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, http.StatusTooManyRequests, limitErr.StatusCode(), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestRateLimiter_KeyFuncRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	t.Cleanup(server.Close)
+
+	var keyReq *http.Request
+
+	// the rate limiter is added before the option adding the tenant header
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), nil,
+		&RateLimiterConfig{Requests: 10, KeyFunc: func(r *http.Request) string {
+			keyReq = r
+			return r.Header.Get("X-Tenant")
+		}},
+		&DefaultHeaders{Headers: map[string]string{"X-Tenant": "acme"}},
+	)
+
+	resp, err := svc.Get(context.Background(), "users", map[string]any{"page": 2})
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	require.NotNil(t, keyReq)
+	assert.Equal(t, "acme", keyReq.Header.Get("X-Tenant"))
+	assert.Equal(t, "/users", keyReq.URL.Path)
+	assert.Equal(t, "2", keyReq.URL.Query().Get("page"))
+}
+
+func TestRateLimiter_WithCircuitBreakerAndRetry(t *testing.T) {
+	tests := []struct {
+		desc    string
+		options func() []Options
+	}{
+		{"rate limiter innermost", func() []Options {
+			return []Options{&RetryConfig{MaxRetries: 3, BaseBackoff: time.Millisecond},
+				&CircuitBreakerConfig{Threshold: 1, Interval: time.Hour}, &RateLimiterConfig{Requests: 1, Per: time.Hour}}
+		}},
+		{"rate limiter outermost", func() []Options {
+			return []Options{&RateLimiterConfig{Requests: 1, Per: time.Hour},
+				&CircuitBreakerConfig{Threshold: 1, Interval: time.Hour}, &RetryConfig{MaxRetries: 3, BaseBackoff: time.Millisecond}}
+		}},
+	}
+
+	for i, tc := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+
+		ctrl := gomock.NewController(t)
+		metrics := NewMockMetrics(ctrl)
+
+		metrics.EXPECT().RecordHistogram(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		metrics.EXPECT().SetGauge(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		// the rejected requests are neither retried nor counted as failures opening the circuit
+		metrics.EXPECT().IncrementCounter(gomock.Any(), "app_http_service_rate_limited_total", "service", server.URL).Times(3)
+
+		svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.FATAL), metrics, tc.options()...)
+
+		resp, err := svc.Get(context.Background(), "users", nil)
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+		resp.Body.Close()
+
+		for range 3 {
+			_, err = svc.Get(context.Background(), "users", nil)
+
+			require.ErrorAs(t, err, &ErrorRateLimitExceeded{}, "TEST[%d], Failed.\n%s", i, tc.desc)
+		}
+
+		server.Close()
+	}
+}
+
+func TestRateLimiter_Refill(t *testing.T) {
+	now := time.Now()
+
+	rl, ok := (&RateLimiterConfig{Requests: 2, Per: time.Second, Burst: 1}).AddOption(nil).(*rateLimiter)
+	require.True(t, ok)
+
+	rl.now = func() time.Time { return now }
+
+	assert.True(t, rl.allow(""))
+	assert.False(t, rl.allow(""), "the burst is used up")
+
+	now = now.Add(500 * time.Millisecond)
+
+	assert.True(t, rl.allow(""), "a token is added every 500ms")
+	assert.False(t, rl.allow(""))
+}
+
+func TestRateLimiter_EvictsLeastRecentlyUsed(t *testing.T) {
+	rl, ok := (&RateLimiterConfig{Requests: 1, Per: time.Hour, MaxKeys: 2}).AddOption(nil).(*rateLimiter)
+	require.True(t, ok)
+
+	assert.True(t, rl.allow("a"))
+	assert.True(t, rl.allow("b"))
+	assert.False(t, rl.allow("a"))
+
+	// "b" is the least recently used key, so its bucket is evicted for "c"
+	assert.True(t, rl.allow("c"))
+	assert.Len(t, rl.keys, 2)
+	assert.True(t, rl.allow("b"), "the bucket of an evicted key starts full")
+	assert.NotContains(t, rl.keys, "a")
+}
+
+func TestRateLimiter_Disabled(t *testing.T) {
+	h := &httpService{}
+
+	assert.Same(t, h, (&RateLimiterConfig{}).AddOption(h))
+}
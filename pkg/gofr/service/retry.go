@@ -2,43 +2,108 @@ package service
 
 import (
 	"context"
+	"errors"
+	"math/rand/v2"
 	"net/http"
+	"slices"
+	"time"
 )
 
+const (
+	defaultBaseBackoff = 100 * time.Millisecond
+	defaultMaxBackoff  = 2 * time.Second
+)
+
+// RetryConfig retries the requests to the service which fail with a connection error or a retryable status,
+// waiting for an exponential backoff with jitter between the attempts. Retries stop when the context of the
+// request is done or its deadline would pass during the backoff, and when the circuit breaker is open.
 type RetryConfig struct {
+	// MaxRetries is the number of times a failed request is retried, i.e. a request is sent at most MaxRetries+1
+	// times. Earlier, it was the number of times a request was sent.
 	MaxRetries int
+	// BaseBackoff is the backoff before the first retry, which doubles for every retry. Defaults to 100ms.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the backoff between the retries. Defaults to 2s.
+	MaxBackoff time.Duration
+	// RetryableStatus are the response status codes which are retried. Defaults to 500, which was the only status
+	// retried earlier, along with 502, 503 and 504.
+	RetryableStatus []int
+	// RetryableMethods are the HTTP methods whose requests are retried. Defaults to all the methods, as earlier.
+	// Setting it to the idempotent methods, GET, PUT and DELETE, keeps the requests which are not safe to send twice,
+	// e.g. a POST creating an order, from being retried.
+	RetryableMethods []string
+
+	metrics Metrics
+	service string
 }
 
 func (r *RetryConfig) AddOption(h HTTP) HTTP {
-	return &retryProvider{
-		maxRetries: r.MaxRetries,
-		HTTP:       h,
+	rp := &retryProvider{
+		maxRetries:       r.MaxRetries,
+		baseBackoff:      r.BaseBackoff,
+		maxBackoff:       r.MaxBackoff,
+		retryableStatus:  r.RetryableStatus,
+		retryableMethods: r.RetryableMethods,
+		metrics:          r.metrics,
+		service:          r.service,
+		HTTP:             h,
+	}
+
+	if rp.baseBackoff <= 0 {
+		rp.baseBackoff = defaultBaseBackoff
+	}
+
+	if rp.maxBackoff <= 0 {
+		rp.maxBackoff = defaultMaxBackoff
+	}
+
+	if rp.retryableStatus == nil {
+		rp.retryableStatus = []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout}
 	}
+
+	return rp
+}
+
+// forService returns a copy of the config which counts the retries of the service in the metrics.
+func (r *RetryConfig) forService(address string, _ Logger, metrics Metrics) Options {
+	c := *r
+	c.metrics = metrics
+	c.service = address
+
+	return &c
 }
 
 type retryProvider struct {
-	maxRetries int
+	maxRetries       int
+	baseBackoff      time.Duration
+	maxBackoff       time.Duration
+	retryableStatus  []int
+	retryableMethods []string
+
+	metrics Metrics
+	service string
 
 	HTTP
 }
 
 func (rp *retryProvider) Get(ctx context.Context, path string, queryParams map[string]any) (*http.Response,
 	error) {
-	return rp.doWithRetry(func() (*http.Response, error) {
+	return rp.doWithRetry(ctx, http.MethodGet, func() (*http.Response, error) {
 		return rp.HTTP.Get(ctx, path, queryParams)
 	})
 }
 
 func (rp *retryProvider) GetWithHeaders(ctx context.Context, path string, queryParams map[string]any,
 	headers map[string]string) (*http.Response, error) {
-	return rp.doWithRetry(func() (*http.Response, error) {
+	return rp.doWithRetry(ctx, http.MethodGet, func() (*http.Response, error) {
 		return rp.HTTP.GetWithHeaders(ctx, path, queryParams, headers)
 	})
 }
 
 func (rp *retryProvider) Post(ctx context.Context, path string, queryParams map[string]any,
 	body []byte) (*http.Response, error) {
-	return rp.doWithRetry(func() (*http.Response, error) {
+	return rp.doWithRetry(ctx, http.MethodPost, func() (*http.Response, error) {
 		return rp.HTTP.Post(ctx, path, queryParams, body)
 	})
 }
@@ -46,64 +111,122 @@ func (rp *retryProvider) Post(ctx context.Context, path string, queryParams map[
 func (rp *retryProvider) PostWithHeaders(ctx context.Context, path string, queryParams map[string]any,
 	body []byte,
 	headers map[string]string) (*http.Response, error) {
-	return rp.doWithRetry(func() (*http.Response, error) {
+	return rp.doWithRetry(ctx, http.MethodPost, func() (*http.Response, error) {
 		return rp.HTTP.PostWithHeaders(ctx, path, queryParams, body, headers)
 	})
 }
 
 func (rp *retryProvider) Put(ctx context.Context, api string, queryParams map[string]any, body []byte) (
 	*http.Response, error) {
-	return rp.doWithRetry(func() (*http.Response, error) {
+	return rp.doWithRetry(ctx, http.MethodPut, func() (*http.Response, error) {
 		return rp.HTTP.Put(ctx, api, queryParams, body)
 	})
 }
 
 func (rp *retryProvider) PutWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
 	headers map[string]string) (*http.Response, error) {
-	return rp.doWithRetry(func() (*http.Response, error) {
+	return rp.doWithRetry(ctx, http.MethodPut, func() (*http.Response, error) {
 		return rp.HTTP.PutWithHeaders(ctx, path, queryParams, body, headers)
 	})
 }
 
 func (rp *retryProvider) Patch(ctx context.Context, path string, queryParams map[string]any, body []byte) (
 	*http.Response, error) {
-	return rp.doWithRetry(func() (*http.Response, error) {
+	return rp.doWithRetry(ctx, http.MethodPatch, func() (*http.Response, error) {
 		return rp.HTTP.Patch(ctx, path, queryParams, body)
 	})
 }
 
 func (rp *retryProvider) PatchWithHeaders(ctx context.Context, path string, queryParams map[string]any, body []byte,
 	headers map[string]string) (*http.Response, error) {
-	return rp.doWithRetry(func() (*http.Response, error) {
+	return rp.doWithRetry(ctx, http.MethodPatch, func() (*http.Response, error) {
 		return rp.HTTP.PatchWithHeaders(ctx, path, queryParams, body, headers)
 	})
 }
 
 func (rp *retryProvider) Delete(ctx context.Context, path string, body []byte) (*http.Response, error) {
-	return rp.doWithRetry(func() (*http.Response, error) {
+	return rp.doWithRetry(ctx, http.MethodDelete, func() (*http.Response, error) {
 		return rp.HTTP.Delete(ctx, path, body)
 	})
 }
 
 func (rp *retryProvider) DeleteWithHeaders(ctx context.Context, path string, body []byte, headers map[string]string) (
 	*http.Response, error) {
-	return rp.doWithRetry(func() (*http.Response, error) {
+	return rp.doWithRetry(ctx, http.MethodDelete, func() (*http.Response, error) {
 		return rp.HTTP.DeleteWithHeaders(ctx, path, body, headers)
 	})
 }
 
-func (rp *retryProvider) doWithRetry(reqFunc func() (*http.Response, error)) (*http.Response, error) {
-	var (
-		resp *http.Response
-		err  error
-	)
+func (rp *retryProvider) GetStream(ctx context.Context, path string, queryParams map[string]any,
+	headers map[string]string) (*http.Response, error) {
+	return rp.doWithRetry(ctx, http.MethodGet, func() (*http.Response, error) {
+		return rp.HTTP.GetStream(ctx, path, queryParams, headers)
+	})
+}
+
+func (rp *retryProvider) doWithRetry(ctx context.Context, method string,
+	reqFunc func() (*http.Response, error)) (*http.Response, error) {
+	resp, err := reqFunc()
 
-	for i := 0; i < rp.maxRetries; i++ {
-		resp, err = reqFunc()
-		if err == nil && resp.StatusCode != http.StatusInternalServerError {
-			return resp, nil
+	if rp.retryableMethods != nil && !slices.Contains(rp.retryableMethods, method) {
+		return resp, err
+	}
+
+	for attempt := 0; attempt < rp.maxRetries && rp.shouldRetry(resp, err); attempt++ {
+		if !rp.wait(ctx, attempt) {
+			break
 		}
+
+		// the response of the failed attempt is discarded as the request is sent again
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		if rp.metrics != nil {
+			rp.metrics.IncrementCounter(ctx, "app_http_service_retries_total", "service", rp.service, "method", method)
+		}
+
+		resp, err = reqFunc()
 	}
 
 	return resp, err
 }
+
+// shouldRetry returns true for connection errors and retryable status codes. Requests rejected by an open
+// circuit breaker, the rate limiter or the bulkhead and cancelled requests are not retried, so that the retries do
+// not defeat them.
+func (rp *retryProvider) shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, ErrCircuitOpen) && !isRejected(err) && !errors.Is(err, context.Canceled) &&
+			!errors.Is(err, context.DeadlineExceeded)
+	}
+
+	return resp != nil && slices.Contains(rp.retryableStatus, resp.StatusCode)
+}
+
+// wait sleeps for the backoff of the attempt, and returns false if the context is done before the backoff
+// or would be past its deadline after it.
+func (rp *retryProvider) wait(ctx context.Context, attempt int) bool {
+	backoff := rp.baseBackoff << attempt
+	if backoff <= 0 || backoff > rp.maxBackoff {
+		backoff = rp.maxBackoff
+	}
+
+	// full jitter spreads the retries of the clients which failed together
+	//nolint:gosec // the jitter does not need a cryptographically secure random number
+	backoff = time.Duration(rand.Int64N(int64(backoff)) + 1)
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < backoff {
+		return false
+	}
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
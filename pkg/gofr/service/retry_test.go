@@ -2,12 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
 	"gofr.dev/pkg/gofr/logging"
 )
@@ -28,6 +31,14 @@ func (*mockHTTP) getHealthResponseForEndpoint(_ context.Context, _ string, _ int
 	}
 }
 
+func (*mockHTTP) CircuitState() int {
+	return ClosedState
+}
+
+func (*mockHTTP) Close() error {
+	return nil
+}
+
 func (*mockHTTP) Get(_ context.Context, _ string, _ map[string]any) (*http.Response, error) {
 	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
 }
@@ -71,6 +82,10 @@ func (*mockHTTP) DeleteWithHeaders(_ context.Context, _ string, _ []byte, _ map[
 	return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
 }
 
+func (*mockHTTP) GetStream(_ context.Context, _ string, _ map[string]any, _ map[string]string) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
 func TestRetryProvider_Get(t *testing.T) {
 	mockHTTP := &mockHTTP{}
 	retryConfig := &RetryConfig{MaxRetries: 3}
@@ -170,7 +185,7 @@ func TestRetryProvider_Patch_WithError(t *testing.T) {
 
 	// Create a new HTTP service instance with basic auth
 	httpService := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), nil,
-		&RetryConfig{MaxRetries: 5})
+		&RetryConfig{MaxRetries: 5, BaseBackoff: time.Millisecond})
 
 	// Make the PATCH request
 	resp, err := httpService.Patch(context.Background(), "/test", nil, []byte("body"))
@@ -223,3 +238,148 @@ func TestRetryProvider_DeleteWithHeaders(t *testing.T) {
 
 	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
 }
+
+func TestRetryProvider_RetryableFailures(t *testing.T) {
+	tests := []struct {
+		desc     string
+		method   string
+		statuses []int
+		config   RetryConfig
+		attempts int
+		status   int
+	}{
+		{"GET retried until success", http.MethodGet,
+			[]int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK}, RetryConfig{MaxRetries: 3}, 3, http.StatusOK},
+		{"GET retries exhausted", http.MethodGet,
+			[]int{http.StatusGatewayTimeout}, RetryConfig{MaxRetries: 2}, 3, http.StatusGatewayTimeout},
+		{"internal server error retried by default", http.MethodGet,
+			[]int{http.StatusInternalServerError, http.StatusOK}, RetryConfig{MaxRetries: 3}, 2, http.StatusOK},
+		{"non retryable status", http.MethodGet,
+			[]int{http.StatusNotImplemented}, RetryConfig{MaxRetries: 3}, 1, http.StatusNotImplemented},
+		{"POST retried by default", http.MethodPost,
+			[]int{http.StatusServiceUnavailable, http.StatusCreated}, RetryConfig{MaxRetries: 3}, 2, http.StatusCreated},
+		{"POST not retried when not configured", http.MethodPost,
+			[]int{http.StatusServiceUnavailable},
+			RetryConfig{MaxRetries: 3, RetryableMethods: []string{http.MethodGet}}, 1, http.StatusServiceUnavailable},
+		{"custom retryable status", http.MethodGet,
+			[]int{http.StatusTooManyRequests, http.StatusOK},
+			RetryConfig{MaxRetries: 3, RetryableStatus: []int{http.StatusTooManyRequests}}, 2, http.StatusOK},
+	}
+
+	for i, tc := range tests {
+		attempts := 0
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(tc.statuses[min(attempts, len(tc.statuses)-1)])
+			attempts++
+		}))
+
+		tc.config.BaseBackoff = time.Millisecond
+
+		svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), nil, &tc.config)
+
+		var (
+			resp *http.Response
+			err  error
+		)
+
+		if tc.method == http.MethodGet {
+			resp, err = svc.Get(context.Background(), "test", nil)
+		} else {
+			resp, err = svc.Post(context.Background(), "test", nil, []byte("body"))
+		}
+
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		assert.Equal(t, tc.status, resp.StatusCode, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.attempts, attempts, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		resp.Body.Close()
+		server.Close()
+	}
+}
+
+type failingHTTP struct {
+	mockHTTP
+
+	err      error
+	attempts int
+}
+
+func (f *failingHTTP) GetWithHeaders(context.Context, string, map[string]any, map[string]string) (*http.Response, error) {
+	f.attempts++
+
+	return nil, f.err
+}
+
+func TestRetryProvider_Errors(t *testing.T) {
+	tests := []struct {
+		desc     string
+		err      error
+		attempts int
+	}{
+		{"connection error retried", errors.New("connection refused"), 3},
+		{"open circuit not retried", ErrCircuitOpen, 1},
+		{"cancelled request not retried", context.Canceled, 1},
+	}
+
+	for i, tc := range tests {
+		h := &failingHTTP{err: tc.err}
+		svc := (&RetryConfig{MaxRetries: 2, BaseBackoff: time.Millisecond}).AddOption(h)
+
+		resp, err := svc.GetWithHeaders(context.Background(), "test", nil, nil)
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		require.ErrorIs(t, err, tc.err, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.attempts, h.attempts, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestRetryProvider_RespectsDeadline(t *testing.T) {
+	h := &failingHTTP{err: errors.New("connection refused")}
+	svc := (&RetryConfig{MaxRetries: 5, BaseBackoff: time.Second, MaxBackoff: time.Second}).AddOption(h)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	resp, err := svc.GetWithHeaders(ctx, "test", nil, nil)
+	if resp != nil {
+		resp.Body.Close()
+	}
+
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.LessOrEqual(t, h.attempts, 2)
+}
+
+func TestRetryProvider_Metrics(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if attempts++; attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	ctrl := gomock.NewController(t)
+	metrics := NewMockMetrics(ctrl)
+
+	metrics.EXPECT().RecordHistogram(gomock.Any(), "app_http_service_response", gomock.Any(), gomock.Any()).AnyTimes()
+	metrics.EXPECT().IncrementCounter(gomock.Any(), "app_http_service_retries_total",
+		"service", server.URL, "method", http.MethodGet).Times(2)
+
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), metrics,
+		&RetryConfig{MaxRetries: 3, BaseBackoff: time.Millisecond})
+
+	resp, err := svc.Get(context.Background(), "test", nil)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"errors"
+	"io"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// spanBody ends the span of a streamed request once its body is read completely or closed.
+type spanBody struct {
+	io.ReadCloser
+	span trace.Span
+	once sync.Once
+}
+
+func (b *spanBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if errors.Is(err, io.EOF) {
+		b.end()
+	}
+
+	return n, err
+}
+
+func (b *spanBody) Close() error {
+	defer b.end()
+
+	return b.ReadCloser.Close()
+}
+
+func (b *spanBody) end() {
+	b.once.Do(func() { b.span.End() })
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"gofr.dev/pkg/gofr/logging"
+)
+
+func TestHTTPService_GetStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/stream", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+		assert.Equal(t, "value", r.Header.Get("X-Test"))
+
+		_, _ = w.Write([]byte(`{"id":1}` + "\n" + `{"id":2}` + "\n"))
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+
+	svc := &httpService{
+		Client: &http.Client{},
+		url:    server.URL,
+		Tracer: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("gofr-http-client"),
+		Logger: logging.NewMockLogger(logging.INFO),
+	}
+
+	resp, err := svc.GetStream(context.Background(), "stream", map[string]any{"page": 1}, map[string]string{"X-Test": "value"})
+	require.NoError(t, err)
+
+	assert.Zero(t, endedSpans(recorder, server.URL+"/stream"), "span ended before the body is read")
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"id":1}`+"\n"+`{"id":2}`+"\n", string(body))
+	assert.Equal(t, 1, endedSpans(recorder, server.URL+"/stream"), "span not ended after the body is read")
+
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, 1, endedSpans(recorder, server.URL+"/stream"), "span ended more than once")
+}
+
+func TestHTTPService_GetStreamClosedBeforeRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("data"))
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+
+	svc := &httpService{
+		Client: &http.Client{},
+		url:    server.URL,
+		Tracer: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("gofr-http-client"),
+		Logger: logging.NewMockLogger(logging.INFO),
+	}
+
+	resp, err := svc.GetStream(context.Background(), "", nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, 1, endedSpans(recorder, server.URL))
+}
+
+func TestHTTPService_GetStreamError(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+
+	svc := &httpService{
+		Client: &http.Client{},
+		url:    "http://invalid\x7f",
+		Tracer: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("gofr-http-client"),
+		Logger: logging.NewMockLogger(logging.INFO),
+	}
+
+	resp, err := svc.GetStream(context.Background(), "", nil, nil)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, 1, endedSpans(recorder, "http://invalid\x7f"))
+}
+
+// endedSpans returns the number of ended spans of the requests to uri.
+func endedSpans(recorder *tracetest.SpanRecorder, uri string) int {
+	var count int
+
+	for _, span := range recorder.Ended() {
+		if span.Name() == uri {
+			count++
+		}
+	}
+
+	return count
+}
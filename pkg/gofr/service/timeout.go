@@ -0,0 +1,55 @@
+package service
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+const defaultKeepAlive = 30 * time.Second
+
+// TimeoutConfig bounds the time taken by the requests to the service, so that a service which does not respond
+// cannot hold the requests of the application indefinitely. The requests which time out fail with an error, which
+// is counted as a failure by the circuit breaker of the service, if any. A zero duration leaves the default of the
+// Go HTTP client as it is.
+type TimeoutConfig struct {
+	// Connect is the maximum time taken to establish a connection to the service.
+	Connect time.Duration
+	// TLSHandshake is the maximum time taken by the TLS handshake with the service.
+	TLSHandshake time.Duration
+	// Request is the maximum time taken by a request, including the connection, any redirects and reading the
+	// response body, so it bounds the streamed responses as well.
+	Request time.Duration
+}
+
+// AddOption returns h as it is, as the timeouts are set on the client of the service by configureClient.
+func (*TimeoutConfig) AddOption(h HTTP) HTTP {
+	return h
+}
+
+func (t *TimeoutConfig) configureClient(c *http.Client) {
+	if t.Request > 0 {
+		c.Timeout = t.Request
+	}
+
+	if t.Connect <= 0 && t.TLSHandshake <= 0 {
+		return
+	}
+
+	transport, ok := c.Transport.(*http.Transport)
+	if !ok {
+		transport, _ = http.DefaultTransport.(*http.Transport)
+	}
+
+	transport = transport.Clone()
+
+	if t.Connect > 0 {
+		transport.DialContext = (&net.Dialer{Timeout: t.Connect, KeepAlive: defaultKeepAlive}).DialContext
+	}
+
+	if t.TLSHandshake > 0 {
+		transport.TLSHandshakeTimeout = t.TLSHandshake
+	}
+
+	c.Transport = transport
+}
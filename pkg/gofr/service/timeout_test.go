@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gofr.dev/pkg/gofr/logging"
+)
+
+func TestTimeoutConfig_Request(t *testing.T) {
+	server, _, unblock := newBlockingServer(t)
+	defer close(unblock)
+
+	ctrl := gomock.NewController(t)
+	metrics := NewMockMetrics(ctrl)
+
+	metrics.EXPECT().RecordHistogram(gomock.Any(), "app_http_service_response", gomock.Any(), gomock.Any()).AnyTimes()
+	metrics.EXPECT().SetGauge(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	// the timeout is applied to the client even though the option is added after the circuit breaker
+	svc := NewHTTPService(server.URL, logging.NewMockLogger(logging.INFO), metrics,
+		&CircuitBreakerConfig{Threshold: 0, Interval: time.Hour},
+		&TimeoutConfig{Request: 50 * time.Millisecond})
+
+	start := time.Now()
+
+	_, err := svc.Get(context.Background(), "test", nil)
+
+	require.ErrorIs(t, err, ErrCircuitOpen, "the request which timed out is counted as a failure")
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, OpenState, svc.CircuitState())
+}
+
+func TestTimeoutConfig_configureClient(t *testing.T) {
+	tests := []struct {
+		desc    string
+		config  TimeoutConfig
+		timeout time.Duration
+		tls     time.Duration
+		dial    bool
+	}{
+		{"no timeouts", TimeoutConfig{}, 0, 0, false},
+		{"request timeout", TimeoutConfig{Request: time.Second}, time.Second, 0, false},
+		{"connect and TLS handshake timeouts", TimeoutConfig{Connect: time.Second, TLSHandshake: 2 * time.Second}, 0, 2 * time.Second, true},
+	}
+
+	for i, tc := range tests {
+		client := &http.Client{}
+
+		tc.config.configureClient(client)
+
+		assert.Equal(t, tc.timeout, client.Timeout, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		if !tc.dial {
+			assert.Nil(t, client.Transport, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+			continue
+		}
+
+		transport, ok := client.Transport.(*http.Transport)
+
+		require.True(t, ok, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.NotNil(t, transport.DialContext, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.tls, transport.TLSHandshakeTimeout, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.NotSame(t, http.DefaultTransport, transport, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
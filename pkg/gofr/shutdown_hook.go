@@ -0,0 +1,41 @@
+package gofr
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// OnShutdown registers a hook which is run during the graceful shutdown of the application, after the servers
+// have stopped accepting requests and before the datasources are closed. Hooks are run in the reverse order of
+// their registration, with the context of the shutdown, whose deadline is the shutdown timeout. As the in-flight work
+// is only awaited until the SHUTDOWN_CLEANUP_TIMEOUT before that deadline, the hooks get that time even if the
+// in-flight work did not complete. An error returned by a hook, or a panic in it, is logged and does not prevent the
+// remaining hooks from running, nor the datasources from being closed.
+func (a *App) OnShutdown(hook func(ctx *Context) error) {
+	a.shutdownHooks = append(a.shutdownHooks, hook)
+}
+
+func (a *App) runShutdownHooks(ctx context.Context) {
+	for i := len(a.shutdownHooks) - 1; i >= 0; i-- {
+		a.runShutdownHook(ctx, a.shutdownHooks[i])
+	}
+}
+
+func (a *App) runShutdownHook(ctx context.Context, hook func(ctx *Context) error) {
+	defer func() {
+		if re := recover(); re != nil {
+			a.container.Logger.Error(panicLog{Error: fmt.Sprint(re), StackTrace: string(debug.Stack())})
+			countPanic(ctx, a.container, "shutdown")
+		}
+	}()
+
+	err := hook(&Context{
+		Context:   ctx,
+		Container: a.container,
+		Request:   noopRequest{},
+	})
+	if err != nil {
+		a.container.Logger.Errorf("error in shutdown hook: %v", err)
+	}
+}
@@ -5,7 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/logging"
+	"gofr.dev/pkg/gofr/testutil"
 )
 
 func TestShutdownWithContext_ContextTimeout(t *testing.T) {
@@ -39,3 +44,65 @@ func TestShutdownWithContext_SuccessfulShutdown(t *testing.T) {
 
 	require.NoError(t, err, "Expected successful shutdown without error")
 }
+
+func TestApp_OnShutdown(t *testing.T) {
+	var order []int
+
+	logs := testutil.StderrOutputForFunc(func() {
+		app := &App{container: &container.Container{Logger: logging.NewMockLogger(logging.DEBUG)}}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		app.OnShutdown(func(c *Context) error {
+			_, hasDeadline := c.Deadline()
+			assert.True(t, hasDeadline, "hook should get the shutdown deadline")
+
+			order = append(order, 1)
+
+			return nil
+		})
+
+		app.OnShutdown(func(*Context) error {
+			order = append(order, 2)
+
+			return errTest
+		})
+
+		app.OnShutdown(func(*Context) error {
+			order = append(order, 3)
+
+			return nil
+		})
+
+		err := app.Shutdown(ctx)
+		require.NoError(t, err)
+	})
+
+	assert.Equal(t, []int{3, 2, 1}, order, "hooks should run in reverse order even if one of them fails")
+	assert.Contains(t, logs, "error in shutdown hook: some error")
+}
+
+func TestApp_OnShutdownPanic(t *testing.T) {
+	ran := false
+
+	logs := testutil.StderrOutputForFunc(func() {
+		app := &App{container: &container.Container{Logger: logging.NewMockLogger(logging.DEBUG)}}
+
+		app.OnShutdown(func(*Context) error {
+			ran = true
+
+			return nil
+		})
+
+		app.OnShutdown(func(*Context) error {
+			panic("hook panicked")
+		})
+
+		err := app.Shutdown(context.Background())
+		require.NoError(t, err)
+	})
+
+	assert.True(t, ran, "hooks registered before a panicking hook should still run")
+	assert.Contains(t, logs, "hook panicked")
+}
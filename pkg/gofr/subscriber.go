@@ -2,17 +2,33 @@ package gofr
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"runtime/debug"
+	"time"
 
 	"gofr.dev/pkg/gofr/container"
+	"gofr.dev/pkg/gofr/datasource/pubsub"
 	"gofr.dev/pkg/gofr/logging"
 )
 
+// ErrDropMessage is returned by a SubscribeFunc to drop a message which cannot be handled, e.g. a message which cannot
+// be bound, instead of having it redelivered. The message is terminated on NATS JetStream, and acknowledged on the other
+// backends. It can be wrapped to log the reason.
+var ErrDropMessage = errors.New("message dropped")
+
+var errHandlerPanicked = errors.New("handler panicked")
+
+// SubscribeFunc handles a message of a topic. The message is acknowledged when it returns nil, and dropped when it
+// returns ErrDropMessage.
+// When it returns any other error, the message is not acknowledged, and it is redelivered by the backends which support
+// it.
 type SubscribeFunc func(c *Context) error
 
 type SubscriptionManager struct {
 	container     *container.Container
 	subscriptions map[string]SubscribeFunc
+	inFlight      *inFlight
 }
 
 func newSubscriptionManager(c *container.Container) SubscriptionManager {
@@ -51,36 +67,92 @@ func (s *SubscriptionManager) handleSubscription(ctx context.Context, topic stri
 		return nil
 	}
 
+	defer s.inFlight.start("subscriber " + topic)()
+
 	// newContext creates a new context from the msg.Context()
 	msgCtx := newContext(nil, msg, s.container)
-	err = func(ctx *Context) error {
-		// TODO : Move panic recovery at central location which will manage for all the different cases.
+	start := time.Now()
+
+	err = func(ctx *Context) (err error) {
+		// a panic is handled as an error, so that the message is redelivered
 		defer func() {
-			panicRecovery(recover(), ctx.Logger)
+			if re := recover(); re != nil {
+				ctx.Logger.Error(panicLog{Topic: topic, Error: fmt.Sprint(re), StackTrace: string(debug.Stack())})
+				countPanic(ctx, s.container, "subscribe")
+
+				err = fmt.Errorf("%w: %v", errHandlerPanicked, re)
+			}
 		}()
 
 		return handler(ctx)
 	}(msgCtx)
 
-	if err != nil {
+	s.recordHandlerMetrics(topic, start, err)
+
+	switch {
+	case err == nil:
+		if msg.Committer != nil {
+			msg.Commit()
+		}
+	case errors.Is(err, ErrDropMessage):
+		s.container.Logger.Infof("dropping message of topic %s: %v", topic, err)
+
+		// terminate the message on the backends which support it, acknowledge it on the others
+		if dropper, ok := msg.Committer.(pubsub.Dropper); ok {
+			dropper.Drop()
+		} else if msg.Committer != nil {
+			msg.Commit()
+		}
+	default:
 		s.container.Logger.Errorf("error in handler for topic %s: %v", topic, err)
 
-		return nil
+		// ask the backends which support it to redeliver the message
+		if nacker, ok := msg.Committer.(pubsub.Nacker); ok {
+			nacker.Nack()
+		}
+	}
+
+	return nil
+}
+
+// recordHandlerMetrics records the duration and the result of the handling of a message of the topic.
+func (s *SubscriptionManager) recordHandlerMetrics(topic string, start time.Time, err error) {
+	metrics := s.container.Metrics()
+	if metrics == nil {
+		return
 	}
 
-	if msg.Committer != nil {
-		// commit the message if the subscription function does not return error
-		msg.Commit()
+	ctx := context.Background()
+
+	metrics.RecordHistogram(ctx, "app_pubsub_handler_duration", time.Since(start).Seconds(), "topic", topic)
+
+	if err != nil {
+		metrics.IncrementCounter(ctx, "app_pubsub_handler_error_count", "topic", topic)
+
+		return
 	}
 
-	return nil
+	metrics.IncrementCounter(ctx, "app_pubsub_handler_success_count", "topic", topic)
 }
 
 type panicLog struct {
+	TraceID    string `json:"trace_id,omitempty"`
+	RequestID  string `json:"request_id,omitempty"`
+	Route      string `json:"route,omitempty"`
+	Topic      string `json:"topic,omitempty"`
+	Job        string `json:"job,omitempty"`
 	Error      string `json:"error,omitempty"`
 	StackTrace string `json:"stack_trace,omitempty"`
 }
 
+// countPanic counts a recovered panic in the app_panics_total metric, labeled by the source which panicked, i.e.
+// http, subscribe, cron, background, event or shutdown.
+func countPanic(ctx context.Context, c *container.Container, source string) {
+	if m := c.Metrics(); m != nil {
+		m.IncrementCounter(ctx, "app_panics_total", "source", source)
+	}
+}
+
 func panicRecovery(re any, log logging.Logger) {
 	if re == nil {
 		return
@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
 	"gofr.dev/pkg/gofr/container"
 	"gofr.dev/pkg/gofr/datasource"
@@ -162,6 +163,28 @@ func TestSubscriptionManager_PanicRecovery(t *testing.T) {
 	}
 }
 
+func TestSubscriptionManager_PanicLogsAndMetrics(t *testing.T) {
+	c, mocks := container.NewMockContainer(t)
+	c.PubSub = committerSubscriber{committer: &testCommitter{}}
+
+	mocks.Metrics.EXPECT().RecordHistogram(gomock.Any(), "app_pubsub_handler_duration", gomock.Any(), "topic", "test-topic")
+	mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_pubsub_handler_error_count", "topic", "test-topic")
+	mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), "app_panics_total", "source", "subscribe")
+
+	logs := testutil.StderrOutputForFunc(func() {
+		c.Logger = logging.NewMockLogger(logging.ERROR)
+
+		subscriptionManager := newSubscriptionManager(c)
+
+		_ = subscriptionManager.handleSubscription(context.Background(), "test-topic", func(*Context) error {
+			panic("test panic")
+		})
+	})
+
+	assert.Contains(t, logs, "subscriber.go")
+	assert.Contains(t, logs, "handler panicked: test panic")
+}
+
 func TestSubscriptionManager_ShouldStopOnCtxDone(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -180,3 +203,115 @@ func TestSubscriptionManager_ShouldStopOnCtxDone(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestSubscriptionManager_HandlerMetrics(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		handlerErr error
+		expCounter string
+	}{
+		{"handler success", nil, "app_pubsub_handler_success_count"},
+		{"handler error", errHandler, "app_pubsub_handler_error_count"},
+	}
+
+	for i, tc := range testCases {
+		c, mocks := container.NewMockContainer(t)
+		c.PubSub = mockSubscriber{}
+
+		mocks.Metrics.EXPECT().RecordHistogram(gomock.Any(), "app_pubsub_handler_duration", gomock.Any(), "topic", "test-topic")
+		mocks.Metrics.EXPECT().IncrementCounter(gomock.Any(), tc.expCounter, "topic", "test-topic")
+
+		subscriptionManager := newSubscriptionManager(c)
+
+		err := subscriptionManager.handleSubscription(context.Background(), "test-topic", func(*Context) error {
+			return tc.handlerErr
+		})
+
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+type testCommitter struct {
+	committed, nacked int
+}
+
+func (c *testCommitter) Commit() { c.committed++ }
+
+func (c *testCommitter) Nack() { c.nacked++ }
+
+type droppingCommitter struct {
+	testCommitter
+	dropped int
+}
+
+func (c *droppingCommitter) Drop() { c.dropped++ }
+
+type committerSubscriber struct {
+	mockSubscriber
+	committer pubsub.Committer
+}
+
+func (s committerSubscriber) Subscribe(ctx context.Context, topic string) (*pubsub.Message, error) {
+	msg := pubsub.NewMessage(ctx)
+	msg.Topic = topic
+	msg.Committer = s.committer
+
+	return msg, nil
+}
+
+func TestSubscriptionManager_Acknowledgement(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		handlerErr   error
+		expCommitted int
+		expNacked    int
+	}{
+		{"nil acknowledges", nil, 1, 0},
+		{"error nacks", errHandler, 0, 1},
+		{"drop acknowledges", fmt.Errorf("invalid message: %w", ErrDropMessage), 1, 0},
+		{"panic nacks", nil, 0, 1},
+	}
+
+	for i, tc := range testCases {
+		committer := &testCommitter{}
+
+		c := &container.Container{
+			Logger: logging.NewLogger(logging.FATAL),
+			PubSub: committerSubscriber{committer: committer},
+		}
+
+		subscriptionManager := newSubscriptionManager(c)
+
+		err := subscriptionManager.handleSubscription(context.Background(), "test-topic", func(*Context) error {
+			if tc.desc == "panic nacks" {
+				panic("test panic")
+			}
+
+			return tc.handlerErr
+		})
+
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.expCommitted, committer.committed, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.expNacked, committer.nacked, "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+}
+
+func TestSubscriptionManager_DropTerminates(t *testing.T) {
+	committer := &droppingCommitter{}
+
+	c := &container.Container{
+		Logger: logging.NewLogger(logging.FATAL),
+		PubSub: committerSubscriber{committer: committer},
+	}
+
+	subscriptionManager := newSubscriptionManager(c)
+
+	err := subscriptionManager.handleSubscription(context.Background(), "test-topic", func(*Context) error {
+		return fmt.Errorf("invalid message: %w", ErrDropMessage)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, committer.dropped)
+	assert.Zero(t, committer.committed)
+	assert.Zero(t, committer.nacked)
+}
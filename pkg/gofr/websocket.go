@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	gWebsocket "github.com/gorilla/websocket"
 
@@ -13,6 +14,35 @@ import (
 
 var ErrMarshalingResponse = errors.New("error marshaling response")
 
+// defaultWSMaxMessageSize is the default size limit of the messages read from WebSocket connections, in bytes.
+const defaultWSMaxMessageSize = 1 << 20
+
+// WebSocketOption is used to configure a WebSocket route while registering it.
+type WebSocketOption func(c *webSocketConfig)
+
+type webSocketConfig struct {
+	maxMessageSize int64
+	onClose        func(ctx *Context, code int, reason string)
+}
+
+// WithWSMaxMessageSize sets the size limit of the messages read from the connections of the route, in bytes.
+// The connection is closed with the status 1009 (message too big) when a client sends a bigger message, instead of
+// buffering it. Defaults to 1MB, and a size of zero or less removes the limit.
+func WithWSMaxMessageSize(bytes int64) WebSocketOption {
+	return func(c *webSocketConfig) {
+		c.maxMessageSize = bytes
+	}
+}
+
+// WithOnClose sets the function called exactly once when a connection of the route is closed, by the client, as it sent
+// a message bigger than the limit, or as the server is shutting down. It is called with the close code and reason, and
+// the code is 1006 (abnormal closure) when the connection has been dropped without being closed.
+func WithOnClose(fn func(ctx *Context, code int, reason string)) WebSocketOption {
+	return func(c *webSocketConfig) {
+		c.onClose = fn
+	}
+}
+
 func (a *App) OverrideWebsocketUpgrader(wsUpgrader websocket.Upgrader) {
 	a.httpServer.ws.WebSocketUpgrader.Upgrader = wsUpgrader
 }
@@ -20,7 +50,15 @@ func (a *App) OverrideWebsocketUpgrader(wsUpgrader websocket.Upgrader) {
 // WebSocket registers a handler function for a WebSocket route. This method allows you to define a route handler for
 // WebSocket connections. It internally handles the WebSocket handshake and provides a `websocket.Connection` object
 // within the handler context. User can access the underlying WebSocket connection using `ctx.GetWebsocketConnection()`.
-func (a *App) WebSocket(route string, handler Handler) {
+// The open connections, their duration, and the messages and bytes sent and received are recorded per route.
+// The messages bigger than 1MB are rejected by default, which can be changed using WithWSMaxMessageSize.
+func (a *App) WebSocket(route string, handler Handler, opts ...WebSocketOption) {
+	cfg := webSocketConfig{maxMessageSize: defaultWSMaxMessageSize}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	a.GET(route, func(ctx *Context) (any, error) {
 		connID := ctx.Request.Context().Value(websocket.WSConnectionKey).(string)
 
@@ -31,16 +69,54 @@ func (a *App) WebSocket(route string, handler Handler) {
 
 		ctx.Request = conn
 
-		ctx.Context = context.WithValue(ctx, websocket.WSConnectionKey, conn)
+		ctx.Context = context.WithValue(ctx.Context, websocket.WSConnectionKey, conn)
 
 		defer a.httpServer.ws.CloseConnection(connID)
 
+		if cfg.maxMessageSize > 0 {
+			conn.SetReadLimit(cfg.maxMessageSize)
+		}
+
+		metrics := a.container.Metrics()
+		start := time.Now()
+
+		conn.EnableMetrics(metrics, route)
+		metrics.DeltaUpDownCounter(context.Background(), "app_websocket_connections", 1, "route", route)
+
+		defer func() {
+			metrics.DeltaUpDownCounter(context.Background(), "app_websocket_connections", -1, "route", route)
+			metrics.RecordHistogram(context.Background(), "app_websocket_connection_duration", time.Since(start).Seconds(),
+				"route", route)
+		}()
+
 		handleWebSocketConnection(ctx, conn, handler)
 
+		if cfg.onClose != nil {
+			code, reason := conn.CloseStatus()
+			if code == 0 {
+				code = gWebsocket.CloseAbnormalClosure
+			}
+
+			cfg.onClose(ctx, code, reason)
+		}
+
 		return nil, nil
 	})
 }
 
+// NewWebSocketClient creates a client for service-to-service WebSocket calls to url, which connects in the background
+// and reconnects with an exponential backoff whenever the connection is lost. The client is closed when the application
+// shuts down.
+func (a *App) NewWebSocketClient(url string, opts ...websocket.ClientOption) *websocket.Client {
+	client := websocket.NewClient(url, a.container.Logger, a.container.Metrics(), opts...)
+
+	a.OnShutdown(func(*Context) error {
+		return client.Close()
+	})
+
+	return client
+}
+
 func handleWebSocketConnection(ctx *Context, conn *websocket.Connection, handler Handler) {
 	for {
 		response, err := handler(ctx)
@@ -49,6 +125,17 @@ func handleWebSocketConnection(ctx *Context, conn *websocket.Connection, handler
 				break
 			}
 
+			// the connection has been closed with 1009 (message too big), so no message can be read anymore
+			if errors.Is(err, gWebsocket.ErrReadLimit) {
+				ctx.Errorf("Closing connection: %v", err)
+				break
+			}
+
+			// the connection has been closed with another code, or as the server is shutting down
+			if code, _ := conn.CloseStatus(); code != 0 {
+				break
+			}
+
 			ctx.Errorf("Error handling message: %v", err)
 		}
 
@@ -0,0 +1,397 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultBaseBackoff = 500 * time.Millisecond
+	defaultMaxBackoff  = 30 * time.Second
+)
+
+// ErrClientClosed is returned by the Client once it has been closed.
+var ErrClientClosed = errors.New("websocket client closed")
+
+// ClientOption is used to configure the Client while creating it.
+type ClientOption func(c *Client)
+
+// WithReconnectBackoff sets the backoff before the first reconnection attempt, which doubles for every failed attempt
+// up to maxBackoff. Defaults to 500ms and 30s.
+func WithReconnectBackoff(base, maxBackoff time.Duration) ClientOption {
+	return func(c *Client) {
+		if base > 0 {
+			c.baseBackoff = base
+		}
+
+		if maxBackoff > 0 {
+			c.maxBackoff = maxBackoff
+		}
+	}
+}
+
+// WithOnConnect sets the function called every time the Client connects, before any message is received on the
+// connection. It can be used to re-subscribe after a reconnection.
+func WithOnConnect(fn func(ctx context.Context)) ClientOption {
+	return func(c *Client) {
+		c.onConnect = fn
+	}
+}
+
+// WithOnDisconnect sets the function called with the error which closed the connection every time the Client
+// disconnects.
+func WithOnDisconnect(fn func(err error)) ClientOption {
+	return func(c *Client) {
+		c.onDisconnect = fn
+	}
+}
+
+// WithHeader adds a header to the handshake requests of the Client, e.g. for authentication.
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) {
+		c.header.Add(key, value)
+	}
+}
+
+// WithDialer sets the dialer used to connect, e.g. to configure TLS or the handshake timeout.
+func WithDialer(dialer *websocket.Dialer) ClientOption {
+	return func(c *Client) {
+		c.dialer = dialer
+	}
+}
+
+// Client is a WebSocket client which keeps a connection to a server open, reconnecting with an exponential backoff
+// with jitter whenever the connection is lost, until it is closed.
+type Client struct {
+	url    string
+	host   string
+	header http.Header
+	dialer *websocket.Dialer
+
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+
+	onConnect    func(ctx context.Context)
+	onDisconnect func(err error)
+
+	logger  Logger
+	metrics Metrics
+	tracer  trace.Tracer
+
+	mu      sync.Mutex
+	conn    *websocket.Conn
+	ready   chan struct{} // closed when conn is set
+	writeMu sync.Mutex
+
+	messages chan []byte
+	ctx      context.Context
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// NewClient creates a Client for the WebSocket server at rawURL, and starts connecting to it in the background.
+// The logger and metrics are optional, and can be nil.
+func NewClient(rawURL string, logger Logger, metrics Metrics, opts ...ClientOption) *Client {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &Client{
+		url:         rawURL,
+		host:        rawURL,
+		header:      make(http.Header),
+		dialer:      websocket.DefaultDialer,
+		baseBackoff: defaultBaseBackoff,
+		maxBackoff:  defaultMaxBackoff,
+		logger:      logger,
+		metrics:     metrics,
+		tracer:      otel.GetTracerProvider().Tracer("gofr-websocket-client"),
+		ready:       make(chan struct{}),
+		messages:    make(chan []byte),
+		ctx:         ctx,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+	}
+
+	// the host is used as the label of the metrics, as the URL may contain credentials or unbounded values
+	if u, err := url.Parse(rawURL); err == nil && u.Host != "" {
+		c.host = u.Host
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	go c.run()
+
+	return c
+}
+
+// Send writes the message to the connection, waiting for the Client to connect if it is reconnecting.
+// Strings and byte slices are sent as they are, and any other value is sent as JSON.
+func (c *Client) Send(ctx context.Context, message any) error {
+	var (
+		data []byte
+		err  error
+	)
+
+	switch v := message.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		data, err = json.Marshal(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, err := c.connection(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	deadline, _ := ctx.Deadline()
+
+	err = conn.SetWriteDeadline(deadline)
+	if err != nil {
+		return err
+	}
+
+	return conn.WriteMessage(TextMessage, data)
+}
+
+// Receive returns the next message received from the server, waiting until one is received, ctx is done or the
+// Client is closed.
+func (c *Client) Receive(ctx context.Context) ([]byte, error) {
+	select {
+	case message := <-c.messages:
+		return message, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.ctx.Done():
+		return nil, ErrClientClosed
+	}
+}
+
+// Close closes the connection, stops the reconnections and waits for them to stop.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	c.cancel()
+	conn := c.conn
+	c.mu.Unlock()
+
+	if conn != nil {
+		c.writeMu.Lock()
+		_ = conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+		c.writeMu.Unlock()
+
+		// unblocks the read of the connection
+		_ = conn.Close()
+	}
+
+	<-c.done
+
+	return nil
+}
+
+// connection returns the current connection, waiting for the Client to connect if there is none.
+func (c *Client) connection(ctx context.Context) (*websocket.Conn, error) {
+	for {
+		c.mu.Lock()
+		conn, ready := c.conn, c.ready
+		c.mu.Unlock()
+
+		if conn != nil {
+			return conn, nil
+		}
+
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-c.ctx.Done():
+			return nil, ErrClientClosed
+		}
+	}
+}
+
+func (c *Client) run() {
+	defer close(c.done)
+
+	connected, attempt := false, 0
+
+	for {
+		conn, err := c.dial()
+		if err != nil {
+			if c.ctx.Err() != nil {
+				return
+			}
+
+			c.errorf("error connecting to websocket %s: %v", c.host, err)
+			c.incrementCounter("app_websocket_client_dial_errors_total")
+
+			if !c.wait(attempt) {
+				return
+			}
+
+			attempt++
+
+			continue
+		}
+
+		if connected {
+			c.incrementCounter("app_websocket_client_reconnects_total")
+		}
+
+		connected, attempt = true, 0
+
+		c.serve(conn)
+
+		// the server is not dialled again right away, as all the clients it disconnected would reconnect together
+		if c.ctx.Err() != nil || !c.wait(attempt) {
+			return
+		}
+	}
+}
+
+// dial connects to the server, carrying the trace context in the handshake request.
+func (c *Client) dial() (*websocket.Conn, error) {
+	ctx, span := c.tracer.Start(c.ctx, "websocket-connect "+c.host, trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	header := c.header.Clone()
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+
+	conn, resp, err := c.dialer.DialContext(ctx, c.url, header)
+	if resp != nil && resp.Body != nil {
+		resp.Body.Close()
+	}
+
+	if err != nil {
+		span.RecordError(err)
+
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// serve makes conn the current connection, and reads its messages until it is closed.
+func (c *Client) serve(conn *websocket.Conn) {
+	c.mu.Lock()
+
+	// the connection would not be closed by Close if it was closed in the meantime
+	if c.ctx.Err() != nil {
+		c.mu.Unlock()
+		conn.Close()
+
+		return
+	}
+
+	c.conn = conn
+	close(c.ready)
+	c.mu.Unlock()
+
+	if c.logger != nil {
+		c.logger.Infof("connected to websocket %s", c.host)
+	}
+
+	c.deltaConnections(1)
+
+	if c.onConnect != nil {
+		c.onConnect(c.ctx)
+	}
+
+	err := c.read(conn)
+
+	c.mu.Lock()
+	c.conn = nil
+	c.ready = make(chan struct{})
+	c.mu.Unlock()
+
+	conn.Close()
+
+	c.deltaConnections(-1)
+
+	if c.ctx.Err() == nil {
+		c.errorf("disconnected from websocket %s: %v", c.host, err)
+	}
+
+	if c.onDisconnect != nil {
+		c.onDisconnect(err)
+	}
+}
+
+func (c *Client) read(conn *websocket.Conn) error {
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			if c.ctx.Err() != nil {
+				return ErrClientClosed
+			}
+
+			return err
+		}
+
+		select {
+		case c.messages <- message:
+		case <-c.ctx.Done():
+			return ErrClientClosed
+		}
+	}
+}
+
+func (c *Client) errorf(format string, args ...any) {
+	if c.logger != nil {
+		c.logger.Errorf(format, args...)
+	}
+}
+
+func (c *Client) incrementCounter(name string) {
+	if c.metrics != nil {
+		c.metrics.IncrementCounter(c.ctx, name, "host", c.host)
+	}
+}
+
+func (c *Client) deltaConnections(value float64) {
+	if c.metrics != nil {
+		c.metrics.DeltaUpDownCounter(c.ctx, "app_websocket_client_connections", value, "host", c.host)
+	}
+}
+
+// wait sleeps for the backoff of the attempt, and returns false if the Client is closed before the backoff elapses.
+func (c *Client) wait(attempt int) bool {
+	backoff := c.baseBackoff << attempt
+	if backoff <= 0 || backoff > c.maxBackoff {
+		backoff = c.maxBackoff
+	}
+
+	// full jitter spreads the reconnections of the clients which were disconnected together
+	//nolint:gosec // the jitter does not need a cryptographically secure random number
+	backoff = time.Duration(rand.Int64N(int64(backoff)) + 1)
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-c.ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,222 @@
+package websocket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// newEchoServer starts a server which echoes the messages back, and closes every connection after closeAfter
+// messages when it is greater than zero.
+func newEchoServer(t *testing.T, closeAfter int, headers chan<- http.Header) string {
+	t.Helper()
+
+	upgrader := websocket.Upgrader{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if headers != nil {
+			headers <- r.Header.Clone()
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for i := 1; ; i++ {
+			messageType, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			if err := conn.WriteMessage(messageType, message); err != nil {
+				return
+			}
+
+			if i == closeAfter {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func newClientMocks(t *testing.T) (*MockLogger, *MockMetrics) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+
+	logger := NewMockLogger(ctrl)
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Errorf(gomock.Any(), gomock.Any()).AnyTimes()
+
+	return logger, NewMockMetrics(ctrl)
+}
+
+func TestClient_SendReceive(t *testing.T) {
+	url := newEchoServer(t, 0, nil)
+	logger, metrics := newClientMocks(t)
+
+	metrics.EXPECT().DeltaUpDownCounter(gomock.Any(), "app_websocket_client_connections", float64(1), "host", gomock.Any())
+	metrics.EXPECT().DeltaUpDownCounter(gomock.Any(), "app_websocket_client_connections", float64(-1), "host", gomock.Any())
+
+	client := NewClient(url, logger, metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tests := []struct {
+		desc     string
+		message  any
+		expected string
+	}{
+		{"string", "hello", "hello"},
+		{"bytes", []byte("bytes"), "bytes"},
+		{"struct", struct {
+			Name string `json:"name"`
+		}{Name: "gofr"}, `{"name":"gofr"}`},
+	}
+
+	for i, tc := range tests {
+		require.NoError(t, client.Send(ctx, tc.message), "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		message, err := client.Receive(ctx)
+
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+		assert.Equal(t, tc.expected, string(message), "TEST[%d], Failed.\n%s", i, tc.desc)
+	}
+
+	require.NoError(t, client.Close())
+
+	_, err := client.Receive(ctx)
+	require.ErrorIs(t, err, ErrClientClosed)
+
+	err = client.Send(ctx, "after close")
+	require.ErrorIs(t, err, ErrClientClosed)
+}
+
+func TestClient_Reconnect(t *testing.T) {
+	url := newEchoServer(t, 1, nil)
+	logger, metrics := newClientMocks(t)
+
+	metrics.EXPECT().DeltaUpDownCounter(gomock.Any(), "app_websocket_client_connections", gomock.Any(), "host", gomock.Any()).
+		AnyTimes()
+	metrics.EXPECT().IncrementCounter(gomock.Any(), "app_websocket_client_reconnects_total", "host", gomock.Any()).
+		MinTimes(1)
+
+	var connects, disconnects atomic.Int32
+
+	client := NewClient(url, logger, metrics,
+		WithReconnectBackoff(time.Millisecond, 10*time.Millisecond),
+		WithOnConnect(func(context.Context) { connects.Add(1) }),
+		WithOnDisconnect(func(error) { disconnects.Add(1) }),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// the server closes the connection after every message, so each message is sent on a new connection
+	for i, message := range []string{"first", "second"} {
+		require.NoError(t, client.Send(ctx, message))
+
+		received, err := client.Receive(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, message, string(received))
+
+		require.Eventually(t, func() bool { return disconnects.Load() > int32(i) }, time.Second, time.Millisecond)
+	}
+
+	require.NoError(t, client.Close())
+
+	assert.GreaterOrEqual(t, connects.Load(), int32(2))
+}
+
+func TestClient_DialErrorsBackoff(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	server.Close()
+
+	logger, metrics := newClientMocks(t)
+
+	metrics.EXPECT().IncrementCounter(gomock.Any(), "app_websocket_client_dial_errors_total", "host", gomock.Any()).
+		MinTimes(2)
+
+	client := NewClient(url, logger, metrics, WithReconnectBackoff(time.Millisecond, 5*time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err := client.Send(ctx, "message")
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NoError(t, client.Close())
+}
+
+func TestClient_HandshakeHeaders(t *testing.T) {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider())
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	headers := make(chan http.Header, 1)
+	url := newEchoServer(t, 0, headers)
+	logger, metrics := newClientMocks(t)
+
+	metrics.EXPECT().DeltaUpDownCounter(gomock.Any(), "app_websocket_client_connections", gomock.Any(), "host", gomock.Any()).
+		AnyTimes()
+
+	client := NewClient(url, logger, metrics, WithHeader("Authorization", "Bearer token"))
+	defer client.Close()
+
+	select {
+	case header := <-headers:
+		assert.Equal(t, "Bearer token", header.Get("Authorization"))
+		assert.NotEmpty(t, header.Get("Traceparent"), "trace context should be carried in the handshake")
+	case <-time.After(5 * time.Second):
+		t.Fatal("handshake request not received")
+	}
+}
+
+func TestClient_BackoffAfterDisconnect(t *testing.T) {
+	var connections atomic.Int32
+
+	upgrader := websocket.Upgrader{}
+
+	// the server closes every connection right away
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		connections.Add(1)
+		conn.Close()
+	}))
+	defer server.Close()
+
+	// the logger and metrics are optional
+	client := NewClient("ws"+strings.TrimPrefix(server.URL, "http"), nil, nil,
+		WithReconnectBackoff(50*time.Millisecond, 50*time.Millisecond))
+
+	time.Sleep(200 * time.Millisecond)
+
+	require.NoError(t, client.Close())
+
+	// without the backoff, the server would be dialled again as soon as the connection is closed
+	assert.Positive(t, connections.Load())
+	assert.LessOrEqual(t, connections.Load(), int32(20))
+}
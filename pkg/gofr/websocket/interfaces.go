@@ -1,6 +1,7 @@
 package websocket
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/gorilla/websocket"
@@ -10,3 +11,21 @@ import (
 type Upgrader interface {
 	Upgrade(w http.ResponseWriter, r *http.Request, responseHeader http.Header) (*websocket.Conn, error)
 }
+
+// Logger is used by the Client to log its connection lifecycle.
+type Logger interface {
+	Infof(format string, args ...any)
+	Errorf(format string, args ...any)
+}
+
+// Metrics is used by the Client and the connections to record their metrics.
+type Metrics interface {
+	IncrementCounter(ctx context.Context, name string, labels ...string)
+	DeltaUpDownCounter(ctx context.Context, name string, value float64, labels ...string)
+}
+
+// CounterAdder is implemented by the Metrics which can increase a counter by more than 1, like the metrics manager of
+// GoFr, and is used by the connections to count the bytes they send and receive.
+type CounterAdder interface {
+	AddCounter(ctx context.Context, name string, value int64, labels ...string)
+}
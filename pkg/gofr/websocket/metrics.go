@@ -0,0 +1,80 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gorilla/websocket"
+)
+
+// EnableMetrics makes the connection record the number and size of the messages it sends and receives, along with its
+// read and write errors, labelled by the route which served it.
+func (w *Connection) EnableMetrics(metrics Metrics, route string) {
+	w.metrics = metrics
+	w.route = route
+}
+
+// ReadMessage reads the next message of the connection, recording its metrics when they are enabled, and its close
+// status once it is closed.
+func (w *Connection) ReadMessage() (messageType int, p []byte, err error) {
+	messageType, p, err = w.Conn.ReadMessage()
+
+	var closeErr *websocket.CloseError
+
+	switch {
+	case errors.As(err, &closeErr):
+		w.setCloseStatus(closeErr.Code, closeErr.Text)
+	case errors.Is(err, websocket.ErrReadLimit):
+		// gorilla/websocket closes the connection with 1009 when the read limit is exceeded
+		w.setCloseStatus(websocket.CloseMessageTooBig, err.Error())
+	}
+
+	if w.metrics == nil {
+		return messageType, p, err
+	}
+
+	ctx := context.Background()
+
+	if err != nil {
+		// the connections closed by the clients are not errors
+		if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway, websocket.CloseNoStatusReceived) {
+			w.metrics.IncrementCounter(ctx, "app_websocket_errors_total", "route", w.route, "operation", "read")
+		}
+
+		return messageType, p, err
+	}
+
+	w.metrics.IncrementCounter(ctx, "app_websocket_messages_received_total", "route", w.route)
+	w.addBytes(ctx, "app_websocket_received_bytes_total", len(p))
+
+	return messageType, p, nil
+}
+
+// WriteMessage writes a message to the connection, recording its metrics when they are enabled.
+func (w *Connection) WriteMessage(messageType int, data []byte) error {
+	err := w.Conn.WriteMessage(messageType, data)
+
+	if w.metrics == nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if err != nil {
+		w.metrics.IncrementCounter(ctx, "app_websocket_errors_total", "route", w.route, "operation", "write")
+
+		return err
+	}
+
+	w.metrics.IncrementCounter(ctx, "app_websocket_messages_sent_total", "route", w.route)
+	w.addBytes(ctx, "app_websocket_sent_bytes_total", len(data))
+
+	return nil
+}
+
+// addBytes counts the bytes sent or received, if the metrics support adding to counters.
+func (w *Connection) addBytes(ctx context.Context, name string, n int) {
+	if adder, ok := w.metrics.(CounterAdder); ok {
+		adder.AddCounter(ctx, name, int64(n), "route", w.route)
+	}
+}
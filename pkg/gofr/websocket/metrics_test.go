@@ -0,0 +1,119 @@
+package websocket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// counterAdderMetrics is the Metrics of the metrics manager of GoFr, which can also add to counters.
+type counterAdderMetrics struct {
+	*MockMetrics
+	*MockCounterAdder
+}
+
+func TestConnection_Metrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockMetrics := NewMockMetrics(ctrl)
+	mockAdder := NewMockCounterAdder(ctrl)
+	metrics := counterAdderMetrics{MockMetrics: mockMetrics, MockCounterAdder: mockAdder}
+
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_websocket_messages_received_total", "route", "/ws")
+	mockAdder.EXPECT().AddCounter(gomock.Any(), "app_websocket_received_bytes_total", int64(5), "route", "/ws")
+	mockMetrics.EXPECT().IncrementCounter(gomock.Any(), "app_websocket_messages_sent_total", "route", "/ws")
+	mockAdder.EXPECT().AddCounter(gomock.Any(), "app_websocket_sent_bytes_total", int64(12), "route", "/ws")
+
+	done := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer close(done)
+
+		upgrader := websocket.Upgrader{}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		c := &Connection{Conn: conn}
+		c.EnableMetrics(metrics, "/ws")
+
+		var message string
+
+		if err := c.Bind(&message); err != nil {
+			return
+		}
+
+		_ = c.WriteMessage(TextMessage, []byte("Hello, "+message))
+
+		// the client closing the connection is not an error
+		_, _, _ = c.ReadMessage()
+	}))
+	defer server.Close()
+
+	client, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	require.NoError(t, client.WriteMessage(websocket.TextMessage, []byte("gofr!")))
+
+	_, message, err := client.ReadMessage()
+	require.NoError(t, err)
+	assert.Equal(t, "Hello, gofr!", string(message))
+
+	require.NoError(t, client.WriteMessage(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")))
+
+	<-done
+
+	client.Close()
+}
+
+func TestConnection_MetricsErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metrics := NewMockMetrics(ctrl)
+
+	metrics.EXPECT().IncrementCounter(gomock.Any(), "app_websocket_errors_total", "route", "/ws", "operation", "read")
+	metrics.EXPECT().IncrementCounter(gomock.Any(), "app_websocket_errors_total", "route", "/ws", "operation", "write")
+
+	done := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer close(done)
+
+		upgrader := websocket.Upgrader{}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		c := &Connection{Conn: conn}
+		c.EnableMetrics(metrics, "/ws")
+
+		// the client drops the connection without closing it
+		_, _, err = c.ReadMessage()
+		assert.Error(t, err)
+
+		conn.Close()
+
+		err = c.WriteMessage(TextMessage, []byte("message"))
+		assert.Error(t, err)
+	}))
+	defer server.Close()
+
+	client, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+
+	resp.Body.Close()
+	client.NetConn().Close()
+
+	<-done
+}
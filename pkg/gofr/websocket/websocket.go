@@ -2,10 +2,12 @@ package websocket
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 )
@@ -19,11 +21,27 @@ const WSConnectionKey WSKey = "ws-connection-key"
 // Connection is a wrapper for gorilla websocket connection.
 type Connection struct {
 	*websocket.Conn
+
+	// HandshakeHeader holds the headers of the HTTP request which was upgraded to the connection.
+	HandshakeHeader http.Header
+
+	// HandshakeTLS holds the TLS state of the HTTP request which was upgraded to the connection, nil for plaintext.
+	HandshakeTLS *tls.ConnectionState
+
+	metrics Metrics
+	route   string
+
+	closeMu     sync.Mutex
+	closeCode   int
+	closeReason string
 }
 
 // ErrorConnection is the connection error that occurs when webscoket connection cannot be established.
 var ErrorConnection = errors.New("couldn't establish connection to web socket")
 
+// closeWriteTimeout is the time given to write the close message before closing a connection.
+const closeWriteTimeout = time.Second
+
 // The message types are defined in RFC 6455, section 11.8.
 const (
 	// TextMessage denotes a text data message. The text message payload is
@@ -61,7 +79,7 @@ func (*Connection) PathParam(_ string) string {
 }
 
 func (w *Connection) Bind(v any) error {
-	_, message, err := w.Conn.ReadMessage()
+	_, message, err := w.ReadMessage()
 	if err != nil {
 		return err
 	}
@@ -80,6 +98,41 @@ func (*Connection) HostName() string {
 	return "" // Not applicable for WebSocket, can be implemented if needed
 }
 
+// Header returns the value of the header of the handshake request identified by key.
+func (w *Connection) Header(key string) string {
+	return w.HandshakeHeader.Get(key)
+}
+
+// Headers returns a copy of the headers of the handshake request.
+func (w *Connection) Headers() http.Header {
+	return w.HandshakeHeader.Clone()
+}
+
+// TLS returns the TLS state of the handshake request, or nil if the connection is not using TLS.
+func (w *Connection) TLS() *tls.ConnectionState {
+	return w.HandshakeTLS
+}
+
+// CloseStatus returns the close code and reason of the connection once it has been closed by the client, or by the
+// server as the client sent a message bigger than the read limit or the server is shutting down. The code is zero
+// while the connection is open, or when it has been dropped without being closed.
+func (w *Connection) CloseStatus() (code int, reason string) {
+	w.closeMu.Lock()
+	defer w.closeMu.Unlock()
+
+	return w.closeCode, w.closeReason
+}
+
+// setCloseStatus records the close code and reason of the connection, unless it has already been closed.
+func (w *Connection) setCloseStatus(code int, reason string) {
+	w.closeMu.Lock()
+	defer w.closeMu.Unlock()
+
+	if w.closeCode == 0 {
+		w.closeCode, w.closeReason = code, reason
+	}
+}
+
 // Manager is a websocket manager that handles the upgrader and manages all
 // active connections through ConnectionHub.
 type Manager struct {
@@ -126,6 +179,39 @@ func (ws *Manager) AddWebsocketConnection(connID string, conn *Connection) {
 	ws.WebSocketConnections[connID] = conn
 }
 
+// CloseAll closes all the connections with the close code and reason, e.g. when the server shuts down. The connections
+// are closed concurrently, so that closing them takes at most the time given to write a close message, however many
+// of them are slow to write to.
+func (ws *Manager) CloseAll(code int, reason string) {
+	ws.mu.RLock()
+	conns := make([]*Connection, 0, len(ws.WebSocketConnections))
+
+	for _, conn := range ws.WebSocketConnections {
+		conns = append(conns, conn)
+	}
+
+	ws.mu.RUnlock()
+
+	var wg sync.WaitGroup
+
+	for _, conn := range conns {
+		wg.Add(1)
+
+		go func(conn *Connection) {
+			defer wg.Done()
+
+			conn.setCloseStatus(code, reason)
+
+			_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason),
+				time.Now().Add(closeWriteTimeout))
+
+			conn.Close()
+		}(conn)
+	}
+
+	wg.Wait()
+}
+
 // CloseConnection closes a websocket connection and then removes it from the connection hub.
 func (ws *Manager) CloseConnection(connID string) {
 	ws.mu.Lock()
@@ -2,6 +2,7 @@ package websocket
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -134,6 +135,19 @@ func Test_UnimplementedMethods(t *testing.T) {
 	assert.Nil(t, conn.Params("test"))
 }
 
+func TestConnection_Headers(t *testing.T) {
+	conn := &Connection{HandshakeHeader: http.Header{"Authorization": {"Bearer token"}}}
+
+	assert.Equal(t, "Bearer token", conn.Header("Authorization"))
+	assert.Empty(t, conn.Header("X-Missing"))
+
+	headers := conn.Headers()
+	headers.Set("Authorization", "changed")
+
+	assert.Equal(t, "Bearer token", conn.Header("Authorization"), "Headers should return a copy")
+	assert.Nil(t, (&Connection{}).Headers())
+}
+
 func dereference(v any) any {
 	switch v := v.(type) {
 	case *string:
@@ -144,3 +158,88 @@ func dereference(v any) any {
 		return v
 	}
 }
+
+func TestManager_CloseAll(t *testing.T) {
+	manager := New()
+	served := make(chan *Connection, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := manager.WebSocketUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		c := &Connection{Conn: conn}
+		manager.AddWebsocketConnection("conn", c)
+
+		served <- c
+	}))
+	defer server.Close()
+
+	client, resp, err := websocket.DefaultDialer.Dial("ws"+server.URL[len("http"):], nil)
+	require.NoError(t, err)
+
+	defer client.Close()
+	defer resp.Body.Close()
+
+	conn := <-served
+
+	code, _ := conn.CloseStatus()
+	assert.Zero(t, code, "open connection should have no close status")
+
+	manager.CloseAll(websocket.CloseGoingAway, "server shutting down")
+
+	code, reason := conn.CloseStatus()
+	assert.Equal(t, websocket.CloseGoingAway, code)
+	assert.Equal(t, "server shutting down", reason)
+
+	_, _, err = client.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway), "expected close 1001, got %v", err)
+
+	// the first close status is kept
+	conn.setCloseStatus(websocket.CloseNormalClosure, "")
+
+	code, _ = conn.CloseStatus()
+	assert.Equal(t, websocket.CloseGoingAway, code)
+}
+
+func TestManager_CloseAllConnections(t *testing.T) {
+	const connections = 5
+
+	manager := New()
+	served := make(chan struct{}, connections)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := manager.WebSocketUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		manager.AddWebsocketConnection(r.URL.Query().Get("id"), &Connection{Conn: conn})
+
+		served <- struct{}{}
+	}))
+	defer server.Close()
+
+	clients := make([]*websocket.Conn, connections)
+
+	for i := range clients {
+		client, resp, err := websocket.DefaultDialer.Dial(fmt.Sprintf("ws%s?id=%d", server.URL[len("http"):], i), nil)
+		require.NoError(t, err)
+
+		resp.Body.Close()
+
+		defer client.Close()
+
+		clients[i] = client
+
+		<-served
+	}
+
+	manager.CloseAll(websocket.CloseGoingAway, "server shutting down")
+
+	for i, client := range clients {
+		_, _, err := client.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway), "TEST[%d], Failed.\nexpected close 1001, got %v", i, err)
+	}
+}
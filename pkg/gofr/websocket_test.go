@@ -1,8 +1,10 @@
 package gofr
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"testing"
@@ -13,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"gofr.dev/pkg/gofr/testutil"
+	gofrWebSocket "gofr.dev/pkg/gofr/websocket"
 )
 
 func Test_WebSocket_Success(t *testing.T) {
@@ -65,6 +68,118 @@ func Test_WebSocket_Success(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_WebSocket_MaxMessageSize(t *testing.T) {
+	testutil.NewServerConfigs(t)
+
+	app := New()
+
+	server := httptest.NewServer(app.httpServer.router)
+	defer server.Close()
+
+	app.WebSocket("/ws", func(ctx *Context) (any, error) {
+		var message string
+
+		err := ctx.Bind(&message)
+		if err != nil {
+			return nil, err
+		}
+
+		return message, nil
+	}, WithWSMaxMessageSize(10))
+
+	wsURL := "ws" + server.URL[len("http"):] + "/ws"
+
+	ws, resp, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	require.NoError(t, err)
+
+	defer ws.Close()
+	defer resp.Body.Close()
+
+	require.NoError(t, ws.WriteMessage(websocket.TextMessage, []byte("small")))
+
+	_, message, err := ws.ReadMessage()
+	require.NoError(t, err)
+	assert.Equal(t, "small", string(message))
+
+	require.NoError(t, ws.WriteMessage(websocket.TextMessage, []byte("a message bigger than the limit")))
+
+	_, _, err = ws.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseMessageTooBig), "expected close 1009, got %v", err)
+}
+
+func Test_WebSocket_OnClose(t *testing.T) {
+	type closeEvent struct {
+		code   int
+		reason string
+	}
+
+	testCases := []struct {
+		desc     string
+		close    func(ws *websocket.Conn, app *App)
+		expEvent closeEvent
+	}{
+		{"closed by client", func(ws *websocket.Conn, _ *App) {
+			_ = ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "bye"))
+		}, closeEvent{websocket.CloseNormalClosure, "bye"}},
+		{"message too big", func(ws *websocket.Conn, _ *App) {
+			_ = ws.WriteMessage(websocket.TextMessage, []byte("a message bigger than the limit"))
+		}, closeEvent{websocket.CloseMessageTooBig, "read limit exceeded"}},
+		{"dropped by client", func(ws *websocket.Conn, _ *App) {
+			ws.NetConn().Close()
+		}, closeEvent{websocket.CloseAbnormalClosure, "unexpected EOF"}},
+		{"server shutdown", func(_ *websocket.Conn, app *App) {
+			_ = app.httpServer.Shutdown(context.Background())
+		}, closeEvent{websocket.CloseGoingAway, "server shutting down"}},
+	}
+
+	for i, tc := range testCases {
+		testutil.NewServerConfigs(t)
+
+		app := New()
+		app.httpServer.srv = &http.Server{Handler: app.httpServer.router, ReadHeaderTimeout: time.Second}
+
+		server := httptest.NewServer(app.httpServer.router)
+
+		events := make(chan closeEvent, 2)
+
+		app.WebSocket("/ws", func(ctx *Context) (any, error) {
+			var message string
+
+			err := ctx.Bind(&message)
+
+			return message, err
+		}, WithWSMaxMessageSize(10), WithOnClose(func(_ *Context, code int, reason string) {
+			events <- closeEvent{code, reason}
+		}))
+
+		ws, resp, err := websocket.DefaultDialer.Dial("ws"+server.URL[len("http"):]+"/ws", nil)
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		resp.Body.Close()
+
+		// wait for the connection to be served
+		require.NoError(t, ws.WriteMessage(websocket.TextMessage, []byte("hello")))
+
+		_, _, err = ws.ReadMessage()
+		require.NoError(t, err, "TEST[%d], Failed.\n%s", i, tc.desc)
+
+		tc.close(ws, app)
+
+		select {
+		case event := <-events:
+			assert.Equal(t, tc.expEvent.code, event.code, "TEST[%d], Failed.\n%s", i, tc.desc)
+			assert.Contains(t, event.reason, tc.expEvent.reason, "TEST[%d], Failed.\n%s", i, tc.desc)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("TEST[%d], Failed.\n%s: close event not received", i, tc.desc)
+		}
+
+		assert.Empty(t, events, "TEST[%d], Failed.\n%s: close event received more than once", i, tc.desc)
+
+		ws.Close()
+		server.Close()
+	}
+}
+
 func TestSerializeMessage(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -119,3 +234,39 @@ func TestSerializeMessage(t *testing.T) {
 		})
 	}
 }
+
+func Test_NewWebSocketClient(t *testing.T) {
+	testutil.NewServerConfigs(t)
+
+	app := New()
+
+	server := httptest.NewServer(app.httpServer.router)
+	defer server.Close()
+
+	app.WebSocket("/ws", func(ctx *Context) (any, error) {
+		var message string
+
+		err := ctx.Bind(&message)
+		if err != nil {
+			return nil, err
+		}
+
+		return ctx.Header("X-Client") + ": " + message, nil
+	})
+
+	client := app.NewWebSocketClient("ws"+server.URL[len("http"):]+"/ws", gofrWebSocket.WithHeader("X-Client", "orders"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.NoError(t, client.Send(ctx, "hello"))
+
+	message, err := client.Receive(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "orders: hello", string(message))
+
+	app.runShutdownHooks(ctx)
+
+	_, err = client.Receive(ctx)
+	require.ErrorIs(t, err, gofrWebSocket.ErrClientClosed)
+}